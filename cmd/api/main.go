@@ -129,7 +129,8 @@ func main() {
 	log.Info("Database stats collector started")
 
 	// Initialize and start business metrics collector
-	businessCollector := metrics.NewBusinessMetricsCollector(db, m, log.Logger)
+	businessCollector := metrics.NewBusinessMetricsCollector(db, m, log.Logger,
+		metrics.WithCollectInterval(cfg.Metrics.BusinessCollectInterval))
 	businessCollector.Start()
 	log.Info("Business metrics collector started")
 
@@ -203,6 +204,20 @@ func main() {
 	c.Start()
 	log.Info("Cleanup job scheduled successfully (runs every hour)")
 
+	// Download counts are buffered and written in batches to avoid contention on popular attachments
+	downloadCounter := job.NewDownloadCounter(attachmentRepo, log.Logger, 10*time.Second)
+	downloadCounter.Start()
+
+	// Attachment deletions are queued in the database and retried with backoff until S3 confirms them
+	attachmentDeleteWorker := job.NewAttachmentDeleteWorker(repository.NewAttachmentDeleteJobRepository(db), attachmentRepo, s3Client,
+		log.Logger, 30*time.Second, job.DefaultAttachmentDeleteMaxAttempts)
+	attachmentDeleteWorker.Start()
+
+	// Board events are delivered to webhook subscribers in the background so slow endpoints never stall requests
+	webhookDispatcher := job.NewWebhookDispatcher(repository.NewWebhookSubscriptionRepository(db), log.Logger,
+		job.DefaultWebhookMaxAttempts, job.DefaultWebhookBaseBackoff)
+	webhookDispatcher.Start()
+
 	// Log example endpoint URLs for verification
 	log.Info("User API endpoint examples (for debugging)",
 		zap.String("validate_member", cfg.UserAPI.BaseURL+"/api/workspaces/{workspaceId}/validate-member/{userId}"),
@@ -220,6 +235,16 @@ func main() {
 		BasePath:   cfg.Server.BasePath,
 		Metrics:    m,
 		S3Client:   s3Client,
+
+		MaxBoardsPerProject:  cfg.Board.MaxBoardsPerProject,
+		MaxCustomFieldsBytes: cfg.Board.MaxCustomFieldsBytes,
+		BulkUpdateInterval:   cfg.Board.BulkUpdateInterval,
+		StrictDecoding:       cfg.Server.StrictDecoding,
+
+		AllowMultiplePinnedComments: cfg.Board.AllowMultiplePinnedComments,
+		ArchiveReasons:              cfg.Board.ArchiveReasons,
+		DownloadCounter:             downloadCounter,
+		WebhookDispatcher:           webhookDispatcher,
 	}
 
 	r := router.Setup(routerConfig)
@@ -274,6 +299,18 @@ func main() {
 	businessCollector.Stop()
 	log.Info("Business metrics collector stopped")
 
+	// Flush buffered download counts
+	log.Info("Flushing attachment download counts")
+	downloadCounter.Stop()
+
+	// Stop draining the attachment deletion queue; unfinished jobs resume on the next start
+	log.Info("Stopping attachment delete worker")
+	attachmentDeleteWorker.Stop()
+
+	// Stop webhook delivery; events still queued are dropped
+	log.Info("Stopping webhook dispatcher")
+	webhookDispatcher.Stop()
+
 	// Stop cron scheduler
 	log.Info("Stopping cleanup job scheduler")
 	cronCtx := c.Stop()
@@ -3,8 +3,12 @@ package client
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
+	"net/url"
 	"strings"
 	"time"
 
@@ -14,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/google/uuid"
 )
 
@@ -24,6 +29,10 @@ type S3ClientInterface interface {
 	UploadFile(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
 	DeleteFile(ctx context.Context, key string) error
 	GetFileURL(key string) string
+	ComputeSHA256(ctx context.Context, key string) (string, error)
+	CopyFile(ctx context.Context, srcKey, dstKey string) error
+	ObjectExists(ctx context.Context, key string) (bool, error)
+	OpenFile(ctx context.Context, key string) (io.ReadCloser, int64, error)
 }
 
 // S3Client wraps AWS S3 client and implements S3ClientInterface
@@ -224,3 +233,65 @@ func (c *S3Client) GetFileURL(key string) string {
 	// AWS S3 환경인 경우 (기본)
 	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", c.bucket, c.region, key)
 }
+
+// ComputeSHA256 streams the object stored at key and returns its hex-encoded SHA-256
+func (c *S3Client) ComputeSHA256(ctx context.Context, key string) (string, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get file from S3: %w", err)
+	}
+	defer out.Body.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, out.Body); err != nil {
+		return "", fmt.Errorf("failed to read file from S3: %w", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// CopyFile copies the object stored at srcKey to dstKey within the bucket
+func (c *S3Client) CopyFile(ctx context.Context, srcKey, dstKey string) error {
+	// CopySource must be URL-encoded; EscapedPath keeps the key's slashes intact
+	source := (&url.URL{Path: c.bucket + "/" + srcKey}).EscapedPath()
+	_, err := c.client.CopyObject(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(c.bucket),
+		Key:        aws.String(dstKey),
+		CopySource: aws.String(source),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy file in S3: %w", err)
+	}
+	return nil
+}
+
+// ObjectExists reports whether an object is stored at key, using a HEAD request
+func (c *S3Client) ObjectExists(ctx context.Context, key string) (bool, error) {
+	_, err := c.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var notFound *types.NotFound
+		if errors.As(err, &notFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to check file in S3: %w", err)
+	}
+	return true, nil
+}
+
+// OpenFile streams the object stored at key and returns its size in bytes
+// The caller must close the returned reader
+func (c *S3Client) OpenFile(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	out, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get file from S3: %w", err)
+	}
+	return out.Body, aws.ToInt64(out.ContentLength), nil
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -23,6 +25,10 @@ type MockS3Client struct {
 	UploadFileFunc           func(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
 	DeleteFileFunc           func(ctx context.Context, key string) error
 	GetFileURLFunc           func(key string) string
+	ComputeSHA256Func        func(ctx context.Context, key string) (string, error)
+	CopyFileFunc             func(ctx context.Context, srcKey, dstKey string) error
+	ObjectExistsFunc         func(ctx context.Context, key string) (bool, error)
+	OpenFileFunc             func(ctx context.Context, key string) (io.ReadCloser, int64, error)
 }
 
 // NewMockS3Client creates a new mock S3 client for testing
@@ -136,5 +142,46 @@ func (m *MockS3Client) GetFileURL(key string) string {
 	return fmt.Sprintf("https://%s.s3.%s.amazonaws.com/%s", m.Bucket, m.Region, key)
 }
 
+// ComputeSHA256 returns a deterministic checksum derived from the key
+func (m *MockS3Client) ComputeSHA256(ctx context.Context, key string) (string, error) {
+	if m.ComputeSHA256Func != nil {
+		return m.ComputeSHA256Func(ctx, key)
+	}
+
+	// Default implementation - hash the key since there is no stored content
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// CopyFile simulates copying a stored file
+func (m *MockS3Client) CopyFile(ctx context.Context, srcKey, dstKey string) error {
+	if m.CopyFileFunc != nil {
+		return m.CopyFileFunc(ctx, srcKey, dstKey)
+	}
+
+	// Default implementation - always succeed
+	return nil
+}
+
+// ObjectExists simulates checking for a stored file
+func (m *MockS3Client) ObjectExists(ctx context.Context, key string) (bool, error) {
+	if m.ObjectExistsFunc != nil {
+		return m.ObjectExistsFunc(ctx, key)
+	}
+
+	// Default implementation - every uploaded file exists
+	return true, nil
+}
+
+// OpenFile simulates reading a stored file
+func (m *MockS3Client) OpenFile(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	if m.OpenFileFunc != nil {
+		return m.OpenFileFunc(ctx, key)
+	}
+
+	// Default implementation - the content is the key, since there is no stored content
+	return io.NopCloser(strings.NewReader(key)), int64(len(key)), nil
+}
+
 // Ensure MockS3Client implements S3ClientInterface
 var _ S3ClientInterface = (*MockS3Client)(nil)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,8 @@ type Config struct {
 	CORS     CORSConfig     `yaml:"cors"`
 	Redis    RedisConfig    `mapstructure:"redis" yaml:"redis"` // ← Redis 추가
 	S3       S3Config       `yaml:"s3"`                         // ← S3 추가
+	Board    BoardConfig    `yaml:"board"`
+	Metrics  MetricsConfig  `yaml:"metrics"`
 }
 
 // ServerConfig holds server configuration
@@ -31,6 +34,8 @@ type ServerConfig struct {
 	ReadTimeout     time.Duration `yaml:"read_timeout"`
 	WriteTimeout    time.Duration `yaml:"write_timeout"`
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	// StrictDecoding rejects unknown JSON fields in board create/update requests
+	StrictDecoding bool `yaml:"strict_decoding"`
 }
 
 // DatabaseConfig holds database configuration
@@ -85,6 +90,26 @@ type S3Config struct {
 	Endpoint  string `yaml:"endpoint"`   // 로컬 MinIO용 (선택적)
 }
 
+// BoardConfig holds board policy configuration
+type BoardConfig struct {
+	// MaxBoardsPerProject limits the number of active boards in a project (0 = unlimited)
+	MaxBoardsPerProject int `yaml:"max_boards_per_project"`
+	// MaxCustomFieldsBytes limits the serialized size of board custom fields (0 = service default)
+	MaxCustomFieldsBytes int `yaml:"max_custom_fields_bytes"`
+	// BulkUpdateInterval is the minimum delay between items of a streamed bulk update (0 = no throttling)
+	BulkUpdateInterval time.Duration `yaml:"bulk_update_interval"`
+	// AllowMultiplePinnedComments keeps earlier pins when another comment of the board is pinned
+	AllowMultiplePinnedComments bool `yaml:"allow_multiple_pinned_comments"`
+	// ArchiveReasons are the accepted reasons for archiving a board (empty = any reason is accepted)
+	ArchiveReasons []string `yaml:"archive_reasons"`
+}
+
+// MetricsConfig holds Prometheus metrics configuration
+type MetricsConfig struct {
+	// BusinessCollectInterval is how often business gauges are recomputed from the database (0 = collector default)
+	BusinessCollectInterval time.Duration `yaml:"business_collect_interval"`
+}
+
 // Load loads configuration from file and environment variables
 // If config file doesn't exist, loads from environment variables only
 func Load(configPath string) (*Config, error) {
@@ -178,6 +203,13 @@ func (c *Config) overrideFromEnv() {
 		c.Server.Mode = mode
 	}
 
+	// Strict request decoding
+	if strict := os.Getenv("SERVER_STRICT_DECODING"); strict != "" {
+		if v, err := strconv.ParseBool(strict); err == nil {
+			c.Server.StrictDecoding = v
+		}
+	}
+
 	// Base path for ALB routing
 	if basePath := os.Getenv("SERVER_BASE_PATH"); basePath != "" {
 		c.Server.BasePath = basePath
@@ -282,6 +314,43 @@ func (c *Config) overrideFromEnv() {
 	if s3Endpoint := os.Getenv("S3_ENDPOINT"); s3Endpoint != "" {
 		c.S3.Endpoint = s3Endpoint
 	}
+
+	// Board policy
+	if maxBoards := os.Getenv("BOARD_MAX_PER_PROJECT"); maxBoards != "" {
+		if n, err := strconv.Atoi(maxBoards); err == nil {
+			c.Board.MaxBoardsPerProject = n
+		}
+	}
+	if maxBytes := os.Getenv("BOARD_MAX_CUSTOM_FIELDS_BYTES"); maxBytes != "" {
+		if n, err := strconv.Atoi(maxBytes); err == nil {
+			c.Board.MaxCustomFieldsBytes = n
+		}
+	}
+	if interval := os.Getenv("BOARD_BULK_UPDATE_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Board.BulkUpdateInterval = d
+		}
+	}
+	if multiPin := os.Getenv("BOARD_ALLOW_MULTIPLE_PINNED_COMMENTS"); multiPin != "" {
+		if b, err := strconv.ParseBool(multiPin); err == nil {
+			c.Board.AllowMultiplePinnedComments = b
+		}
+	}
+	if reasons := os.Getenv("BOARD_ARCHIVE_REASONS"); reasons != "" {
+		c.Board.ArchiveReasons = nil
+		for _, reason := range strings.Split(reasons, ",") {
+			if reason = strings.TrimSpace(reason); reason != "" {
+				c.Board.ArchiveReasons = append(c.Board.ArchiveReasons, reason)
+			}
+		}
+	}
+
+	// Metrics
+	if interval := os.Getenv("METRICS_BUSINESS_COLLECT_INTERVAL"); interval != "" {
+		if d, err := time.ParseDuration(interval); err == nil {
+			c.Metrics.BusinessCollectInterval = d
+		}
+	}
 }
 
 // validate validates the configuration
@@ -310,6 +379,18 @@ func (c *Config) validate() error {
 	if c.UserAPI.Timeout == 0 {
 		return fmt.Errorf("user api timeout is required")
 	}
+	if c.Board.MaxBoardsPerProject < 0 {
+		return fmt.Errorf("board max_boards_per_project must not be negative")
+	}
+	if c.Board.MaxCustomFieldsBytes < 0 {
+		return fmt.Errorf("board max_custom_fields_bytes must not be negative")
+	}
+	if c.Board.BulkUpdateInterval < 0 {
+		return fmt.Errorf("board bulk_update_interval must not be negative")
+	}
+	if c.Metrics.BusinessCollectInterval < 0 {
+		return fmt.Errorf("metrics business_collect_interval must not be negative")
+	}
 
 	// Validate and normalize User API Base URL
 	if err := c.validateUserAPIBaseURL(); err != nil {
@@ -5,6 +5,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 
@@ -12,6 +15,37 @@ import (
 	"project-board-api/internal/repository"
 )
 
+// MaxCustomFieldValueLength is the longest custom field value accepted on write, in characters
+// It matches the length of field option values, so a longer value can never resolve to an option
+const MaxCustomFieldValueLength = 100
+
+// FieldValuesError reports every custom field value that failed validation, keyed by field
+type FieldValuesError struct {
+	Fields map[string]string
+}
+
+// Error lists the failed fields in a stable order
+func (e *FieldValuesError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, len(fields))
+	for i, field := range fields {
+		messages[i] = e.Fields[field]
+	}
+	return strings.Join(messages, "; ")
+}
+
+// ConvertedFields is one board's result of a batch value-to-ID conversion
+// Err is a *FieldValuesError when the board's values failed validation, in which case Fields is nil
+type ConvertedFields struct {
+	Fields map[string]interface{}
+	Err    error
+}
+
 // FieldOptionConverter handles conversion between field option values and IDs
 type FieldOptionConverter interface {
 	// ConvertValuesToIDs converts customFields from value strings to UUIDs
@@ -19,7 +53,12 @@ type FieldOptionConverter interface {
 	// Output: {"importance": "uuid-1", "stage": "uuid-2"}
 	ConvertValuesToIDs(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}) (map[string]interface{}, error)
 
+	// ConvertValuesToIDsBatch converts customFields for multiple boards of the same project efficiently
+	// Results are returned per input, in order, each with its own conversion error
+	ConvertValuesToIDsBatch(ctx context.Context, projectID uuid.UUID, customFields []map[string]interface{}) ([]ConvertedFields, error)
+
 	// ConvertIDsToValues converts customFields from UUIDs to value strings
+	// Archived options are still rendered so existing boards keep showing them
 	// Input: {"importance": "uuid-1", "stage": "uuid-2"}
 	// Output: {"importance": "high", "stage": "in_progress"}
 	ConvertIDsToValues(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error)
@@ -31,16 +70,51 @@ type FieldOptionConverter interface {
 // fieldOptionConverterImpl is the implementation of FieldOptionConverter
 type fieldOptionConverterImpl struct {
 	fieldOptionRepo repository.FieldOptionRepository
+
+	// allowArchivedOnWrite lets ConvertValuesToIDs select archived options (data migrations only)
+	allowArchivedOnWrite bool
+}
+
+// FieldOptionConverterOption configures optional FieldOptionConverter behaviour
+type FieldOptionConverterOption func(*fieldOptionConverterImpl)
+
+// WithAllowArchivedOnWrite lets archived options be written to boards, e.g. when migrating existing data
+func WithAllowArchivedOnWrite(allow bool) FieldOptionConverterOption {
+	return func(c *fieldOptionConverterImpl) {
+		c.allowArchivedOnWrite = allow
+	}
+}
+
+// archivedOnWriteKey marks a context whose conversions may select archived options
+type archivedOnWriteKey struct{}
+
+// WithArchivedOnWrite returns a context under which value-to-ID conversions accept archived options,
+// for writes that bring existing data back, such as restoring a project backup
+func WithArchivedOnWrite(ctx context.Context) context.Context {
+	return context.WithValue(ctx, archivedOnWriteKey{}, true)
+}
+
+// allowsArchived reports whether a conversion under ctx may select archived options
+func (c *fieldOptionConverterImpl) allowsArchived(ctx context.Context) bool {
+	allowed, _ := ctx.Value(archivedOnWriteKey{}).(bool)
+	return c.allowArchivedOnWrite || allowed
 }
 
 // NewFieldOptionConverter creates a new instance of FieldOptionConverter
-func NewFieldOptionConverter(fieldOptionRepo repository.FieldOptionRepository) FieldOptionConverter {
-	return &fieldOptionConverterImpl{
+func NewFieldOptionConverter(fieldOptionRepo repository.FieldOptionRepository, opts ...FieldOptionConverterOption) FieldOptionConverter {
+	c := &fieldOptionConverterImpl{
 		fieldOptionRepo: fieldOptionRepo,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 // ConvertValuesToIDs converts customFields from value strings to UUIDs
+// Values are trimmed of surrounding whitespace before lookup; empty and over-length values are rejected
+// Archived options are rejected unless the converter or ctx (see WithArchivedOnWrite) allows them on write
+// All fields are checked in one pass and invalid ones are reported together as a *FieldValuesError
 func (c *fieldOptionConverterImpl) ConvertValuesToIDs(
 	ctx context.Context,
 	projectID uuid.UUID,
@@ -50,31 +124,105 @@ func (c *fieldOptionConverterImpl) ConvertValuesToIDs(
 		return customFields, nil
 	}
 
+	return c.convertValues(customFields, c.allowsArchived(ctx), func(fieldType domain.FieldType, value string) (*domain.FieldOption, error) {
+		return c.fieldOptionRepo.FindByProjectAndFieldTypeAndValue(ctx, projectID, fieldType, value)
+	})
+}
+
+// ConvertValuesToIDsBatch converts the customFields of many boards in one project
+// The project's options are loaded once and shared by every conversion, so the cost does not grow with the number of boards
+// Each input gets its own result in the same order, with the same validation and errors as ConvertValuesToIDs;
+// the returned error is only set when the options could not be loaded
+func (c *fieldOptionConverterImpl) ConvertValuesToIDsBatch(
+	ctx context.Context,
+	projectID uuid.UUID,
+	customFields []map[string]interface{},
+) ([]ConvertedFields, error) {
+	results := make([]ConvertedFields, len(customFields))
+
+	var options map[domain.FieldType]map[string]*domain.FieldOption
+	for i, fields := range customFields {
+		if len(fields) == 0 {
+			results[i].Fields = fields
+			continue
+		}
+
+		if options == nil {
+			projectOptions, err := c.fieldOptionRepo.FindByProjectID(ctx, projectID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to find field options for project: %w", err)
+			}
+			options = make(map[domain.FieldType]map[string]*domain.FieldOption)
+			for _, option := range projectOptions {
+				if options[option.FieldType] == nil {
+					options[option.FieldType] = make(map[string]*domain.FieldOption)
+				}
+				options[option.FieldType][option.Value] = option
+			}
+		}
+
+		results[i].Fields, results[i].Err = c.convertValues(fields, c.allowsArchived(ctx), func(fieldType domain.FieldType, value string) (*domain.FieldOption, error) {
+			return options[fieldType][value], nil
+		})
+	}
+
+	return results, nil
+}
+
+// convertValues validates customFields and resolves each value to an option ID with lookup
+// lookup returns nil when the project has no option with that value
+func (c *fieldOptionConverterImpl) convertValues(
+	customFields map[string]interface{},
+	allowArchived bool,
+	lookup func(fieldType domain.FieldType, value string) (*domain.FieldOption, error),
+) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
+	invalid := make(map[string]string)
 
 	for fieldType, value := range customFields {
+		switch domain.FieldType(fieldType) {
+		case domain.FieldTypeStage, domain.FieldTypeRole, domain.FieldTypeImportance:
+		default:
+			invalid[fieldType] = fmt.Sprintf("unknown field '%s'", fieldType)
+			continue
+		}
+
 		valueStr, ok := value.(string)
 		if !ok {
-			return nil, fmt.Errorf("invalid value type for field '%s': expected string, got %T", fieldType, value)
+			invalid[fieldType] = fmt.Sprintf("invalid value type for field '%s': expected string, got %T", fieldType, value)
+			continue
 		}
 
-		// Query field option by project, field type, and value
-		option, err := c.fieldOptionRepo.FindByProjectAndFieldTypeAndValue(
-			ctx,
-			projectID,
-			domain.FieldType(fieldType),
-			valueStr,
-		)
+		valueStr = strings.TrimSpace(valueStr)
+		if valueStr == "" {
+			invalid[fieldType] = fmt.Sprintf("empty value for field '%s'", fieldType)
+			continue
+		}
+		if utf8.RuneCountInString(valueStr) > MaxCustomFieldValueLength {
+			invalid[fieldType] = fmt.Sprintf("value for field '%s' exceeds %d characters", fieldType, MaxCustomFieldValueLength)
+			continue
+		}
+
+		option, err := lookup(domain.FieldType(fieldType), valueStr)
 		if err != nil {
 			return nil, fmt.Errorf("failed to find field option for field '%s': %w", fieldType, err)
 		}
 		if option == nil {
-			return nil, fmt.Errorf("invalid field option value '%s' for field type '%s'", valueStr, fieldType)
+			invalid[fieldType] = fmt.Sprintf("invalid field option value '%s' for field type '%s'", valueStr, fieldType)
+			continue
+		}
+		if option.IsArchived && !allowArchived {
+			invalid[fieldType] = fmt.Sprintf("field option value '%s' for field type '%s' is archived", valueStr, fieldType)
+			continue
 		}
 
 		result[fieldType] = option.ID.String()
 	}
 
+	if len(invalid) > 0 {
+		return nil, &FieldValuesError{Fields: invalid}
+	}
+
 	return result, nil
 }
 
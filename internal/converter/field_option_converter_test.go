@@ -0,0 +1,259 @@
+package converter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+func setupConverterTestDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+
+	db.Exec(`CREATE TABLE field_options (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT,
+		field_type TEXT NOT NULL,
+		value TEXT NOT NULL,
+		label TEXT NOT NULL,
+		color TEXT NOT NULL,
+		display_order INTEGER NOT NULL DEFAULT 0,
+		is_system_default INTEGER NOT NULL DEFAULT 0,
+		is_archived INTEGER NOT NULL DEFAULT 0
+	)`)
+
+	return db
+}
+
+func TestFieldOptionConverter_ArchivedOptions(t *testing.T) {
+	db := setupConverterTestDB(t)
+	repo := repository.NewFieldOptionRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	archived := &domain.FieldOption{
+		BaseModel:  domain.BaseModel{ID: uuid.New()},
+		ProjectID:  &projectID,
+		FieldType:  domain.FieldTypeStage,
+		Value:      "on_hold",
+		Label:      "보류",
+		Color:      "#9CA3AF",
+		IsArchived: true,
+	}
+	if err := db.Create(archived).Error; err != nil {
+		t.Fatalf("failed to create field option: %v", err)
+	}
+
+	t.Run("writing an archived option is rejected", func(t *testing.T) {
+		conv := NewFieldOptionConverter(repo)
+		if _, err := conv.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{"stage": "on_hold"}); err == nil {
+			t.Error("ConvertValuesToIDs() expected error for archived option")
+		}
+	})
+
+	t.Run("migrations may write an archived option", func(t *testing.T) {
+		conv := NewFieldOptionConverter(repo, WithAllowArchivedOnWrite(true))
+		got, err := conv.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{"stage": "on_hold"})
+		if err != nil {
+			t.Fatalf("ConvertValuesToIDs() error = %v", err)
+		}
+		if got["stage"] != archived.ID.String() {
+			t.Errorf("stage = %v, want %v", got["stage"], archived.ID)
+		}
+	})
+
+	t.Run("a restoring context may write an archived option", func(t *testing.T) {
+		conv := NewFieldOptionConverter(repo)
+		results, err := conv.ConvertValuesToIDsBatch(WithArchivedOnWrite(ctx), projectID, []map[string]interface{}{{"stage": "on_hold"}})
+		if err != nil {
+			t.Fatalf("ConvertValuesToIDsBatch() error = %v", err)
+		}
+		if results[0].Err != nil || results[0].Fields["stage"] != archived.ID.String() {
+			t.Errorf("result = %+v, want stage %v", results[0], archived.ID)
+		}
+	})
+
+	t.Run("reading an existing archived option still renders it", func(t *testing.T) {
+		conv := NewFieldOptionConverter(repo)
+		got, err := conv.ConvertIDsToValues(ctx, map[string]interface{}{"stage": archived.ID.String()})
+		if err != nil {
+			t.Fatalf("ConvertIDsToValues() error = %v", err)
+		}
+		if got["stage"] != "on_hold" {
+			t.Errorf("stage = %v, want %q", got["stage"], "on_hold")
+		}
+	})
+}
+
+func TestFieldOptionConverter_NormalizesValues(t *testing.T) {
+	db := setupConverterTestDB(t)
+	repo := repository.NewFieldOptionRepository(db)
+	conv := NewFieldOptionConverter(repo)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	option := &domain.FieldOption{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: &projectID,
+		FieldType: domain.FieldTypeStage,
+		Value:     "in_progress",
+		Label:     "진행중",
+		Color:     "#3B82F6",
+	}
+	if err := db.Create(option).Error; err != nil {
+		t.Fatalf("failed to create field option: %v", err)
+	}
+
+	t.Run("surrounding whitespace is trimmed before lookup", func(t *testing.T) {
+		got, err := conv.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{"stage": "  in_progress\n"})
+		if err != nil {
+			t.Fatalf("ConvertValuesToIDs() error = %v", err)
+		}
+		if got["stage"] != option.ID.String() {
+			t.Errorf("stage = %v, want %v", got["stage"], option.ID)
+		}
+	})
+
+	t.Run("over-length value is rejected", func(t *testing.T) {
+		long := strings.Repeat("가", MaxCustomFieldValueLength+1)
+		if _, err := conv.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{"stage": long}); err == nil {
+			t.Error("ConvertValuesToIDs() expected error for over-length value")
+		}
+	})
+
+	t.Run("blank value is rejected", func(t *testing.T) {
+		if _, err := conv.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{"stage": "   "}); err == nil {
+			t.Error("ConvertValuesToIDs() expected error for blank value")
+		}
+	})
+}
+
+func TestFieldOptionConverter_ReportsAllInvalidFields(t *testing.T) {
+	db := setupConverterTestDB(t)
+	repo := repository.NewFieldOptionRepository(db)
+	conv := NewFieldOptionConverter(repo)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	option := &domain.FieldOption{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: &projectID,
+		FieldType: domain.FieldTypeStage,
+		Value:     "in_progress",
+		Label:     "진행중",
+		Color:     "#3B82F6",
+	}
+	if err := db.Create(option).Error; err != nil {
+		t.Fatalf("failed to create field option: %v", err)
+	}
+
+	_, err := conv.ConvertValuesToIDs(ctx, projectID, map[string]interface{}{
+		"stage":      "in_progress",
+		"importance": "hgih",
+		"role":       42,
+		"priority":   "high",
+	})
+
+	var fieldErr *FieldValuesError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("ConvertValuesToIDs() error = %v, want *FieldValuesError", err)
+	}
+	for _, field := range []string{"importance", "role", "priority"} {
+		if fieldErr.Fields[field] == "" {
+			t.Errorf("expected a message for %q, got %v", field, fieldErr.Fields)
+		}
+	}
+	if _, ok := fieldErr.Fields["stage"]; ok {
+		t.Errorf("valid field stage reported as invalid: %q", fieldErr.Fields["stage"])
+	}
+}
+
+func TestFieldOptionConverter_ConvertValuesToIDsBatch(t *testing.T) {
+	db := setupConverterTestDB(t)
+	repo := repository.NewFieldOptionRepository(db)
+	conv := NewFieldOptionConverter(repo)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	stage := &domain.FieldOption{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: &projectID,
+		FieldType: domain.FieldTypeStage,
+		Value:     "in_progress",
+		Label:     "진행중",
+		Color:     "#3B82F6",
+	}
+	importance := &domain.FieldOption{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: &projectID,
+		FieldType: domain.FieldTypeImportance,
+		Value:     "high",
+		Label:     "높음",
+		Color:     "#EF4444",
+	}
+	for _, option := range []*domain.FieldOption{stage, importance} {
+		if err := db.Create(option).Error; err != nil {
+			t.Fatalf("failed to create field option: %v", err)
+		}
+	}
+
+	var queries int
+	if err := db.Callback().Query().Before("gorm:query").Register("count_queries", func(*gorm.DB) {
+		queries++
+	}); err != nil {
+		t.Fatalf("failed to register query counter: %v", err)
+	}
+
+	customFields := make([]map[string]interface{}, 10)
+	for i := range customFields {
+		customFields[i] = map[string]interface{}{"stage": " in_progress ", "importance": "high"}
+	}
+	customFields[3] = map[string]interface{}{"stage": "done"}
+	customFields[7] = nil
+
+	results, err := conv.ConvertValuesToIDsBatch(ctx, projectID, customFields)
+	if err != nil {
+		t.Fatalf("ConvertValuesToIDsBatch() error = %v", err)
+	}
+	if queries != 1 {
+		t.Errorf("expected the project's options to be loaded once, got %d queries", queries)
+	}
+	if len(results) != len(customFields) {
+		t.Fatalf("expected %d results, got %d", len(customFields), len(results))
+	}
+
+	for i, result := range results {
+		switch i {
+		case 3:
+			var fieldErr *FieldValuesError
+			if !errors.As(result.Err, &fieldErr) || fieldErr.Fields["stage"] == "" {
+				t.Errorf("results[3].Err = %v, want a stage *FieldValuesError", result.Err)
+			}
+		case 7:
+			if result.Err != nil || result.Fields != nil {
+				t.Errorf("results[7] = %+v, want nil fields passed through", result)
+			}
+		default:
+			if result.Err != nil {
+				t.Errorf("results[%d].Err = %v", i, result.Err)
+				continue
+			}
+			if result.Fields["stage"] != stage.ID.String() || result.Fields["importance"] != importance.ID.String() {
+				t.Errorf("results[%d].Fields = %v", i, result.Fields)
+			}
+		}
+	}
+}
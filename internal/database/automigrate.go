@@ -25,11 +25,21 @@ func AutoMigrate(db *gorm.DB) error {
 		&domain.Project{},
 		&domain.ProjectMember{},
 		&domain.ProjectJoinRequest{},
+		&domain.Label{},
 		&domain.Board{},
 		&domain.Participant{},
+		&domain.ChecklistItem{},
+		&domain.BoardActivity{},
+		&domain.BoardTemplate{},
 		&domain.Comment{},
+		&domain.CommentRevision{},
 		&domain.FieldOption{},
 		&domain.Attachment{},
+		&domain.AttachmentAnnotation{},
+		&domain.AttachmentDeleteJob{},
+		&domain.WebhookSubscription{},
+		&domain.ThresholdRule{},
+		&domain.ThresholdRuleTrigger{},
 	}
 
 	// Run auto-migration for all models
@@ -52,11 +62,21 @@ func SafeAutoMigrate(db *gorm.DB, logger *zap.Logger) error {
 		{&domain.Project{}, "projects"},
 		{&domain.ProjectMember{}, "project_members"},
 		{&domain.ProjectJoinRequest{}, "project_join_requests"},
+		{&domain.Label{}, "labels"},
 		{&domain.Board{}, "boards"},
 		{&domain.Participant{}, "participants"},
+		{&domain.ChecklistItem{}, "checklist_items"},
+		{&domain.BoardActivity{}, "board_activities"},
+		{&domain.BoardTemplate{}, "board_templates"},
 		{&domain.Comment{}, "comments"},
+		{&domain.CommentRevision{}, "comment_revisions"},
 		{&domain.FieldOption{}, "field_options"},
 		{&domain.Attachment{}, "attachments"},
+		{&domain.AttachmentAnnotation{}, "attachment_annotations"},
+		{&domain.AttachmentDeleteJob{}, "attachment_delete_jobs"},
+		{&domain.WebhookSubscription{}, "webhook_subscriptions"},
+		{&domain.ThresholdRule{}, "threshold_rules"},
+		{&domain.ThresholdRuleTrigger{}, "threshold_rule_triggers"},
 	}
 
 	logger.Info("Starting safe auto-migration",
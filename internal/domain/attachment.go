@@ -23,23 +23,57 @@ const (
 	AttachmentStatusConfirmed AttachmentStatus = "CONFIRMED" // Confirmed status
 )
 
+// AttachmentVisibility controls which project members can see an attachment
+type AttachmentVisibility string
+
+const (
+	AttachmentVisibilityBoard      AttachmentVisibility = "BOARD"      // Every member who can see the entity
+	AttachmentVisibilityRestricted AttachmentVisibility = "RESTRICTED" // Project owners and admins, plus the uploader
+)
+
 // Attachment represents a file attachment associated with a board or project
 // This is a polymorphic relationship - EntityID can reference Board, Project, or Comment
 // ⚠️ IMPORTANT: Do not add foreign key constraints on EntityID as it references multiple tables
 type Attachment struct {
 	BaseModel
-	EntityType  EntityType       `gorm:"type:varchar(50);not null;index:idx_attachments_entity,priority:1" json:"entity_type"`
-	EntityID    *uuid.UUID       `gorm:"type:uuid;index:idx_attachments_entity,priority:2" json:"entity_id"` // ✅ FK 제거, 다형성 관계
-	Status      AttachmentStatus `gorm:"type:varchar(20);not null;default:'TEMP';index:idx_attachments_status" json:"status"`
-	FileName    string           `gorm:"type:varchar(255);not null" json:"file_name"`
-	FileURL     string           `gorm:"type:text;not null" json:"file_url"` // ✅ S3 key만 저장 (full URL 아님)
-	FileSize    int64            `gorm:"not null" json:"file_size"`
-	ContentType string           `gorm:"type:varchar(100);not null" json:"content_type"`
-	UploadedBy  uuid.UUID        `gorm:"type:uuid;not null;index:idx_attachments_uploaded_by" json:"uploaded_by"`
-	ExpiresAt   *time.Time       `gorm:"type:timestamp;index:idx_attachments_expires_at" json:"expires_at"`
+	EntityType     EntityType           `gorm:"type:varchar(50);not null;index:idx_attachments_entity,priority:1" json:"entity_type"`
+	EntityID       *uuid.UUID           `gorm:"type:uuid;index:idx_attachments_entity,priority:2" json:"entity_id"` // ✅ FK 제거, 다형성 관계
+	Status         AttachmentStatus     `gorm:"type:varchar(20);not null;default:'TEMP';index:idx_attachments_status" json:"status"`
+	FileName       string               `gorm:"type:varchar(255);not null" json:"file_name"`
+	FileURL        string               `gorm:"type:text;not null" json:"file_url"` // ✅ S3 key만 저장 (full URL 아님)
+	FileSize       int64                `gorm:"not null" json:"file_size"`
+	ContentType    string               `gorm:"type:varchar(100);not null" json:"content_type"`
+	UploadedBy     uuid.UUID            `gorm:"type:uuid;not null;index:idx_attachments_uploaded_by" json:"uploaded_by"`
+	ExpiresAt      *time.Time           `gorm:"type:timestamp;index:idx_attachments_expires_at" json:"expires_at"`
+	ChecksumSHA256 string               `gorm:"type:varchar(64)" json:"checksum_sha256"`  // hex SHA-256, computed at confirmation
+	DownloadCount  int64                `gorm:"not null;default:0" json:"download_count"` // flushed in batches, so it may lag recent downloads
+	Visibility     AttachmentVisibility `gorm:"type:varchar(20);not null;default:'BOARD'" json:"visibility"`
+	// ProjectID is the project the upload was made for, when the client named one
+	// A TEMP attachment with a project can only be confirmed to entities of that project
+	ProjectID *uuid.UUID `gorm:"type:uuid" json:"project_id"`
 }
 
 // TableName specifies the table name for Attachment
 func (Attachment) TableName() string {
 	return "attachments"
 }
+
+// IsRestricted reports whether only privileged roles and the uploader may see the attachment
+func (a *Attachment) IsRestricted() bool {
+	return a.Visibility == AttachmentVisibilityRestricted
+}
+
+// BelongsToOtherProject reports whether the attachment was uploaded for a project other than projectID
+// Attachments uploaded without a project context belong to none and never conflict
+func (a *Attachment) BelongsToOtherProject(projectID uuid.UUID) bool {
+	return a.ProjectID != nil && *a.ProjectID != projectID
+}
+
+// VisibleTo reports whether a user holding role in the attachment's project may see it
+// role is empty when the user is not a member of the project
+func (a *Attachment) VisibleTo(userID uuid.UUID, role ProjectRole) bool {
+	if !a.IsRestricted() {
+		return true
+	}
+	return a.UploadedBy == userID || role == ProjectRoleOwner || role == ProjectRoleAdmin
+}
@@ -0,0 +1,19 @@
+package domain
+
+import "github.com/google/uuid"
+
+// AttachmentAnnotation represents a positional marker left on an image attachment
+// X and Y are normalized coordinates (0..1) relative to the image width and height
+type AttachmentAnnotation struct {
+	BaseModel
+	AttachmentID uuid.UUID `gorm:"type:uuid;not null;index:idx_attachment_annotations_attachment_id" json:"attachment_id"`
+	AuthorID     uuid.UUID `gorm:"type:uuid;not null;index:idx_attachment_annotations_author_id" json:"author_id"`
+	X            float64   `gorm:"not null" json:"x"`
+	Y            float64   `gorm:"not null" json:"y"`
+	Content      string    `gorm:"type:text;not null" json:"content"`
+}
+
+// TableName specifies the table name for AttachmentAnnotation
+func (AttachmentAnnotation) TableName() string {
+	return "attachment_annotations"
+}
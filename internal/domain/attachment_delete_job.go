@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentDeleteJobStatus represents the state of a queued attachment deletion
+type AttachmentDeleteJobStatus string
+
+const (
+	AttachmentDeleteJobPending AttachmentDeleteJobStatus = "PENDING" // Waiting for its next attempt
+	AttachmentDeleteJobDead    AttachmentDeleteJobStatus = "DEAD"    // Gave up after the maximum number of attempts
+)
+
+// AttachmentDeleteJob is an outbox entry for deleting an attachment's S3 object and database row
+// Jobs are written in the same transaction as the change that drops the attachment,
+// so nothing is deleted from S3 unless that change commits
+type AttachmentDeleteJob struct {
+	BaseModel
+	AttachmentID  uuid.UUID                 `gorm:"type:uuid;not null;index:idx_attachment_delete_jobs_attachment_id" json:"attachment_id"`
+	FileKey       string                    `gorm:"type:text;not null" json:"file_key"` // S3 key, kept so the job does not depend on the row
+	Status        AttachmentDeleteJobStatus `gorm:"type:varchar(20);not null;default:'PENDING';index:idx_attachment_delete_jobs_due,priority:1" json:"status"`
+	Attempts      int                       `gorm:"not null;default:0" json:"attempts"`
+	NextAttemptAt time.Time                 `gorm:"type:timestamp;not null;index:idx_attachment_delete_jobs_due,priority:2" json:"next_attempt_at"`
+	LastError     string                    `gorm:"type:text" json:"last_error"`
+}
+
+// TableName specifies the table name for AttachmentDeleteJob
+func (AttachmentDeleteJob) TableName() string {
+	return "attachment_delete_jobs"
+}
@@ -10,22 +10,41 @@ import (
 // Board represents a work board entity within a project
 type Board struct {
 	BaseModel
-	ProjectID    uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_project_id" json:"project_id"`
-	AuthorID     uuid.UUID      `gorm:"type:uuid;not null;index:idx_boards_author_id" json:"author_id"`
-	AssigneeID   *uuid.UUID     `gorm:"type:uuid;index:idx_boards_assignee_id" json:"assignee_id"`
-	Title        string         `gorm:"type:varchar(255);not null" json:"title"`
-	Content      string         `gorm:"type:text" json:"content"`
-	CustomFields datatypes.JSON `gorm:"type:jsonb" json:"custom_fields"`
-	StartDate    *time.Time     `gorm:"type:timestamp;index:idx_boards_start_date" json:"start_date"`
-	DueDate      *time.Time     `gorm:"type:timestamp;index:idx_boards_due_date" json:"due_date"`
-	Project      Project        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
-	Participants []Participant  `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"participants,omitempty"`
-	Comments     []Comment      `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"comments,omitempty"`
+	ProjectID      uuid.UUID       `gorm:"type:uuid;not null;index:idx_boards_project_id;index:idx_boards_project_open_due,priority:1,where:due_date IS NOT NULL AND deleted_at IS NULL;uniqueIndex:idx_boards_project_external_id,priority:1,where:external_id IS NOT NULL AND deleted_at IS NULL;uniqueIndex:idx_boards_project_unique_title,priority:1,where:title_unique AND deleted_at IS NULL" json:"project_id"`
+	ExternalID     *string         `gorm:"type:varchar(255);uniqueIndex:idx_boards_project_external_id,priority:2" json:"external_id"` // key of the board in the system it was imported from
+	AuthorID       uuid.UUID       `gorm:"type:uuid;not null;index:idx_boards_author_id" json:"author_id"`
+	AssigneeID     *uuid.UUID      `gorm:"type:uuid;index:idx_boards_assignee_id" json:"assignee_id"`
+	Title          string          `gorm:"type:varchar(255);not null;uniqueIndex:idx_boards_project_unique_title,priority:2" json:"title"`
+	Content        string          `gorm:"type:text" json:"content"`
+	CustomFields   datatypes.JSON  `gorm:"type:jsonb" json:"custom_fields"`
+	StartDate      *time.Time      `gorm:"type:timestamp;index:idx_boards_start_date" json:"start_date"`
+	DueDate        *time.Time      `gorm:"type:timestamp;index:idx_boards_due_date;index:idx_boards_project_open_due,priority:2" json:"due_date"`
+	EstimateHours  *float64        `gorm:"type:numeric(10,2)" json:"estimate_hours"`  // planned effort
+	ActualHours    *float64        `gorm:"type:numeric(10,2)" json:"actual_hours"`    // spent effort
+	Version        int64           `gorm:"not null;default:1" json:"version"`         // bumped by every update, for optimistic locking
+	TitleUnique    bool            `gorm:"not null;default:false" json:"-"`           // set while the project enforces unique titles
+	ArchivedAt     *time.Time      `gorm:"type:timestamp" json:"archived_at"`         // set while archived; archived boards are left out of lists
+	ArchiveReason  *string         `gorm:"type:varchar(100)" json:"archive_reason"`   // why the board was archived, cleared on restore
+	LastActivityAt *time.Time      `gorm:"type:timestamp" json:"last_activity_at"`    // time of the latest recorded activity, compared with participants' LastSeenAt
+	Overdue        *bool           `gorm:"->;-:migration;column:is_overdue" json:"-"` // computed by list queries, nil otherwise
+	Project        Project         `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
+	Participants   []Participant   `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"participants,omitempty"`
+	Comments       []Comment       `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"comments,omitempty"`
+	Labels         []Label         `gorm:"many2many:board_labels;constraint:OnDelete:CASCADE" json:"labels,omitempty"`
+	ChecklistItems []ChecklistItem `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"checklist_items,omitempty"`
 	// ✅ 수정: Attachments는 다형성 관계이므로 FK 제거, Repository에서 별도 조회
 	Attachments []Attachment `gorm:"-" json:"attachments,omitempty"`
+	// Cover is the board's cover image; only list queries load it
+	Cover *Attachment `gorm:"-" json:"-"`
 }
 
 // TableName specifies the table name for Board
 func (Board) TableName() string {
 	return "boards"
 }
+
+// IsOverdue reports whether the board's due date has passed at now
+// A board due exactly at now is not overdue yet
+func (b *Board) IsOverdue(now time.Time) bool {
+	return b.DueDate != nil && b.DueDate.Before(now)
+}
@@ -0,0 +1,20 @@
+package domain
+
+import "github.com/google/uuid"
+
+// BoardActivity records one field changed by a board update
+// Entries are append-only; CreatedAt is the time of the change
+// Values are human-readable: custom fields hold option values rather than option IDs
+type BoardActivity struct {
+	BaseModel
+	BoardID  uuid.UUID `gorm:"type:uuid;not null;index:idx_board_activities_board_id" json:"board_id"`
+	ActorID  uuid.UUID `gorm:"type:uuid;not null" json:"actor_id"` // uuid.Nil when no user is in the request context
+	Field    string    `gorm:"type:varchar(100);not null" json:"field"`
+	OldValue string    `gorm:"type:text" json:"old_value"`
+	NewValue string    `gorm:"type:text" json:"new_value"`
+}
+
+// TableName specifies the table name for BoardActivity
+func (BoardActivity) TableName() string {
+	return "board_activities"
+}
@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// BoardTemplatePlaceholderDate is replaced with the creation date (YYYY-MM-DD) in template patterns
+const BoardTemplatePlaceholderDate = "{{date}}"
+
+// BoardTemplate is a reusable snapshot of a board's configuration within a project
+// Custom fields are kept as option values rather than IDs, so they are resolved again on every use
+type BoardTemplate struct {
+	BaseModel
+	ProjectID      uuid.UUID                      `gorm:"type:uuid;not null;index:idx_board_templates_project_id" json:"project_id"`
+	Name           string                         `gorm:"type:varchar(200);not null" json:"name"`
+	TitlePattern   string                         `gorm:"type:varchar(200);not null" json:"title_pattern"`
+	ContentPattern string                         `gorm:"type:text" json:"content_pattern"`
+	CustomFields   datatypes.JSON                 `gorm:"type:jsonb" json:"custom_fields"` // option values, e.g. {"stage": "in_progress"}
+	AssigneeID     *uuid.UUID                     `gorm:"type:uuid" json:"assignee_id"`
+	ParticipantIDs datatypes.JSONSlice[uuid.UUID] `gorm:"type:jsonb" json:"participant_ids"`
+	CreatedBy      uuid.UUID                      `gorm:"type:uuid;not null" json:"created_by"`
+	Project        Project                        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for BoardTemplate
+func (BoardTemplate) TableName() string {
+	return "board_templates"
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "github.com/google/uuid"
+
+// ChecklistItem is a single checkable step on a board
+// Items of a board are ordered by Position, starting at 0
+type ChecklistItem struct {
+	BaseModel
+	BoardID  uuid.UUID `gorm:"type:uuid;not null;index:idx_checklist_items_board_position,priority:1" json:"board_id"`
+	Text     string    `gorm:"type:varchar(500);not null" json:"text"`
+	Done     bool      `gorm:"not null;default:false" json:"done"`
+	Position int       `gorm:"not null;default:0;index:idx_checklist_items_board_position,priority:2" json:"position"`
+}
+
+// TableName specifies the table name for ChecklistItem
+func (ChecklistItem) TableName() string {
+	return "checklist_items"
+}
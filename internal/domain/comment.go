@@ -1,6 +1,10 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // Comment represents a comment on a board
 type Comment struct {
@@ -8,7 +12,9 @@ type Comment struct {
 	BoardID uuid.UUID `gorm:"type:uuid;not null;index:idx_comments_board_id" json:"board_id"`
 	UserID  uuid.UUID `gorm:"type:uuid;not null;index:idx_comments_user_id" json:"user_id"`
 	Content string    `gorm:"type:text;not null" json:"content"`
-	Board   Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
+	// PinnedAt is set while the comment is pinned to the top of its board
+	PinnedAt *time.Time `gorm:"type:timestamp" json:"pinned_at"`
+	Board    Board      `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
 	// ✅ 수정: Attachments는 다형성 관계이므로 FK 제거, Repository에서 별도 조회
 	Attachments []Attachment `gorm:"-" json:"attachments,omitempty"`
 }
@@ -0,0 +1,16 @@
+package domain
+
+import "github.com/google/uuid"
+
+// CommentRevision is a previous body of an edited comment
+// Revisions are append-only; CreatedAt is the time the body was replaced
+type CommentRevision struct {
+	BaseModel
+	CommentID uuid.UUID `gorm:"type:uuid;not null;index:idx_comment_revisions_comment_id" json:"comment_id"`
+	Content   string    `gorm:"type:text;not null" json:"content"`
+}
+
+// TableName specifies the table name for CommentRevision
+func (CommentRevision) TableName() string {
+	return "comment_revisions"
+}
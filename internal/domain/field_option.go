@@ -22,6 +22,7 @@ type FieldOption struct {
 	Color           string     `gorm:"type:varchar(20);not null" json:"color"`
 	DisplayOrder    int        `gorm:"type:int;not null;default:0;index:idx_field_options_display_order" json:"display_order"`
 	IsSystemDefault bool       `gorm:"type:boolean;not null;default:false" json:"is_system_default"`
+	IsArchived      bool       `gorm:"type:boolean;not null;default:false" json:"is_archived"` // kept for existing boards, not selectable for new values
 	Project         *Project   `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"project,omitempty"`
 }
 
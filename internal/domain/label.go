@@ -0,0 +1,17 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Label represents a colored tag that categorizes boards within a project
+type Label struct {
+	BaseModel
+	ProjectID uuid.UUID `gorm:"type:uuid;not null;index:idx_labels_project_id;uniqueIndex:uq_labels_project_name,priority:1,where:deleted_at IS NULL" json:"project_id"`
+	Name      string    `gorm:"type:varchar(50);not null;uniqueIndex:uq_labels_project_name,priority:2" json:"name"`
+	Color     string    `gorm:"type:varchar(20);not null" json:"color"`
+	Project   Project   `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for Label
+func (Label) TableName() string {
+	return "labels"
+}
@@ -1,13 +1,27 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // Participant represents a user participating in a board
 type Participant struct {
 	BaseModel
 	BoardID uuid.UUID `gorm:"type:uuid;not null;index:idx_participants_board_id;uniqueIndex:uq_participants_board_user" json:"board_id"`
 	UserID  uuid.UUID `gorm:"type:uuid;not null;index:idx_participants_user_id;uniqueIndex:uq_participants_board_user" json:"user_id"`
-	Board   Board     `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
+	// LastSeenAt is when the user last marked the board as seen; nil if never
+	LastSeenAt *time.Time `gorm:"type:timestamp" json:"last_seen_at"`
+	Board      Board      `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"board,omitempty"`
+}
+
+// HasUnread reports whether the board had activity since the participant last saw it
+func (p *Participant) HasUnread(lastActivityAt *time.Time) bool {
+	if lastActivityAt == nil {
+		return false
+	}
+	return p.LastSeenAt == nil || p.LastSeenAt.Before(*lastActivityAt)
 }
 
 // TableName specifies the table name for Participant
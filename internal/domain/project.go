@@ -22,6 +22,8 @@ type Project struct {
 	JoinRequests []ProjectJoinRequest `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"join_requests,omitempty"`
 	// ✅ 수정: Attachments는 다형성 관계이므로 FK 제거, Repository에서 별도 조회
 	Attachments []Attachment `gorm:"-" json:"attachments,omitempty"`
+	// EnforceUniqueTitles rejects a board whose title is already used by another board of the project
+	EnforceUniqueTitles bool `gorm:"not null;default:false" json:"enforce_unique_titles"`
 }
 
 // ProjectRole represents the role of a project member
@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// ThresholdField names a numeric board field a threshold rule can watch
+type ThresholdField string
+
+const (
+	ThresholdFieldEstimateHours ThresholdField = "estimateHours"
+	ThresholdFieldActualHours   ThresholdField = "actualHours"
+)
+
+// ThresholdOperator compares a watched field with a rule's threshold
+type ThresholdOperator string
+
+const (
+	ThresholdOperatorGreater        ThresholdOperator = "gt"
+	ThresholdOperatorGreaterOrEqual ThresholdOperator = "gte"
+	ThresholdOperatorLess           ThresholdOperator = "lt"
+	ThresholdOperatorLessOrEqual    ThresholdOperator = "lte"
+)
+
+// ThresholdRule alerts its recipients when a numeric field of a board in the project crosses Threshold
+// Recipients are alerted once when the condition becomes true for a board, and again only after it has reset
+type ThresholdRule struct {
+	BaseModel
+	ProjectID  uuid.UUID                      `gorm:"type:uuid;not null;index:idx_threshold_rules_project_id" json:"project_id"`
+	Field      ThresholdField                 `gorm:"type:varchar(50);not null" json:"field"`
+	Operator   ThresholdOperator              `gorm:"type:varchar(10);not null" json:"operator"`
+	Threshold  float64                        `gorm:"not null" json:"threshold"`
+	Recipients datatypes.JSONSlice[uuid.UUID] `gorm:"not null" json:"recipients"`
+	CreatedBy  uuid.UUID                      `gorm:"type:uuid;not null" json:"created_by"`
+	Project    Project                        `gorm:"foreignKey:ProjectID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for ThresholdRule
+func (ThresholdRule) TableName() string {
+	return "threshold_rules"
+}
+
+// Matches reports whether value satisfies the rule's condition
+func (r *ThresholdRule) Matches(value float64) bool {
+	switch r.Operator {
+	case ThresholdOperatorGreater:
+		return value > r.Threshold
+	case ThresholdOperatorGreaterOrEqual:
+		return value >= r.Threshold
+	case ThresholdOperatorLess:
+		return value < r.Threshold
+	case ThresholdOperatorLessOrEqual:
+		return value <= r.Threshold
+	}
+	return false
+}
+
+// FieldValue returns the value of a board field the rule can watch; ok is false while the field is unset
+func (f ThresholdField) FieldValue(board *Board) (value float64, ok bool) {
+	var field *float64
+	switch f {
+	case ThresholdFieldEstimateHours:
+		field = board.EstimateHours
+	case ThresholdFieldActualHours:
+		field = board.ActualHours
+	}
+	if field == nil {
+		return 0, false
+	}
+	return *field, true
+}
+
+// ThresholdRuleTrigger marks a board for which a rule's condition currently holds
+// It is created when the condition becomes true and deleted when it no longer holds,
+// so recipients are not alerted again while the board stays over the threshold
+type ThresholdRuleTrigger struct {
+	RuleID      uuid.UUID     `gorm:"type:uuid;primaryKey" json:"rule_id"`
+	BoardID     uuid.UUID     `gorm:"type:uuid;primaryKey;index:idx_threshold_rule_triggers_board_id" json:"board_id"`
+	TriggeredAt time.Time     `gorm:"not null" json:"triggered_at"`
+	Rule        ThresholdRule `gorm:"foreignKey:RuleID;constraint:OnDelete:CASCADE" json:"-"`
+	Board       Board         `gorm:"foreignKey:BoardID;constraint:OnDelete:CASCADE" json:"-"`
+}
+
+// TableName specifies the table name for ThresholdRuleTrigger
+func (ThresholdRuleTrigger) TableName() string {
+	return "threshold_rule_triggers"
+}
+
+// ThresholdAlert describes a board crossing a threshold rule, delivered with board.threshold_alert events
+type ThresholdAlert struct {
+	RuleID     uuid.UUID         `json:"ruleId"`
+	Field      ThresholdField    `json:"field"`
+	Operator   ThresholdOperator `json:"operator"`
+	Threshold  float64           `json:"threshold"`
+	Value      float64           `json:"value"`
+	Recipients []uuid.UUID       `json:"recipients"`
+}
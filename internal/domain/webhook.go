@@ -0,0 +1,54 @@
+package domain
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+)
+
+// WebhookEventType identifies a board change that webhook subscribers can be notified of
+type WebhookEventType string
+
+const (
+	WebhookEventBoardCreated WebhookEventType = "board.created"
+	WebhookEventBoardUpdated WebhookEventType = "board.updated"
+	WebhookEventBoardDeleted WebhookEventType = "board.deleted"
+	// WebhookEventBoardThresholdAlert is sent when a board crosses a ThresholdRule, for delivery to the rule's recipients
+	WebhookEventBoardThresholdAlert WebhookEventType = "board.threshold_alert"
+)
+
+// WebhookSubscription registers a URL to be notified of board events in a project
+// Deliveries are signed with an HMAC-SHA256 of the body keyed by Secret
+type WebhookSubscription struct {
+	BaseModel
+	ProjectID uuid.UUID                   `gorm:"type:uuid;not null;index:idx_webhook_subscriptions_project_id" json:"project_id"`
+	TargetURL string                      `gorm:"type:varchar(2048);not null" json:"target_url"`
+	Events    datatypes.JSONSlice[string] `gorm:"not null" json:"events"`
+	Secret    string                      `gorm:"type:varchar(128);not null" json:"-"`
+	CreatedBy uuid.UUID                   `gorm:"type:uuid;not null" json:"created_by"`
+}
+
+// TableName specifies the table name for WebhookSubscription
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// Subscribes reports whether the subscription wants events of the given type
+func (s *WebhookSubscription) Subscribes(eventType WebhookEventType) bool {
+	return slices.Contains(s.Events, string(eventType))
+}
+
+// WebhookEvent describes a board change delivered to webhook subscribers
+type WebhookEvent struct {
+	Type      WebhookEventType `json:"type"`
+	ProjectID uuid.UUID        `json:"projectId"`
+	BoardID   uuid.UUID        `json:"boardId"`
+	// ChangedFields names the fields changed by a board.updated event, as in the board activity log
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// Alert is set on board.threshold_alert events
+	Alert      *ThresholdAlert `json:"alert,omitempty"`
+	ActorID    uuid.UUID       `json:"actorId"`
+	OccurredAt time.Time       `json:"occurredAt"`
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateAnnotationRequest represents the request to add an annotation to an image attachment
+// @Description x and y are normalized coordinates between 0 and 1 (relative to image width/height)
+type CreateAnnotationRequest struct {
+	X       *float64 `json:"x" binding:"required" example:"0.25"`
+	Y       *float64 `json:"y" binding:"required" example:"0.75"`
+	Content string   `json:"content" binding:"required,min=1,max=1000" example:"Logo should be larger here"`
+}
+
+// AnnotationResponse represents an attachment annotation
+type AnnotationResponse struct {
+	ID           uuid.UUID `json:"annotationId" example:"c3d4e5f6-a7b8-9012-cdef-123456789012"`
+	AttachmentID uuid.UUID `json:"attachmentId" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	AuthorID     uuid.UUID `json:"authorId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	X            float64   `json:"x" example:"0.25"`
+	Y            float64   `json:"y" example:"0.75"`
+	Content      string    `json:"content" example:"Logo should be larger here"`
+	CreatedAt    time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
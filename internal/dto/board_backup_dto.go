@@ -0,0 +1,88 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardBackupManifest is the first entry of a project backup and describes everything the boards refer to
+// Boards store custom fields as option values, so the field options are needed to restore them into another project
+type BoardBackupManifest struct {
+	FormatVersion int                      `json:"formatVersion"`
+	ProjectID     uuid.UUID                `json:"projectId"`
+	ExportedAt    time.Time                `json:"exportedAt"`
+	FieldOptions  []BoardBackupFieldOption `json:"fieldOptions"`
+	Labels        []BoardBackupLabel       `json:"labels"`
+}
+
+// BoardBackupFieldOption is a custom field option of the backed-up project
+type BoardBackupFieldOption struct {
+	FieldType    string `json:"fieldType"`
+	Value        string `json:"value"`
+	Label        string `json:"label"`
+	Color        string `json:"color"`
+	DisplayOrder int    `json:"displayOrder"`
+	IsArchived   bool   `json:"isArchived"`
+}
+
+// BoardBackupLabel is a label of the backed-up project
+type BoardBackupLabel struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// BoardBackupRecord is one board of a project backup
+// Custom fields hold option values and labels hold names; attachments list the objects that follow the record
+type BoardBackupRecord struct {
+	ID            uuid.UUID               `json:"id"`
+	Title         string                  `json:"title"`
+	Content       string                  `json:"content"`
+	AuthorID      uuid.UUID               `json:"authorId"`
+	AssigneeID    *uuid.UUID              `json:"assigneeId"`
+	Participants  []uuid.UUID             `json:"participants"`
+	CustomFields  map[string]interface{}  `json:"customFields"`
+	Labels        []string                `json:"labels"`
+	StartDate     *time.Time              `json:"startDate"`
+	DueDate       *time.Time              `json:"dueDate"`
+	EstimateHours *float64                `json:"estimateHours"`
+	ActualHours   *float64                `json:"actualHours"`
+	ExternalID    *string                 `json:"externalId"`
+	ArchivedAt    *time.Time              `json:"archivedAt"`
+	ArchiveReason *string                 `json:"archiveReason"`
+	CreatedAt     time.Time               `json:"createdAt"`
+	UpdatedAt     time.Time               `json:"updatedAt"`
+	Attachments   []BoardBackupAttachment `json:"attachments"`
+}
+
+// BoardBackupAttachment is the metadata of a board attachment whose object is stored in the backup
+type BoardBackupAttachment struct {
+	ID             uuid.UUID `json:"id"`
+	FileName       string    `json:"fileName"`
+	ContentType    string    `json:"contentType"`
+	FileSize       int64     `json:"fileSize"`
+	ChecksumSHA256 string    `json:"checksumSha256"`
+	UploadedBy     uuid.UUID `json:"uploadedBy"`
+	Visibility     string    `json:"visibility"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// ImportBoardBackupResponse summarizes a restored project backup
+type ImportBoardBackupResponse struct {
+	Boards       int `json:"boards" example:"42"`
+	Failed       int `json:"failed" example:"0"` // boards that could not be restored, listed in issues
+	Attachments  int `json:"attachments" example:"7"`
+	FieldOptions int `json:"fieldOptions" example:"2"` // options created because the project did not define them yet
+	Labels       int `json:"labels" example:"3"`       // labels created because the project did not define them yet
+	// BoardIDs maps each backed-up board ID to the ID of the board restored from it
+	BoardIDs map[uuid.UUID]uuid.UUID `json:"boardIds"`
+	// Issues lists the boards restored with a changed title or external ID and the boards that could not be restored
+	Issues []BoardBackupIssue `json:"issues,omitempty"`
+}
+
+// BoardBackupIssue reports a backed-up board that was restored with changes, or not restored at all
+type BoardBackupIssue struct {
+	SourceBoardID uuid.UUID `json:"sourceBoardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Restored      bool      `json:"restored" example:"true"`
+	Message       string    `json:"message" example:"external ID 'JIRA-42' is already used in the project; restored without it"`
+}
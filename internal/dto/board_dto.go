@@ -2,6 +2,7 @@
 package dto
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,6 +15,8 @@ import (
 // @Description Example values: stage="in_progress", role="developer", importance="high"
 // @Description participants is an optional array of user IDs to add as board participants (max 50)
 // @Description attachmentIds is an optional array of attachment IDs to link to the board
+// @Description startDate and dueDate are RFC3339 timestamps stored in UTC; a value at midnight in its own offset
+// @Description (e.g. "2024-03-01T00:00:00+09:00") is an all-day date and is stored as that calendar day at midnight UTC
 type CreateBoardRequest struct {
 	ProjectID     uuid.UUID              `json:"projectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
 	Title         string                 `json:"title" binding:"required,min=1,max=200" example:"Implement user authentication"`
@@ -22,8 +25,11 @@ type CreateBoardRequest struct {
 	AssigneeID    *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
 	StartDate     *time.Time             `json:"startDate" example:"2024-01-01T00:00:00Z"`
 	DueDate       *time.Time             `json:"dueDate" example:"2024-12-31T23:59:59Z"`
+	EstimateHours *float64               `json:"estimateHours" example:"8"`
+	ActualHours   *float64               `json:"actualHours" example:"6.5"`
 	Participants  []uuid.UUID            `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890,b2c3d4e5-f6a7-8901-bcde-f12345678901"`
 	AttachmentIDs []uuid.UUID            `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	ExternalID    *string                `json:"externalId,omitempty" binding:"omitempty,min=1,max=255" example:"JIRA-1042"`
 }
 
 // UpdateBoardRequest represents the request to update a board
@@ -32,15 +38,31 @@ type CreateBoardRequest struct {
 // @Description Valid field types: stage, role, importance
 // @Description Example values: stage="completed", role="designer", importance="medium"
 // @Description attachmentIds is an optional array of attachment IDs to add to the board
+// @Description startDate and dueDate can be set to null to clear them
+// @Description Dates are normalized to UTC the same way as on create, including all-day dates
 type UpdateBoardRequest struct {
 	Title         *string                 `json:"title" binding:"omitempty,min=1,max=200" example:"Update user authentication"`
 	Content       *string                 `json:"content" binding:"omitempty,max=5000" example:"Refactor JWT implementation"`
 	CustomFields  *map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:medium"`
 	AssigneeID    *uuid.UUID              `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
-	StartDate     *time.Time              `json:"startDate" example:"2024-01-01T00:00:00Z"`
-	DueDate       *time.Time              `json:"dueDate" example:"2024-12-31T23:59:59Z"`
+	StartDate     Optional[time.Time]     `json:"startDate,omitzero" swaggertype:"string" format:"date-time" example:"2024-01-01T00:00:00Z"`
+	DueDate       Optional[time.Time]     `json:"dueDate,omitzero" swaggertype:"string" format:"date-time" example:"2024-12-31T23:59:59Z"`
+	EstimateHours *float64                `json:"estimateHours" example:"8"`
+	ActualHours   *float64                `json:"actualHours" example:"6.5"`
 	Participants  []uuid.UUID             `json:"participants,omitempty" binding:"omitempty,max=50,dive,uuid"`
 	AttachmentIDs []uuid.UUID             `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	// LabelIDs replaces the board's labels; an empty array removes them all
+	LabelIDs []uuid.UUID `json:"labelIds" binding:"omitempty,max=50" example:"0f8fad5b-d9cb-469f-a165-70867728950e"`
+	// ExpectedVersion rejects the update with 409 if the board changed since the client read it
+	ExpectedVersion *int64 `json:"expectedVersion,omitempty" example:"3"`
+}
+
+// PatchOp represents a single RFC 6902 JSON Patch operation on a board
+// @Description Supported paths: /title, /content, /assigneeId, /startDate, /dueDate, /estimateHours, /actualHours, /customFields, /customFields/{fieldType}
+type PatchOp struct {
+	Op    string          `json:"op" binding:"required,oneof=add remove replace test" example:"replace"`
+	Path  string          `json:"path" binding:"required" example:"/title"`
+	Value json.RawMessage `json:"value,omitempty" swaggertype:"object"`
 }
 
 // UpdateBoardFieldRequest represents the request to update a single board field
@@ -60,6 +82,52 @@ type AttachmentResponse struct {
 	ContentType string    `json:"contentType" example:"application/pdf"`
 	UploadedBy  uuid.UUID `json:"uploadedBy" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
 	UploadedAt  time.Time `json:"uploadedAt" example:"2024-01-15T10:30:00Z"`
+	// ChecksumSHA256 lets clients verify the downloaded file; empty if it could not be computed
+	ChecksumSHA256 string `json:"checksumSha256,omitempty" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	// DownloadCount is the number of download URLs issued; recent downloads may not be reflected yet
+	DownloadCount int64 `json:"downloadCount" example:"12"`
+	// Visibility is BOARD, or RESTRICTED for files only project owners, admins and the uploader can see
+	Visibility string `json:"visibility" example:"BOARD"`
+}
+
+// AttachmentFilter narrows the attachments returned by ListAttachments
+type AttachmentFilter struct {
+	// ContentType matches a full type ("image/png") or, with a trailing slash, a type family ("image/")
+	ContentType string `form:"contentType" example:"image/"`
+	// IncludePending also returns uploads that were never confirmed
+	IncludePending bool `form:"includePending" example:"false"`
+}
+
+// AttachmentPagination selects the order and page of ListAttachments
+// Cursor is the nextCursor of the previous page; sortBy is createdAt (default) or size
+type AttachmentPagination struct {
+	SortBy string `form:"sortBy" example:"createdAt"`
+	Order  string `form:"order" example:"desc"`
+	Cursor string `form:"cursor" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Limit  int    `form:"limit" example:"20"`
+}
+
+// AttachmentPageResponse is one page of a board's attachments
+// NextCursor is empty on the last page
+type AttachmentPageResponse struct {
+	Attachments []AttachmentResponse `json:"attachments"`
+	NextCursor  string               `json:"nextCursor,omitempty" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+}
+
+// CloneBoardRequest selects which parts of a board CloneBoard copies
+// @Description Omitted flags use the defaults: custom fields and participants are copied,
+// @Description attachments and comments are not, so discussions are never duplicated by accident
+type CloneBoardRequest struct {
+	IncludeAttachments  *bool `json:"includeAttachments" example:"false"`
+	IncludeParticipants *bool `json:"includeParticipants" example:"true"`
+	IncludeComments     *bool `json:"includeComments" example:"false"`
+	IncludeCustomFields *bool `json:"includeCustomFields" example:"true"`
+}
+
+// ArchiveBoardRequest records why a board is archived
+// @Description reason is optional; when the service restricts archive reasons it must be one of them
+type ArchiveBoardRequest struct {
+	Reason string `json:"reason" binding:"max=100" example:"completed"`
 }
 
 // BoardResponse represents the board response
@@ -67,20 +135,152 @@ type AttachmentResponse struct {
 // @Description customFields contains field type as key and value string as value (not UUIDs)
 // @Description Example: {"importance": "high", "role": "developer", "stage": "in_progress"}
 // @Description participantIds contains an array of user IDs who are participants of the board
+// @Description Every timestamp is RFC3339 in UTC (e.g. "2024-01-15T10:30:00Z"); all-day start and due dates are midnight UTC
 type BoardResponse struct {
-	ID             uuid.UUID              `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
-	ProjectID      uuid.UUID              `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
-	AuthorID       uuid.UUID              `json:"authorId" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
-	AssigneeID     *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
-	Title          string                 `json:"title" example:"Implement user authentication"`
-	Content        string                 `json:"content" example:"Add JWT-based authentication to the API"`
-	CustomFields   map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:high"`
-	StartDate      *time.Time             `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
-	DueDate        *time.Time             `json:"dueDate,omitempty" example:"2024-12-31T23:59:59Z"`
-	ParticipantIDs []uuid.UUID            `json:"participantIds" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890,b2c3d4e5-f6a7-8901-bcde-f12345678901"`
-	Attachments    []AttachmentResponse   `json:"attachments"`
-	CreatedAt      time.Time              `json:"createdAt" example:"2024-01-15T10:30:00Z"`
-	UpdatedAt      time.Time              `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
+	ID             uuid.UUID                 `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ProjectID      uuid.UUID                 `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	AuthorID       uuid.UUID                 `json:"authorId" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	AssigneeID     *uuid.UUID                `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Title          string                    `json:"title" example:"Implement user authentication"`
+	Content        string                    `json:"content" example:"Add JWT-based authentication to the API"`
+	CustomFields   map[string]interface{}    `json:"customFields" swaggertype:"object,string" example:"importance:high"`
+	ExternalID     *string                   `json:"externalId,omitempty" example:"JIRA-1042"`
+	StartDate      *time.Time                `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
+	DueDate        *time.Time                `json:"dueDate,omitempty" example:"2024-12-31T23:59:59Z"`
+	EstimateHours  *float64                  `json:"estimateHours,omitempty" example:"8"`
+	ActualHours    *float64                  `json:"actualHours,omitempty" example:"6.5"`
+	Variance       *float64                  `json:"variance,omitempty" example:"-1.5"` // actualHours - estimateHours
+	IsOverdue      bool                      `json:"isOverdue" example:"false"`         // dueDate has passed
+	HasUnread      bool                      `json:"hasUnread" example:"false"`         // activity since the requesting participant last marked the board seen
+	ParticipantIDs []uuid.UUID               `json:"participantIds" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890,b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	Labels         []LabelResponse           `json:"labels"`
+	Checklist      ChecklistProgressResponse `json:"checklist"`
+	Attachments    []AttachmentResponse      `json:"attachments"`
+	// CoverThumbnailURL is set by list endpoints when the board has a cover image, null otherwise
+	CoverThumbnailURL *string    `json:"coverThumbnailUrl" example:"https://bucket.s3.amazonaws.com/board/images/cover.png"`
+	CreatedAt         time.Time  `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt         time.Time  `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
+	Version           int64      `json:"version" example:"3"` // send back as expectedVersion on update
+	ArchivedAt        *time.Time `json:"archivedAt,omitempty" example:"2024-02-01T09:00:00Z"`
+	ArchiveReason     *string    `json:"archiveReason,omitempty" example:"completed"`
+	LastActivityAt    *time.Time `json:"lastActivityAt,omitempty" example:"2024-01-15T14:20:00Z"`
+}
+
+// BulkUpdateBoardsRequest represents a streamed bulk update of several boards
+// @Description Each item is applied with the same rules as PUT /boards/{boardId}
+// @Description Results are streamed back as newline-delimited JSON, one line per item
+type BulkUpdateBoardsRequest struct {
+	Items []BulkBoardUpdateItem `json:"items" binding:"required,min=1,max=500,dive"`
+}
+
+// BulkBoardUpdateItem is a single board update within a bulk request
+type BulkBoardUpdateItem struct {
+	BoardID uuid.UUID          `json:"boardId" binding:"required" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Update  UpdateBoardRequest `json:"update"`
+}
+
+// BulkBoardUpdateResult represents the outcome of one item in a bulk update
+// @Description success=false means the update failed, error field contains reason
+type BulkBoardUpdateResult struct {
+	BoardID uuid.UUID      `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Success bool           `json:"success" example:"true"`
+	Error   string         `json:"error,omitempty" example:"Board not found"`
+	Board   *BoardResponse `json:"board,omitempty"`
+}
+
+// BatchUpdateBoardsRequest updates several boards in one transaction
+// @Description Either every board is updated or none is; results report which items failed
+type BatchUpdateBoardsRequest struct {
+	Items []BatchBoardUpdateItem `json:"items" binding:"required,min=1,dive"`
+}
+
+// BatchBoardUpdateItem is the board ID plus the fields of UpdateBoardRequest, at the same level
+type BatchBoardUpdateItem struct {
+	BoardID uuid.UUID `json:"boardId" binding:"required" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	UpdateBoardRequest
+}
+
+// BatchUpdateBoardsResponse reports the outcome of a batch update
+// @Description applied=false means nothing was changed; items without their own error were rolled back because another item failed
+type BatchUpdateBoardsResponse struct {
+	Applied bool                     `json:"applied" example:"true"`
+	Results []BatchBoardUpdateResult `json:"results"`
+}
+
+// BatchBoardUpdateResult is the outcome of one item, in request order
+type BatchBoardUpdateResult struct {
+	BoardID uuid.UUID         `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Success bool              `json:"success" example:"true"`
+	Error   string            `json:"error,omitempty" example:"Start date must be before or equal to due date"`
+	Fields  map[string]string `json:"fields,omitempty"` // per-field messages for invalid custom field values
+	Board   *BoardResponse    `json:"board,omitempty"`
+}
+
+// BulkCreateBoardsRequest creates one board per title, all sharing the same defaults
+// @Description Boards are created in one transaction: either all of them are created or none
+type BulkCreateBoardsRequest struct {
+	ProjectID uuid.UUID         `json:"projectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Titles    []string          `json:"titles" binding:"required,min=1,max=100,dive,required,max=200" example:"Design,Build,Ship"`
+	Defaults  BulkBoardDefaults `json:"defaults"`
+}
+
+// BulkBoardDefaults are applied to every board of a bulk create
+type BulkBoardDefaults struct {
+	CustomFields map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"stage:in_progress"`
+	AssigneeID   *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	StartDate    *time.Time             `json:"startDate" example:"2024-01-01T00:00:00Z"`
+	DueDate      *time.Time             `json:"dueDate" example:"2024-12-31T23:59:59Z"`
+}
+
+// BulkCreateBoardResult reports the board created for one title
+type BulkCreateBoardResult struct {
+	Title   string    `json:"title" example:"Design"`
+	BoardID uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	// Warning flags a title that duplicates an existing board or an earlier title; the board is still created
+	Warning string `json:"warning,omitempty" example:"A board with this title already exists in the project"`
+}
+
+// BulkCreateBoardsResponse lists the created boards in request order
+type BulkCreateBoardsResponse struct {
+	Created int                     `json:"created" example:"5"`
+	Results []BulkCreateBoardResult `json:"results"`
+}
+
+// ImportBoardsRequest imports boards from an external system into a project
+// @Description Each item is matched by externalId within the project: an existing board is updated, otherwise a board is created
+// @Description Repeating an import therefore never duplicates boards
+type ImportBoardsRequest struct {
+	ProjectID uuid.UUID         `json:"projectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Items     []ImportBoardItem `json:"items" binding:"required,min=1,max=500,dive"`
+}
+
+// ImportBoardItem is a single board within an import
+type ImportBoardItem struct {
+	ExternalID    string                 `json:"externalId" binding:"required,max=255" example:"JIRA-1042"`
+	Title         string                 `json:"title" binding:"required,min=1,max=200" example:"Implement user authentication"`
+	Content       string                 `json:"content" binding:"max=5000" example:"Add JWT-based authentication to the API"`
+	CustomFields  map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:high"`
+	AssigneeID    *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	StartDate     *time.Time             `json:"startDate" example:"2024-01-01T00:00:00Z"`
+	DueDate       *time.Time             `json:"dueDate" example:"2024-12-31T23:59:59Z"`
+	EstimateHours *float64               `json:"estimateHours" example:"8"`
+	ActualHours   *float64               `json:"actualHours" example:"6.5"`
+}
+
+// ImportBoardResult reports the outcome of one imported item
+type ImportBoardResult struct {
+	ExternalID string    `json:"externalId" example:"JIRA-1042"`
+	BoardID    uuid.UUID `json:"boardId,omitempty" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Created    bool      `json:"created" example:"true"`
+	Error      string    `json:"error,omitempty" example:"Invalid custom field values"`
+}
+
+// ImportBoardsResponse summarizes an import
+type ImportBoardsResponse struct {
+	Created int                 `json:"created" example:"3"`
+	Updated int                 `json:"updated" example:"12"`
+	Failed  int                 `json:"failed" example:"0"`
+	Results []ImportBoardResult `json:"results"`
 }
 
 // PaginatedBoardsResponse represents a paginated list of boards with metadata.
@@ -91,19 +291,211 @@ type PaginatedBoardsResponse struct {
 	Limit  int             `json:"limit"`
 }
 
+// BoardListQuery filters, sorts and pages ListBoards
+// Cursor is the opaque nextCursor of the previous page and must be sent with the same sort order;
+// sortBy is createdAt (default), title, startDate or dueDate
+type BoardListQuery struct {
+	AssigneeID    *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	ParticipantID *uuid.UUID `json:"participantId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	DueFrom       *time.Time `json:"dueFrom,omitempty" example:"2024-01-01T00:00:00Z"`
+	DueTo         *time.Time `json:"dueTo,omitempty" example:"2024-12-31T23:59:59Z"`
+	// LabelIDs matches boards carrying any of the labels
+	LabelIDs []uuid.UUID `json:"labelIds,omitempty"`
+	// CustomFields holds option values, not option IDs
+	CustomFields    map[string]interface{} `json:"customFields,omitempty"`
+	IncludeArchived bool                   `json:"includeArchived,omitempty"`
+	SortBy          string                 `json:"sortBy" example:"dueDate"`
+	Order           string                 `json:"order" example:"asc"`
+	// SortSpec sorts by several keys in turn and replaces sortBy and order
+	SortSpec []SortField `json:"sort,omitempty"`
+	Cursor   string      `json:"cursor,omitempty" example:"eyJrIjoiY3JlYXRlZF9hdDpkZXNjIiwiYyI6IjIwMjQtMDQtMDFUMDk6MzA6MDBaIiwiaSI6IjEyNzVlYWM1LWYwZjktNGJlZS04MjM1LTU3NmEwMDQyZjQyYiJ9"`
+	Limit    int         `json:"limit" example:"50"`
+}
+
+// SortField is one key of a multi-key board sort
+// @Description Desc may be omitted to use the key's default: createdAt sorts descending, the other keys ascending
+type SortField struct {
+	Key  string `json:"key" example:"dueDate"`
+	Desc *bool  `json:"desc,omitempty" example:"false"`
+}
+
+// BoardListPageResponse is one page of a project's boards
+// NextCursor is empty and HasMore false on the last page
+type BoardListPageResponse struct {
+	Boards     []BoardResponse `json:"boards"`
+	NextCursor string          `json:"nextCursor,omitempty" example:"eyJrIjoiY3JlYXRlZF9hdDpkZXNjIiwiYyI6IjIwMjQtMDQtMDFUMDk6MzA6MDBaIiwiaSI6IjEyNzVlYWM1LWYwZjktNGJlZS04MjM1LTU3NmEwMDQyZjQyYiJ9"`
+	HasMore    bool            `json:"hasMore" example:"true"`
+}
+
 // BoardDetailResponse represents the detailed board response with participants and comments
 // @Description Detailed board response with value-based customFields, participants, and comments
 // @Description customFields contains field type as key and value string as value (not UUIDs)
 // @Description Example: {"importance": "high", "role": "developer", "stage": "in_progress"}
 type BoardDetailResponse struct {
 	BoardResponse
-	Participants []ParticipantResponse `json:"participants"`
-	Comments     []CommentResponse     `json:"comments"`
+	Participants   []ParticipantResponse   `json:"participants"`
+	Comments       []CommentResponse       `json:"comments"`
+	ChecklistItems []ChecklistItemResponse `json:"checklistItems"`
 }
 
 // BoardFilters represents the filter parameters for board queries
 type BoardFilters struct {
 	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+	// IncludeArchived lists archived boards alongside active ones
+	IncludeArchived bool `json:"includeArchived,omitempty"`
+}
+
+// SearchBoardsRequest combines a text query with board filters
+// Boards must match the text and every filter; results are ordered by relevance
+type SearchBoardsRequest struct {
+	Query   string       `json:"query" example:"login"`
+	Filters BoardFilters `json:"filters"`
+	DueFrom *time.Time   `json:"dueFrom,omitempty" example:"2024-01-01T00:00:00Z"`
+	DueTo   *time.Time   `json:"dueTo,omitempty" example:"2024-12-31T23:59:59Z"`
+	Page    int          `json:"page" example:"1"`
+	Limit   int          `json:"limit" example:"20"`
+}
+
+// ProjectEffortResponse represents the total estimated vs actual effort of a project's boards
+type ProjectEffortResponse struct {
+	ProjectID          uuid.UUID `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	TotalEstimateHours float64   `json:"totalEstimateHours" example:"120"`
+	TotalActualHours   float64   `json:"totalActualHours" example:"98.5"`
+	Variance           float64   `json:"variance" example:"-21.5"` // totalActualHours - totalEstimateHours
+}
+
+// BoardActivityResponse is one field change in a board's history
+type BoardActivityResponse struct {
+	ID       uuid.UUID `json:"id" example:"0b3f7c1e-9a2d-4e5f-8c6b-1d2e3f4a5b6c"`
+	BoardID  uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	ActorID  uuid.UUID `json:"actorId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Field    string    `json:"field" example:"customFields.stage"`
+	OldValue string    `json:"oldValue" example:"in_progress"`
+	NewValue string    `json:"newValue" example:"done"`
+	// ChangedAt is when the update that made this change was saved
+	ChangedAt time.Time `json:"changedAt" example:"2024-01-15T10:30:00Z"`
+}
+
+// BoardActivityPageResponse is one page of a board's history, newest first
+type BoardActivityPageResponse struct {
+	Activities []BoardActivityResponse `json:"activities"`
+	Total      int64                   `json:"total" example:"42"`
+	Page       int                     `json:"page" example:"1"`
+	Limit      int                     `json:"limit" example:"20"`
+}
+
+// BoardStateResponse is a board's audited fields as they were at a point in time
+// Only fields recorded in the board's history are reconstructed
+type BoardStateResponse struct {
+	BoardID       uuid.UUID  `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	At            time.Time  `json:"at" example:"2024-01-15T10:30:00Z"`
+	Title         string     `json:"title" example:"로그인 기능 구현"`
+	Content       string     `json:"content" example:"JWT 기반 인증 구현"`
+	AssigneeID    *uuid.UUID `json:"assigneeId,omitempty" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	StartDate     *time.Time `json:"startDate,omitempty" example:"2024-01-01T00:00:00Z"`
+	DueDate       *time.Time `json:"dueDate,omitempty" example:"2024-01-31T23:59:59Z"`
+	EstimateHours *float64   `json:"estimateHours,omitempty" example:"8"`
+	ActualHours   *float64   `json:"actualHours,omitempty" example:"6.5"`
+	// CustomFields holds option values, not option IDs
+	CustomFields map[string]interface{} `json:"customFields,omitempty"`
+	Participants []uuid.UUID            `json:"participants"`
+	ArchivedAt   *time.Time             `json:"archivedAt,omitempty" example:"2024-02-01T09:00:00Z"`
+}
+
+// CustomFieldOptionCount is the number of boards set to one option of a custom field
+type CustomFieldOptionCount struct {
+	OptionID uuid.UUID `json:"optionId" example:"8c6f1a0e-2b7d-4c1e-9f3a-5d2e8b7c6a41"`
+	Value    string    `json:"value" example:"in_progress"`
+	Label    string    `json:"label" example:"진행중"`
+	Count    int64     `json:"count" example:"7"`
+}
+
+// CustomFieldAggregationResponse counts a project's boards per option of a custom field
+// Options no board uses are listed with a zero count; boards without the field are counted in unset
+type CustomFieldAggregationResponse struct {
+	ProjectID uuid.UUID                `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	FieldKey  string                   `json:"fieldKey" example:"stage"`
+	Options   []CustomFieldOptionCount `json:"options"`
+	Unset     int64                    `json:"unset" example:"2"`
+	Total     int64                    `json:"total" example:"15"`
+}
+
+// OrphanedAssignee is a board whose assignee is no longer a member of the project
+type OrphanedAssignee struct {
+	BoardID    uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Title      string    `json:"title" example:"Implement user authentication"`
+	AssigneeID uuid.UUID `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+}
+
+// OrphanedAssigneesResponse lists the boards of a project with orphaned assignees
+type OrphanedAssigneesResponse struct {
+	ProjectID uuid.UUID          `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Boards    []OrphanedAssignee `json:"boards"`
+}
+
+// CleanOrphanedAssigneesResponse reports how many boards had their orphaned assignee removed
+type CleanOrphanedAssigneesResponse struct {
+	ProjectID uuid.UUID `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Cleared   int64     `json:"cleared" example:"3"`
+}
+
+// BoardCountResponse represents the number of boards matching a board list filter
+type BoardCountResponse struct {
+	Count       int64 `json:"count" example:"42"`
+	Approximate bool  `json:"approximate" example:"false"`
+}
+
+// BulkMoveBoardsRequest moves boards into another project
+// @Description With allOrNothing every board is moved or none is; otherwise each board is moved on its own
+// @Description Custom fields the target project has no matching field or option for are dropped,
+// @Description unless strict is set, in which case a board with such fields is not moved
+type BulkMoveBoardsRequest struct {
+	BoardIDs        []uuid.UUID `json:"boardIds" binding:"required,min=1"`
+	TargetProjectID uuid.UUID   `json:"targetProjectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	AllOrNothing    bool        `json:"allOrNothing" example:"false"`
+	Strict          bool        `json:"strict" example:"false"`
+}
+
+// BulkMoveBoardsResponse reports the outcome of every board of a bulk move, in request order
+// Applied is false when an all-or-nothing move was rolled back
+type BulkMoveBoardsResponse struct {
+	Applied bool                  `json:"applied" example:"true"`
+	Moved   int                   `json:"moved" example:"3"`
+	Failed  int                   `json:"failed" example:"0"`
+	Results []BulkMoveBoardResult `json:"results"`
+}
+
+// BulkMoveBoardResult is the outcome of moving one board
+type BulkMoveBoardResult struct {
+	BoardID       uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	FromProjectID uuid.UUID `json:"fromProjectId,omitempty" example:"8f2a4c1e-3b5d-4e6f-9a7b-0c1d2e3f4a5b"`
+	Success       bool      `json:"success" example:"true"`
+	Error         string    `json:"error,omitempty" example:"Custom fields are not compatible with the target project"`
+	// Fields explains, per custom field, why a strict move was refused
+	Fields map[string]string `json:"fields,omitempty"`
+	// DroppedFields lists the custom fields removed because the target project cannot represent them
+	DroppedFields []string `json:"droppedFields,omitempty" example:"stage"`
+	// NonMemberParticipants are participants who are not members of the target project
+	NonMemberParticipants []uuid.UUID    `json:"nonMemberParticipants,omitempty"`
+	Board                 *BoardResponse `json:"board,omitempty"`
+}
+
+// MoveBoardToProjectRequest moves a board into another project
+// @Description Custom fields the target project has no matching field or option for are dropped,
+// @Description unless strict is set, in which case the move is refused
+type MoveBoardToProjectRequest struct {
+	TargetProjectID uuid.UUID `json:"targetProjectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Strict          bool      `json:"strict" example:"false"`
+}
+
+// MoveBoardToProjectResponse is a board after moving it into another project
+type MoveBoardToProjectResponse struct {
+	Board         BoardResponse `json:"board"`
+	FromProjectID uuid.UUID     `json:"fromProjectId" example:"8f2a4c1e-3b5d-4e6f-9a7b-0c1d2e3f4a5b"`
+	// DroppedFields lists the custom fields removed because the target project cannot represent them
+	DroppedFields []string `json:"droppedFields,omitempty" example:"stage"`
+	// NonMemberParticipants are participants who are not members of the target project; they stay on the board
+	NonMemberParticipants []uuid.UUID `json:"nonMemberParticipants,omitempty"`
 }
 
 // MoveBoardRequest represents the request to move a board
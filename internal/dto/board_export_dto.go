@@ -0,0 +1,31 @@
+package dto
+
+import "github.com/google/uuid"
+
+// BoardExportRecord is one board of a CSV or JSON export
+// Custom fields hold option values rather than option IDs, and every timestamp is ISO-8601 in UTC
+type BoardExportRecord struct {
+	ID            uuid.UUID              `json:"id"`
+	ProjectID     uuid.UUID              `json:"projectId"`
+	Title         string                 `json:"title"`
+	Content       string                 `json:"content"`
+	Author        BoardExportUser        `json:"author"`
+	Assignee      *BoardExportUser       `json:"assignee"`
+	Participants  []BoardExportUser      `json:"participants"`
+	CustomFields  map[string]interface{} `json:"customFields"`
+	Labels        []string               `json:"labels"`
+	StartDate     *string                `json:"startDate"`
+	DueDate       *string                `json:"dueDate"`
+	EstimateHours *float64               `json:"estimateHours"`
+	ActualHours   *float64               `json:"actualHours"`
+	ArchivedAt    *string                `json:"archivedAt"`
+	CreatedAt     string                 `json:"createdAt"`
+	UpdatedAt     string                 `json:"updatedAt"`
+}
+
+// BoardExportUser is a user referenced by an exported board
+// Name is the user's display name, or empty when it could not be resolved
+type BoardExportUser struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
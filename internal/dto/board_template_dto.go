@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateBoardFromTemplateRequest overrides template values for the board being created
+// @Description Omitted fields take the template's value; customFields are merged key by key over the template defaults
+// @Description "{{date}}" in the template's title and content is replaced with the creation date
+type CreateBoardFromTemplateRequest struct {
+	Title         *string                `json:"title" binding:"omitempty,min=1,max=200" example:"Sprint review"`
+	Content       *string                `json:"content" binding:"omitempty,max=5000" example:"Agenda and notes"`
+	CustomFields  map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"importance:high"`
+	AssigneeID    *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	Participants  []uuid.UUID            `json:"participants,omitempty" binding:"omitempty,max=50" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	StartDate     *time.Time             `json:"startDate" example:"2024-01-01T00:00:00Z"`
+	DueDate       *time.Time             `json:"dueDate" example:"2024-12-31T23:59:59Z"`
+	EstimateHours *float64               `json:"estimateHours" example:"8"`
+}
+
+// BoardTemplateResponse represents the board template response
+// @Description customFields holds option values; options removed since the template was saved are skipped when it is used
+type BoardTemplateResponse struct {
+	ID             uuid.UUID              `json:"templateId" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	ProjectID      uuid.UUID              `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Name           string                 `json:"name" example:"Sprint review"`
+	TitlePattern   string                 `json:"titlePattern" example:"Sprint review {{date}}"`
+	ContentPattern string                 `json:"contentPattern" example:"Agenda and notes"`
+	CustomFields   map[string]interface{} `json:"customFields" swaggertype:"object,string" example:"stage:in_progress"`
+	AssigneeID     *uuid.UUID             `json:"assigneeId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	ParticipantIDs []uuid.UUID            `json:"participantIds" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	CreatedBy      uuid.UUID              `json:"createdBy" example:"b2c3d4e5-f6a7-8901-bcde-f12345678901"`
+	CreatedAt      time.Time              `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateChecklistItemRequest represents the request to add an item to a board's checklist
+// @Description New items are appended after the last item
+type CreateChecklistItemRequest struct {
+	Text string `json:"text" binding:"required,min=1,max=500" example:"Write release notes"`
+}
+
+// UpdateChecklistItemRequest represents the request to update a checklist item
+// @Description Omitted fields are left unchanged; use the order endpoint to move items
+type UpdateChecklistItemRequest struct {
+	Text *string `json:"text" binding:"omitempty,min=1,max=500" example:"Write and publish release notes"`
+	Done *bool   `json:"done" example:"true"`
+}
+
+// ReorderChecklistItemsRequest represents the new order of a board's checklist
+// @Description itemIds must list every item of the board exactly once
+type ReorderChecklistItemsRequest struct {
+	ItemIDs []uuid.UUID `json:"itemIds" binding:"required,min=1" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+}
+
+// ChecklistItemResponse represents the checklist item response
+type ChecklistItemResponse struct {
+	ID        uuid.UUID `json:"itemId" example:"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`
+	BoardID   uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
+	Text      string    `json:"text" example:"Write release notes"`
+	Done      bool      `json:"done" example:"false"`
+	Position  int       `json:"position" example:"0"`
+	CreatedAt time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	UpdatedAt time.Time `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
+}
+
+// ChecklistProgressResponse summarizes how much of a board's checklist is done
+type ChecklistProgressResponse struct {
+	Done    int    `json:"done" example:"3"`
+	Total   int    `json:"total" example:"5"`
+	Summary string `json:"summary" example:"3/5 done"`
+}
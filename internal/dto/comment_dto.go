@@ -30,6 +30,16 @@ type CommentResponse struct {
 	UserID      uuid.UUID            `json:"userId"`
 	Content     string               `json:"content"`
 	Attachments []AttachmentResponse `json:"attachments"`
+	IsPinned    bool                 `json:"isPinned"`
+	PinnedAt    *time.Time           `json:"pinnedAt,omitempty"`
 	CreatedAt   time.Time            `json:"createdAt"`
 	UpdatedAt   time.Time            `json:"updatedAt"`
 }
+
+// CommentRevisionResponse represents a previous body of an edited comment
+type CommentRevisionResponse struct {
+	RevisionID uuid.UUID `json:"revisionId"`
+	CommentID  uuid.UUID `json:"commentId"`
+	Content    string    `json:"content"`
+	EditedAt   time.Time `json:"editedAt"` // when this body was replaced
+}
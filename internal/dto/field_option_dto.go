@@ -15,6 +15,7 @@ type FieldOptionResponse struct {
 	Color           string    `json:"color"`
 	DisplayOrder    int       `json:"displayOrder"`
 	IsSystemDefault bool      `json:"isSystemDefault"`
+	IsArchived      bool      `json:"isArchived"`
 	CreatedAt       time.Time `json:"createdAt"`
 	UpdatedAt       time.Time `json:"updatedAt"`
 }
@@ -33,4 +34,5 @@ type UpdateFieldOptionRequest struct {
 	Label        *string `json:"label" binding:"omitempty,max=200"`
 	Color        *string `json:"color" binding:"omitempty,hexcolor"`
 	DisplayOrder *int    `json:"displayOrder"`
+	IsArchived   *bool   `json:"isArchived"`
 }
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateLabelRequest represents the request to create a label in a project
+// @Description Label names are unique within a project
+type CreateLabelRequest struct {
+	ProjectID uuid.UUID `json:"projectId" binding:"required" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Name      string    `json:"name" binding:"required,min=1,max=50" example:"bug"`
+	Color     string    `json:"color" binding:"required,max=20" example:"#E5484D"`
+}
+
+// BoardLabelsRequest represents the labels to attach to or detach from a board
+// @Description Labels must belong to the board's project
+type BoardLabelsRequest struct {
+	LabelIDs []uuid.UUID `json:"labelIds" binding:"required,min=1,max=50" example:"0f8fad5b-d9cb-469f-a165-70867728950e"`
+}
+
+// LabelResponse represents the label response
+type LabelResponse struct {
+	ID        uuid.UUID `json:"labelId" example:"0f8fad5b-d9cb-469f-a165-70867728950e"`
+	ProjectID uuid.UUID `json:"projectId" example:"539167fb-b599-41ba-9ead-344a6d0b3a2f"`
+	Name      string    `json:"name" example:"bug"`
+	Color     string    `json:"color" example:"#E5484D"`
+	CreatedAt time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
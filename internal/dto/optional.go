@@ -0,0 +1,44 @@
+package dto
+
+import "encoding/json"
+
+// Optional distinguishes a JSON field that was omitted from one explicitly set to null
+// The zero value means the field was omitted
+type Optional[T any] struct {
+	// Set reports whether the field was present in the request
+	Set bool
+	// Value is the decoded value, nil when the field was null
+	Value *T
+}
+
+// OptionalOf returns an Optional set to v; a nil v leaves the field untouched
+func OptionalOf[T any](v *T) Optional[T] {
+	return Optional[T]{Set: v != nil, Value: v}
+}
+
+// Null returns an Optional that explicitly clears the field
+func Null[T any]() Optional[T] {
+	return Optional[T]{Set: true}
+}
+
+// UnmarshalJSON marks the field as present and decodes null as a nil Value
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	o.Set = true
+	if string(data) == "null" {
+		o.Value = nil
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	o.Value = &v
+	return nil
+}
+
+// MarshalJSON encodes the value, or null if there is none
+// Use the omitzero tag option so an unset field is left out entirely
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.Value)
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestUpdateBoardRequest_DatePresence(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantSet   bool
+		wantValue bool
+	}{
+		{name: "omitted leaves the date untouched", body: `{}`, wantSet: false, wantValue: false},
+		{name: "null clears the date", body: `{"startDate": null}`, wantSet: true, wantValue: false},
+		{name: "value sets the date", body: `{"startDate": "2024-01-01T00:00:00Z"}`, wantSet: true, wantValue: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req UpdateBoardRequest
+			if err := json.Unmarshal([]byte(tt.body), &req); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if req.StartDate.Set != tt.wantSet {
+				t.Errorf("StartDate.Set = %v, want %v", req.StartDate.Set, tt.wantSet)
+			}
+			if (req.StartDate.Value != nil) != tt.wantValue {
+				t.Errorf("StartDate.Value = %v, want present %v", req.StartDate.Value, tt.wantValue)
+			}
+			if req.DueDate.Set {
+				t.Error("DueDate.Set = true for a body without dueDate")
+			}
+		})
+	}
+}
+
+func TestOptional_MarshalRoundTrip(t *testing.T) {
+	due := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	data, err := json.Marshal(UpdateBoardRequest{StartDate: Null[time.Time](), DueDate: OptionalOf(&due)})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if string(decoded["startDate"]) != "null" {
+		t.Errorf("startDate = %s, want null", decoded["startDate"])
+	}
+	if _, ok := decoded["dueDate"]; !ok {
+		t.Error("dueDate missing from encoded request")
+	}
+
+	// An unset field is left out so a round trip does not clear it
+	data, err = json.Marshal(UpdateBoardRequest{})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	decoded = nil
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if _, ok := decoded["startDate"]; ok {
+		t.Error("startDate encoded for an unset field")
+	}
+}
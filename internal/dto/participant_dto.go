@@ -45,4 +45,12 @@ type ParticipantResponse struct {
 	BoardID   uuid.UUID `json:"boardId" example:"1275eac5-f0f9-4bee-8235-576a0042f42b"`
 	UserID    uuid.UUID `json:"userId" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
 	CreatedAt time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+	// LastSeenAt is when the participant last marked the board as seen
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty" example:"2024-01-16T08:00:00Z"`
+}
+
+// SyncParticipantsResponse represents the result of reconciling participants from assignees
+type SyncParticipantsResponse struct {
+	BoardID uuid.UUID   `json:"boardId" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Added   []uuid.UUID `json:"added"`
 }
@@ -29,6 +29,8 @@ type UpdateProjectRequest struct {
 	StartDate     *time.Time  `json:"startDate,omitempty" example:"2024-01-15T00:00:00Z"`
 	DueDate       *time.Time  `json:"dueDate,omitempty" example:"2024-04-15T23:59:59Z"`
 	AttachmentIDs []uuid.UUID `json:"attachmentIds,omitempty" binding:"omitempty,dive,uuid" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	// EnforceUniqueTitles makes board create and update reject a title already used in the project
+	EnforceUniqueTitles *bool `json:"enforceUniqueTitles,omitempty" example:"true"`
 }
 
 // ProjectResponse represents the project response
@@ -49,6 +51,8 @@ type ProjectResponse struct {
 	Attachments []AttachmentResponse `json:"attachments"`
 	CreatedAt   time.Time            `json:"createdAt" example:"2024-01-15T10:30:00Z"`
 	UpdatedAt   time.Time            `json:"updatedAt" example:"2024-01-15T14:20:00Z"`
+	// EnforceUniqueTitles reports whether board titles must be unique within the project
+	EnforceUniqueTitles bool `json:"enforceUniqueTitles" example:"false"`
 }
 
 // ProjectMemberResponse represents a project member
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateThresholdRuleRequest represents the request to alert users when a board field crosses a threshold
+// @Description field accepts estimateHours and actualHours; operator accepts gt, gte, lt and lte
+// @Description recipients must be members of the project. They are alerted once when a board meets the condition,
+// @Description and again only after the board has stopped meeting it
+type CreateThresholdRuleRequest struct {
+	Field      string      `json:"field" binding:"required,oneof=estimateHours actualHours" example:"actualHours"`
+	Operator   string      `json:"operator" binding:"required,oneof=gt gte lt lte" example:"gt"`
+	Threshold  *float64    `json:"threshold" binding:"required" example:"40"`
+	Recipients []uuid.UUID `json:"recipients" binding:"required,min=1,max=50" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+}
+
+// ThresholdRuleResponse represents a threshold rule
+type ThresholdRuleResponse struct {
+	ID         uuid.UUID   `json:"ruleId" example:"e5f6a7b8-c9d0-1234-efab-345678901234"`
+	ProjectID  uuid.UUID   `json:"projectId" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Field      string      `json:"field" example:"actualHours"`
+	Operator   string      `json:"operator" example:"gt"`
+	Threshold  float64     `json:"threshold" example:"40"`
+	Recipients []uuid.UUID `json:"recipients" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	CreatedBy  uuid.UUID   `json:"createdBy" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	CreatedAt  time.Time   `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateWebhookRequest represents the request to subscribe a URL to a project's board events
+// @Description events accepts board.created, board.updated, board.deleted and board.threshold_alert
+// @Description board.threshold_alert carries an alert with the rule, the board's value and the users to notify
+type CreateWebhookRequest struct {
+	TargetURL string   `json:"targetUrl" binding:"required,url,max=2048" example:"https://hooks.example.com/boards"`
+	Events    []string `json:"events" binding:"required,min=1,dive,oneof=board.created board.updated board.deleted board.threshold_alert" example:"board.updated"`
+}
+
+// WebhookResponse represents a webhook subscription
+// @Description secret is only returned when the subscription is created; use it to verify the X-Webhook-Signature header
+type WebhookResponse struct {
+	ID        uuid.UUID `json:"webhookId" example:"d4e5f6a7-b8c9-0123-defa-234567890123"`
+	ProjectID uuid.UUID `json:"projectId" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	TargetURL string    `json:"targetUrl" example:"https://hooks.example.com/boards"`
+	Events    []string  `json:"events" example:"board.updated"`
+	Secret    string    `json:"secret,omitempty" example:"4f9c2a..."`
+	CreatedBy uuid.UUID `json:"createdBy" example:"a1b2c3d4-e5f6-7890-abcd-ef1234567890"`
+	CreatedAt time.Time `json:"createdAt" example:"2024-01-15T10:30:00Z"`
+}
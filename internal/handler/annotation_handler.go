@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type AnnotationHandler struct {
+	annotationService service.AnnotationService
+}
+
+func NewAnnotationHandler(annotationService service.AnnotationService) *AnnotationHandler {
+	return &AnnotationHandler{
+		annotationService: annotationService,
+	}
+}
+
+// AddAnnotation godoc
+// @Summary      이미지 첨부파일에 Annotation 추가
+// @Description  이미지 첨부파일의 특정 위치에 Annotation(마커)을 추가합니다
+// @Description  x, y는 이미지 크기 대비 정규화된 좌표(0~1)이며 범위를 벗어나면 400 에러 반환
+// @Tags         attachments
+// @Accept       json
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID (UUID)"
+// @Param        request body dto.CreateAnnotationRequest true "Annotation 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.AnnotationResponse} "Annotation 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 좌표 범위 초과"
+// @Failure      404 {object} response.ErrorResponse "Attachment를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /attachments/{attachmentId}/annotations [post]
+func (h *AnnotationHandler) AddAnnotation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid attachment ID")
+		return
+	}
+
+	var req dto.CreateAnnotationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	annotation, err := h.annotationService.AddAnnotation(c.Request.Context(), attachmentID, userUUID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, annotation)
+}
+
+// ListAnnotations godoc
+// @Summary      첨부파일의 Annotation 목록 조회
+// @Description  이미지 첨부파일에 남겨진 모든 Annotation을 생성 순으로 조회합니다
+// @Tags         attachments
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.AnnotationResponse} "Annotation 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Attachment ID"
+// @Failure      404 {object} response.ErrorResponse "Attachment를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /attachments/{attachmentId}/annotations [get]
+func (h *AnnotationHandler) ListAnnotations(c *gin.Context) {
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid attachment ID")
+		return
+	}
+
+	annotations, err := h.annotationService.ListAnnotations(c.Request.Context(), attachmentID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, annotations)
+}
+
+// DeleteAnnotation godoc
+// @Summary      Annotation 삭제
+// @Description  Annotation을 삭제합니다 (작성자만 삭제 가능)
+// @Tags         attachments
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID (UUID)"
+// @Param        annotationId path string true "Annotation ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "Annotation 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Attachment 또는 Annotation ID"
+// @Failure      403 {object} response.ErrorResponse "삭제 권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Attachment에 속한 Annotation을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /attachments/{attachmentId}/annotations/{annotationId} [delete]
+func (h *AnnotationHandler) DeleteAnnotation(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid attachment ID")
+		return
+	}
+
+	annotationID, err := uuid.Parse(c.Param("annotationId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid annotation ID")
+		return
+	}
+
+	if err := h.annotationService.DeleteAnnotation(c.Request.Context(), attachmentID, annotationID, userUUID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
@@ -63,6 +63,7 @@ func setupFullFlowTestDB(t *testing.T) *gorm.DB {
 			owner_id TEXT NOT NULL,
 			is_default INTEGER DEFAULT 0,
 			is_public INTEGER DEFAULT 0,
+			enforce_unique_titles INTEGER NOT NULL DEFAULT 0,
 			start_date DATETIME,
 			due_date DATETIME
 		)
@@ -81,8 +82,16 @@ func setupFullFlowTestDB(t *testing.T) *gorm.DB {
 			title TEXT NOT NULL,
 			content TEXT,
 			custom_fields TEXT,
+			external_id TEXT,
 			start_date DATETIME,
-			due_date DATETIME
+			due_date DATETIME,
+			estimate_hours REAL,
+			actual_hours REAL,
+			version INTEGER NOT NULL DEFAULT 1,
+			title_unique INTEGER NOT NULL DEFAULT 0,
+			archived_at DATETIME,
+			archive_reason VARCHAR(100),
+			last_activity_at DATETIME
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create boards table")
@@ -101,7 +110,11 @@ func setupFullFlowTestDB(t *testing.T) *gorm.DB {
 			file_size INTEGER NOT NULL,
 			content_type TEXT NOT NULL,
 			uploaded_by TEXT NOT NULL,
-			expires_at DATETIME
+			expires_at DATETIME,
+			checksum_sha256 TEXT,
+			download_count INTEGER NOT NULL DEFAULT 0,
+			visibility TEXT NOT NULL DEFAULT 'BOARD',
+			project_id TEXT
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create attachments table")
@@ -113,7 +126,8 @@ func setupFullFlowTestDB(t *testing.T) *gorm.DB {
 			updated_at DATETIME NOT NULL,
 			deleted_at DATETIME,
 			board_id TEXT NOT NULL,
-			user_id TEXT NOT NULL
+			user_id TEXT NOT NULL,
+			last_seen_at DATETIME
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create participants table")
@@ -131,6 +145,42 @@ func setupFullFlowTestDB(t *testing.T) *gorm.DB {
 	`).Error
 	require.NoError(t, err, "Failed to create comments table")
 
+	err = db.Exec(`
+		CREATE TABLE labels (
+			id TEXT PRIMARY KEY,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME,
+			project_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			color TEXT NOT NULL
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create labels table")
+
+	err = db.Exec(`
+		CREATE TABLE checklist_items (
+			id TEXT PRIMARY KEY,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME,
+			board_id TEXT NOT NULL,
+			text TEXT NOT NULL,
+			done INTEGER NOT NULL DEFAULT 0,
+			position INTEGER NOT NULL DEFAULT 0
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create checklist_items table")
+
+	err = db.Exec(`
+		CREATE TABLE board_labels (
+			board_id TEXT NOT NULL,
+			label_id TEXT NOT NULL,
+			PRIMARY KEY (board_id, label_id)
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create board_labels table")
+
 	return db
 }
 
@@ -157,7 +207,7 @@ func setupFullFlowRouter(
 	attachmentRepo := repository.NewAttachmentRepository(db)
 
 	// Initialize handlers
-	attachmentHandler := NewAttachmentHandler(s3Client, attachmentRepo)
+	attachmentHandler := NewAttachmentHandler(s3Client, attachmentRepo, nil, nil)
 	boardHandler := NewBoardHandler(boardService)
 
 	// Setup routes
@@ -213,6 +263,7 @@ func TestIntegrationCompleteAttachmentFlow(t *testing.T) {
 		fieldOptionConverter,
 		m,
 		logger,
+		service.WithTransactor(repository.NewTransactor(db)),
 	)
 
 	router := setupFullFlowRouter(db, s3Client, boardService)
@@ -476,6 +527,7 @@ func TestIntegrationMultipleAttachmentsFlow(t *testing.T) {
 		fieldOptionConverter,
 		m,
 		logger,
+		service.WithTransactor(repository.NewTransactor(db)),
 	)
 
 	router := setupFullFlowRouter(db, s3Client, boardService)
@@ -589,6 +641,7 @@ func TestIntegrationAttachmentValidationErrors(t *testing.T) {
 		fieldOptionConverter,
 		m,
 		logger,
+		service.WithTransactor(repository.NewTransactor(db)),
 	)
 
 	router := setupFullFlowRouter(db, s3Client, boardService)
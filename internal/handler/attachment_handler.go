@@ -8,18 +8,57 @@ import (
 	"project-board-api/internal/repository"
 )
 
+// DownloadRecorder counts attachment downloads, typically buffering them for batched writes
+type DownloadRecorder interface {
+	Record(attachmentID uuid.UUID)
+	// Pending returns the recorded downloads not yet reflected in the attachment row
+	Pending(attachmentID uuid.UUID) int64
+}
+
 // AttachmentHandler handles attachment-related requests
 type AttachmentHandler struct {
 	s3Client       client.S3ClientInterface
 	attachmentRepo repository.AttachmentRepository
+	annotationRepo repository.AttachmentAnnotationRepository
+	downloads      DownloadRecorder
+	boardRepo      repository.BoardRepository
+	projectRepo    repository.ProjectRepository
+	deleteJobRepo  repository.AttachmentDeleteJobRepository
+}
+
+// AttachmentHandlerOption configures optional AttachmentHandler behaviour
+type AttachmentHandlerOption func(*AttachmentHandler)
+
+// WithAttachmentAccess lets the handler look up project roles for restricted attachments
+// Without it, restricted attachments are only served to their uploader
+func WithAttachmentAccess(boardRepo repository.BoardRepository, projectRepo repository.ProjectRepository) AttachmentHandlerOption {
+	return func(h *AttachmentHandler) {
+		h.boardRepo = boardRepo
+		h.projectRepo = projectRepo
+	}
+}
+
+// WithAttachmentDeleteJobs lets the handler list the queued deletions the background worker gave up on
+func WithAttachmentDeleteJobs(deleteJobRepo repository.AttachmentDeleteJobRepository) AttachmentHandlerOption {
+	return func(h *AttachmentHandler) {
+		h.deleteJobRepo = deleteJobRepo
+	}
 }
 
 // NewAttachmentHandler creates a new AttachmentHandler
-func NewAttachmentHandler(s3Client client.S3ClientInterface, attachmentRepo repository.AttachmentRepository) *AttachmentHandler {
-	return &AttachmentHandler{
+// annotationRepo may be nil, in which case annotation counts are reported as zero
+// downloads may be nil, in which case downloads are not counted
+func NewAttachmentHandler(s3Client client.S3ClientInterface, attachmentRepo repository.AttachmentRepository, annotationRepo repository.AttachmentAnnotationRepository, downloads DownloadRecorder, opts ...AttachmentHandlerOption) *AttachmentHandler {
+	h := &AttachmentHandler{
 		s3Client:       s3Client,
 		attachmentRepo: attachmentRepo,
+		annotationRepo: annotationRepo,
+		downloads:      downloads,
+	}
+	for _, opt := range opts {
+		opt(h)
 	}
+	return h
 }
 
 // MaxFileSize defines the maximum allowed file size for uploads (50MB).
@@ -93,6 +132,8 @@ type PresignedURLRequest struct {
 	FileName    string `json:"fileName" binding:"required"`
 	FileSize    int64  `json:"fileSize" binding:"required"`
 	ContentType string `json:"contentType" binding:"required"`
+	// ProjectID is optional; when set the attachment can only be confirmed to a board of that project
+	ProjectID string `json:"projectId"`
 }
 
 // PresignedURLResponse represents the response containing the presigned URL
@@ -109,6 +150,7 @@ type PresignedURLResponse struct {
 // @Description  Creates a temporary attachment record and returns its ID along with the presigned URL
 // @Description  Validates file metadata (size, type, name) before generating URL
 // @Description  Supported entity types: BOARD, COMMENT, PROJECT
+// @Description  When projectId is given, confirming the attachment to a board of another project is rejected
 // @Description  Supported file types: images (jpg, jpeg, png, gif, webp, svg, heic) and documents (pdf, txt, doc, docx, xls, xlsx, ppt, pptx, zip, json, md, csv)
 // @Description  Maximum file size: 50MB
 // @Description  URL expires in 5 minutes (300 seconds)
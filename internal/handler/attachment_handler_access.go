@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// requestUserID returns the authenticated user, or uuid.Nil when the request carries none
+func requestUserID(c *gin.Context) uuid.UUID {
+	userIDValue, exists := c.Get("user_id")
+	if !exists {
+		return uuid.Nil
+	}
+
+	switch userID := userIDValue.(type) {
+	case uuid.UUID:
+		return userID
+	case string:
+		parsed, err := uuid.Parse(userID)
+		if err != nil {
+			return uuid.Nil
+		}
+		return parsed
+	default:
+		return uuid.Nil
+	}
+}
+
+// projectRole returns the user's role in the project owning the entity, or "" when it cannot be determined
+// Comment attachments are never restricted, so comments are not resolved
+func (h *AttachmentHandler) projectRole(ctx context.Context, entityType domain.EntityType, entityID *uuid.UUID, userID uuid.UUID) (domain.ProjectRole, error) {
+	if h.projectRepo == nil || entityID == nil || userID == uuid.Nil {
+		return "", nil
+	}
+
+	projectID := *entityID
+	switch entityType {
+	case domain.EntityTypeProject:
+	case domain.EntityTypeBoard:
+		if h.boardRepo == nil {
+			return "", nil
+		}
+		board, err := h.boardRepo.FindByID(ctx, *entityID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return "", nil
+			}
+			return "", err
+		}
+		projectID = board.ProjectID
+	default:
+		return "", nil
+	}
+
+	member, err := h.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return member.RoleName, nil
+}
+
+// visibleAttachments drops the restricted attachments of one entity that the requesting user may not see
+func (h *AttachmentHandler) visibleAttachments(c *gin.Context, entityType domain.EntityType, entityID uuid.UUID, attachments []*domain.Attachment) ([]*domain.Attachment, error) {
+	userID := requestUserID(c)
+
+	var role domain.ProjectRole
+	resolved := false
+	visible := make([]*domain.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		if attachment.IsRestricted() && !resolved {
+			var err error
+			if role, err = h.projectRole(c.Request.Context(), entityType, &entityID, userID); err != nil {
+				return nil, err
+			}
+			resolved = true
+		}
+		if attachment.VisibleTo(userID, role) {
+			visible = append(visible, attachment)
+		}
+	}
+	return visible, nil
+}
+
+// canAccess reports whether the requesting user may see the attachment
+func (h *AttachmentHandler) canAccess(c *gin.Context, attachment *domain.Attachment) (bool, error) {
+	if !attachment.IsRestricted() {
+		return true, nil
+	}
+
+	userID := requestUserID(c)
+	role, err := h.projectRole(c.Request.Context(), attachment.EntityType, attachment.EntityID, userID)
+	if err != nil {
+		return false, err
+	}
+	return attachment.VisibleTo(userID, role), nil
+}
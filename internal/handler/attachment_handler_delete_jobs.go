@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/response"
+)
+
+// AttachmentDeleteJobResponse is a queued attachment deletion the background worker gave up on
+type AttachmentDeleteJobResponse struct {
+	ID           uuid.UUID `json:"id"`
+	AttachmentID uuid.UUID `json:"attachmentId"`
+	FileKey      string    `json:"fileKey"`
+	Attempts     int       `json:"attempts"`
+	LastError    string    `json:"lastError"`
+	QueuedAt     time.Time `json:"queuedAt"`
+	FailedAt     time.Time `json:"failedAt"`
+}
+
+// ListFailedDeleteJobs godoc
+// @Summary      Get failed attachment deletions
+// @Description  Lists queued attachment deletions that were given up on after the maximum number of attempts, most recently failed first
+// @Description  The S3 object and attachment row of each job are still in place and need manual cleanup
+// @Tags         attachments
+// @Produce      json
+// @Success      200 {object} response.SuccessResponse{data=[]AttachmentDeleteJobResponse} "Failed deletions retrieved successfully"
+// @Failure      404 {object} response.ErrorResponse "Attachment deletion queue is not configured"
+// @Failure      500 {object} response.ErrorResponse "Failed to retrieve failed deletions"
+// @Router       /attachments/delete-jobs/failed [get]
+func (h *AttachmentHandler) ListFailedDeleteJobs(c *gin.Context) {
+	if h.deleteJobRepo == nil {
+		response.SendError(c, http.StatusNotFound, response.ErrCodeNotFound, "Attachment deletion queue is not configured")
+		return
+	}
+
+	jobs, err := h.deleteJobRepo.ListFailedAttachmentJobs(c.Request.Context())
+	if err != nil {
+		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to retrieve failed deletions")
+		return
+	}
+
+	resp := make([]AttachmentDeleteJobResponse, len(jobs))
+	for i, job := range jobs {
+		resp[i] = AttachmentDeleteJobResponse{
+			ID:           job.ID,
+			AttachmentID: job.AttachmentID,
+			FileKey:      job.FileKey,
+			Attempts:     job.Attempts,
+			LastError:    job.LastError,
+			QueuedAt:     job.CreatedAt,
+			FailedAt:     job.UpdatedAt,
+		}
+	}
+
+	response.SendSuccess(c, http.StatusOK, resp)
+}
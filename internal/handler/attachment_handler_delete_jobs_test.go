@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// stubAttachmentDeleteJobRepository serves a fixed list of failed jobs
+type stubAttachmentDeleteJobRepository struct {
+	repository.AttachmentDeleteJobRepository
+	failed []*domain.AttachmentDeleteJob
+	err    error
+}
+
+func (s *stubAttachmentDeleteJobRepository) ListFailedAttachmentJobs(ctx context.Context) ([]*domain.AttachmentDeleteJob, error) {
+	return s.failed, s.err
+}
+
+func TestAttachmentHandler_ListFailedDeleteJobs(t *testing.T) {
+	failedAt := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	job := &domain.AttachmentDeleteJob{
+		BaseModel:    domain.BaseModel{ID: uuid.New(), CreatedAt: failedAt.Add(-time.Hour), UpdatedAt: failedAt},
+		AttachmentID: uuid.New(),
+		FileKey:      "board/files/spec.pdf",
+		Status:       domain.AttachmentDeleteJobDead,
+		Attempts:     8,
+		LastError:    "access denied",
+	}
+
+	tests := []struct {
+		name           string
+		repo           repository.AttachmentDeleteJobRepository
+		expectedStatus int
+		expectedJobs   int
+	}{
+		{name: "lists dead jobs", repo: &stubAttachmentDeleteJobRepository{failed: []*domain.AttachmentDeleteJob{job}}, expectedStatus: http.StatusOK, expectedJobs: 1},
+		{name: "repository error", repo: &stubAttachmentDeleteJobRepository{err: errors.New("database unavailable")}, expectedStatus: http.StatusInternalServerError},
+		{name: "queue not configured", repo: nil, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var opts []AttachmentHandlerOption
+			if tt.repo != nil {
+				opts = append(opts, WithAttachmentDeleteJobs(tt.repo))
+			}
+			handler := NewAttachmentHandler(nil, &mockAttachmentRepository{}, nil, nil, opts...)
+
+			router := setupTestRouter()
+			router.GET("/attachments/delete-jobs/failed", handler.ListFailedDeleteJobs)
+
+			req := httptest.NewRequest(http.MethodGet, "/attachments/delete-jobs/failed", nil)
+			w := httptest.NewRecorder()
+
+			// When
+			router.ServeHTTP(w, req)
+
+			// Then
+			require.Equal(t, tt.expectedStatus, w.Code, "Response body: %s", w.Body.String())
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			var resp response.SuccessResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			data, _ := json.Marshal(resp.Data)
+			var jobs []AttachmentDeleteJobResponse
+			require.NoError(t, json.Unmarshal(data, &jobs))
+			require.Len(t, jobs, tt.expectedJobs)
+			assert.Equal(t, job.AttachmentID, jobs[0].AttachmentID)
+			assert.Equal(t, job.FileKey, jobs[0].FileKey)
+			assert.Equal(t, 8, jobs[0].Attempts)
+			assert.Equal(t, "access denied", jobs[0].LastError)
+			assert.True(t, failedAt.Equal(jobs[0].FailedAt))
+		})
+	}
+}
@@ -36,7 +36,7 @@ func setupDeleteAttachmentHandler(t *testing.T, mockRepo *mockAttachmentReposito
 	require.NoError(t, err, "Failed to create S3 client")
 
 	// Create handler
-	handler := NewAttachmentHandler(s3Client, mockRepo)
+	handler := NewAttachmentHandler(s3Client, mockRepo, nil, nil)
 
 	// Setup router
 	router := gin.New()
@@ -177,7 +177,7 @@ func TestDeleteAttachment_Forbidden(t *testing.T) {
 	require.NoError(t, err, "Failed to create S3 client")
 
 	// Create handler
-	handler := NewAttachmentHandler(s3Client, mockRepo)
+	handler := NewAttachmentHandler(s3Client, mockRepo, nil, nil)
 
 	// Setup router with current user
 	router := gin.New()
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/response"
+)
+
+// DownloadURLResponse represents the URL issued for downloading an attachment
+type DownloadURLResponse struct {
+	AttachmentID uuid.UUID `json:"attachmentId"`
+	FileName     string    `json:"fileName"`
+	DownloadURL  string    `json:"downloadUrl"`
+	// DownloadCount includes this download
+	DownloadCount int64 `json:"downloadCount"`
+}
+
+// GetDownloadURL godoc
+// @Summary      Get attachment download URL
+// @Description  Issues the URL for downloading an attachment and counts the download
+// @Description  Counts are written in batches, so list responses may lag by a few seconds
+// @Description  Restricted attachments are only available to project owners, admins and the uploader
+// @Tags         attachments
+// @Produce      json
+// @Param        attachmentId path string true "Attachment ID"
+// @Success      200 {object} response.SuccessResponse{data=DownloadURLResponse} "Download URL issued successfully"
+// @Failure      400 {object} response.ErrorResponse "Invalid attachment ID"
+// @Failure      403 {object} response.ErrorResponse "Attachment is restricted"
+// @Failure      404 {object} response.ErrorResponse "Attachment not found"
+// @Router       /attachments/{attachmentId}/download-url [get]
+func (h *AttachmentHandler) GetDownloadURL(c *gin.Context) {
+	attachmentID, err := uuid.Parse(c.Param("attachmentId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid attachment ID")
+		return
+	}
+
+	attachment, err := h.attachmentRepo.FindByID(c.Request.Context(), attachmentID)
+	if err != nil {
+		response.SendError(c, http.StatusNotFound, response.ErrCodeNotFound, "Attachment not found")
+		return
+	}
+
+	allowed, err := h.canAccess(c, attachment)
+	if err != nil {
+		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to check attachment access")
+		return
+	}
+	if !allowed {
+		response.SendError(c, http.StatusForbidden, response.ErrCodeForbidden, "You do not have permission to download this attachment")
+		return
+	}
+
+	downloadCount := attachment.DownloadCount
+	if h.downloads != nil {
+		h.downloads.Record(attachment.ID)
+		downloadCount += h.downloads.Pending(attachment.ID)
+	}
+
+	response.SendSuccess(c, http.StatusOK, DownloadURLResponse{
+		AttachmentID:  attachment.ID,
+		FileName:      attachment.FileName,
+		DownloadURL:   h.s3Client.GetFileURL(attachment.FileURL),
+		DownloadCount: downloadCount,
+	})
+}
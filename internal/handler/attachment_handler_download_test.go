@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/job"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func TestGetDownloadURL_IncrementsDownloadCount(t *testing.T) {
+	db := setupAttachmentIntegrationTestDB(t)
+	attachmentRepo := repository.NewAttachmentRepository(db)
+	counter := job.NewDownloadCounter(attachmentRepo, zap.NewNop(), time.Minute)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	h := NewAttachmentHandler(client.NewMockS3Client(), attachmentRepo, nil, counter)
+	router.GET("/api/attachments/:attachmentId/download-url", h.GetDownloadURL)
+
+	entityID := uuid.New()
+	attachment := &domain.Attachment{
+		EntityType:  domain.EntityTypeBoard,
+		EntityID:    &entityID,
+		Status:      domain.AttachmentStatusConfirmed,
+		FileName:    "report.pdf",
+		FileURL:     "board/files/report.pdf",
+		FileSize:    1024,
+		ContentType: "application/pdf",
+		UploadedBy:  uuid.New(),
+	}
+	require.NoError(t, attachmentRepo.Create(context.Background(), attachment))
+
+	issue := func() DownloadURLResponse {
+		req := httptest.NewRequest(http.MethodGet, "/api/attachments/"+attachment.ID.String()+"/download-url", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp response.SuccessResponse
+		resp.Data = &DownloadURLResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return *resp.Data.(*DownloadURLResponse)
+	}
+
+	first := issue()
+	assert.Contains(t, first.DownloadURL, attachment.FileURL)
+	assert.Equal(t, int64(1), first.DownloadCount)
+	assert.Equal(t, int64(2), issue().DownloadCount)
+
+	// Nothing is written until the counter flushes
+	stored, err := attachmentRepo.FindByID(context.Background(), attachment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stored.DownloadCount)
+
+	require.NoError(t, counter.Flush(context.Background()))
+
+	stored, err = attachmentRepo.FindByID(context.Background(), attachment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stored.DownloadCount)
+
+	// Persisted and pending downloads add up after a flush
+	assert.Equal(t, int64(3), issue().DownloadCount)
+}
+
+func TestGetDownloadURL_AttachmentNotFound(t *testing.T) {
+	db := setupAttachmentIntegrationTestDB(t)
+	attachmentRepo := repository.NewAttachmentRepository(db)
+	counter := job.NewDownloadCounter(attachmentRepo, zap.NewNop(), time.Minute)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	h := NewAttachmentHandler(client.NewMockS3Client(), attachmentRepo, nil, counter)
+	router.GET("/api/attachments/:attachmentId/download-url", h.GetDownloadURL)
+
+	missingID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/attachments/"+missingID.String()+"/download-url", nil)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, int64(0), counter.Pending(missingID))
+}
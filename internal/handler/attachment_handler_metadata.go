@@ -24,6 +24,36 @@ func validateEntityType(entityTypeStr string) (domain.EntityType, error) {
 	}
 }
 
+// validateVisibility defaults an empty visibility to BOARD
+// Comment attachments follow their comment, so they cannot be restricted
+func validateVisibility(visibilityStr string, entityType domain.EntityType) (domain.AttachmentVisibility, error) {
+	visibility := domain.AttachmentVisibility(strings.ToUpper(visibilityStr))
+
+	switch visibility {
+	case "", domain.AttachmentVisibilityBoard:
+		return domain.AttachmentVisibilityBoard, nil
+	case domain.AttachmentVisibilityRestricted:
+		if entityType == domain.EntityTypeComment {
+			return "", response.NewValidationError("Invalid visibility", "Comment attachments cannot be restricted")
+		}
+		return visibility, nil
+	default:
+		return "", response.NewValidationError("Invalid visibility", "Visibility must be BOARD or RESTRICTED")
+	}
+}
+
+// parseProjectContext parses the optional project an upload is made for
+func parseProjectContext(projectIDStr string) (*uuid.UUID, error) {
+	if projectIDStr == "" {
+		return nil, nil
+	}
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid project ID", "projectId must be a valid UUID")
+	}
+	return &projectID, nil
+}
+
 // validateFileType validates file type and extension
 func validateFileType(fileName, contentType string) error {
 	// Extract file extension using filepath.Ext
@@ -54,6 +84,10 @@ type SaveAttachmentMetadataRequest struct {
 	FileName    string `json:"fileName" binding:"required"`
 	FileSize    int64  `json:"fileSize" binding:"required"`
 	ContentType string `json:"contentType" binding:"required"`
+	// Visibility is BOARD (default) or RESTRICTED; comment attachments are always BOARD
+	Visibility string `json:"visibility"`
+	// ProjectID is optional; when set the attachment can only be confirmed to a board of that project
+	ProjectID string `json:"projectId"`
 }
 
 // AttachmentResponse represents the attachment metadata response
@@ -69,6 +103,14 @@ type AttachmentResponse struct {
 	UploadedBy  uuid.UUID  `json:"uploadedBy"`
 	UploadedAt  time.Time  `json:"uploadedAt"`
 	ExpiresAt   *time.Time `json:"expiresAt"`
+	// ChecksumSHA256 lets clients verify the downloaded file; set once the attachment is confirmed
+	ChecksumSHA256 string `json:"checksumSha256,omitempty"`
+	// AnnotationCount is the number of positional annotations left on the attachment
+	AnnotationCount int64 `json:"annotationCount"`
+	// DownloadCount is the number of download URLs issued; recent downloads may not be reflected yet
+	DownloadCount int64 `json:"downloadCount"`
+	// Visibility is BOARD, or RESTRICTED for files only project owners, admins and the uploader can see
+	Visibility string `json:"visibility"`
 }
 
 // SaveAttachmentMetadata godoc
@@ -77,6 +119,7 @@ type AttachmentResponse struct {
 // @Description  Creates a temporary attachment record with 1-hour expiration
 // @Description  The attachment will be linked to an entity (board/comment/project) when that entity is created
 // @Description  Supported entity types: BOARD, COMMENT, PROJECT
+// @Description  When projectId is given, confirming the attachment to a board of another project is rejected
 // @Tags         attachments
 // @Accept       json
 // @Produce      json
@@ -123,6 +166,18 @@ func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 		return
 	}
 
+	visibility, err := validateVisibility(req.Visibility, entityType)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, err.Error())
+		return
+	}
+
+	projectID, err := parseProjectContext(req.ProjectID)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, err.Error())
+		return
+	}
+
 	// Validate file size
 	if req.FileSize <= 0 {
 		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "File size must be greater than 0")
@@ -171,6 +226,8 @@ func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 		ContentType: req.ContentType,
 		UploadedBy:  userID,
 		ExpiresAt:   &expiresAt,
+		Visibility:  visibility,
+		ProjectID:   projectID,
 	}
 
 	// Save to database
@@ -192,6 +249,7 @@ func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 		UploadedBy:  attachment.UploadedBy,
 		UploadedAt:  attachment.CreatedAt,
 		ExpiresAt:   attachment.ExpiresAt,
+		Visibility:  string(attachment.Visibility),
 	}
 
 	response.SendSuccess(c, http.StatusCreated, resp)
@@ -201,6 +259,7 @@ func (h *AttachmentHandler) SaveAttachmentMetadata(c *gin.Context) {
 // @Summary      Get board attachments
 // @Description  Retrieves all attachments associated with a specific board
 // @Description  Returns only confirmed attachments linked to the board
+// @Description  Restricted attachments are omitted unless the user is a project owner, admin or the uploader
 // @Tags         attachments
 // @Accept       json
 // @Produce      json
@@ -43,7 +43,7 @@ func setupAttachmentHandlerWithRepo(t *testing.T, mockRepo *mockAttachmentReposi
 	}
 
 	// 핸들러 생성
-	handler := NewAttachmentHandler(s3Client, mockRepo)
+	handler := NewAttachmentHandler(s3Client, mockRepo, nil, nil)
 
 	// 인증 미들웨어가 포함된 라우터 설정
 	router := gin.New()
@@ -252,7 +252,7 @@ func TestSaveAttachmentMetadata_Unauthorized(t *testing.T) {
 	s3Client, err := client.NewS3Client(cfg)
 	require.NoError(t, err)
 	mockRepo := &mockAttachmentRepository{}
-	handler := NewAttachmentHandler(s3Client, mockRepo)
+	handler := NewAttachmentHandler(s3Client, mockRepo, nil, nil)
 	router := gin.New()
 	// 인증 미들웨어 없음 - user_id가 설정되지 않음
 	router.POST("/attachments", handler.SaveAttachmentMetadata)
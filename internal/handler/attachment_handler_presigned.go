@@ -59,6 +59,12 @@ func (h *AttachmentHandler) GeneratePresignedURL(c *gin.Context) {
 		return
 	}
 
+	projectID, err := parseProjectContext(req.ProjectID)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, err.Error())
+		return
+	}
+
 	// Validate file type and extension
 	if err := validateFileType(req.FileName, req.ContentType); err != nil {
 		response.SendError(c, http.StatusBadRequest, "INVALID_FILE_TYPE", err.Error())
@@ -104,6 +110,7 @@ func (h *AttachmentHandler) GeneratePresignedURL(c *gin.Context) {
 		ContentType: req.ContentType,
 		UploadedBy:  userID,
 		ExpiresAt:   &expiresAt,
+		ProjectID:   projectID,
 	}
 
 	// Save to database
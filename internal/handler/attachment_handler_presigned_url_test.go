@@ -17,6 +17,7 @@ import (
 
 	"project-board-api/internal/client"
 	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
 )
 
 // Mock attachment repository for testing
@@ -52,6 +53,10 @@ func (m *mockAttachmentRepository) FindByEntityID(ctx context.Context, entityTyp
 	return []*domain.Attachment{}, nil
 }
 
+func (m *mockAttachmentRepository) FindByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error) {
+	return map[uuid.UUID][]*domain.Attachment{}, nil
+}
+
 func (m *mockAttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if m.deleteFunc != nil {
 		return m.deleteFunc(ctx, id)
@@ -75,6 +80,22 @@ func (m *mockAttachmentRepository) DeleteBatch(ctx context.Context, attachmentID
 	return nil
 }
 
+func (m *mockAttachmentRepository) UpdateChecksum(ctx context.Context, id uuid.UUID, checksum string) error {
+	return nil
+}
+
+func (m *mockAttachmentRepository) ListByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID, query repository.AttachmentListQuery) ([]*domain.Attachment, error) {
+	return nil, nil
+}
+
+func (m *mockAttachmentRepository) IncrementDownloadCounts(ctx context.Context, counts map[uuid.UUID]int64) error {
+	return nil
+}
+
+func (m *mockAttachmentRepository) FindCoverImages(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]*domain.Attachment, error) {
+	return map[uuid.UUID]*domain.Attachment{}, nil
+}
+
 // setupAttachmentHandler creates a test handler with a mock S3 client
 func setupAttachmentHandler(t *testing.T) (*AttachmentHandler, *gin.Engine) {
 	gin.SetMode(gin.TestMode)
@@ -86,7 +107,7 @@ func setupAttachmentHandler(t *testing.T) (*AttachmentHandler, *gin.Engine) {
 	mockRepo := &mockAttachmentRepository{}
 
 	// Create handler
-	handler := NewAttachmentHandler(mockS3Client, mockRepo)
+	handler := NewAttachmentHandler(mockS3Client, mockRepo, nil, nil)
 
 	// Setup router with auth middleware
 	router := gin.New()
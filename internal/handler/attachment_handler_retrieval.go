@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,6 +11,20 @@ import (
 	"project-board-api/internal/response"
 )
 
+// annotationCounts loads annotation counts for the given attachments in a single query
+func (h *AttachmentHandler) annotationCounts(ctx context.Context, attachments []*domain.Attachment) (map[uuid.UUID]int64, error) {
+	if h.annotationRepo == nil || len(attachments) == 0 {
+		return map[uuid.UUID]int64{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(attachments))
+	for i, attachment := range attachments {
+		ids[i] = attachment.ID
+	}
+
+	return h.annotationRepo.CountByAttachmentIDs(ctx, ids)
+}
+
 func (h *AttachmentHandler) GetBoardAttachments(c *gin.Context) {
 	boardIDStr := c.Param("boardId")
 	boardID, err := uuid.Parse(boardIDStr)
@@ -24,6 +39,18 @@ func (h *AttachmentHandler) GetBoardAttachments(c *gin.Context) {
 		return
 	}
 
+	attachments, err = h.visibleAttachments(c, domain.EntityTypeBoard, boardID, attachments)
+	if err != nil {
+		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to retrieve attachments")
+		return
+	}
+
+	annotationCounts, err := h.annotationCounts(c.Request.Context(), attachments)
+	if err != nil {
+		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to retrieve attachments")
+		return
+	}
+
 	// Convert to response format
 	resp := make([]AttachmentResponse, len(attachments))
 	for i, attachment := range attachments {
@@ -31,17 +58,22 @@ func (h *AttachmentHandler) GetBoardAttachments(c *gin.Context) {
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
 
 		resp[i] = AttachmentResponse{
-			ID:          attachment.ID,
-			EntityType:  string(attachment.EntityType),
-			EntityID:    attachment.EntityID,
-			Status:      string(attachment.Status),
-			FileName:    attachment.FileName,
-			FileURL:     fileURL, // Return full URL to client
-			FileSize:    attachment.FileSize,
-			ContentType: attachment.ContentType,
-			UploadedBy:  attachment.UploadedBy,
-			UploadedAt:  attachment.CreatedAt,
-			ExpiresAt:   attachment.ExpiresAt,
+			ID:             attachment.ID,
+			EntityType:     string(attachment.EntityType),
+			EntityID:       attachment.EntityID,
+			Status:         string(attachment.Status),
+			FileName:       attachment.FileName,
+			FileURL:        fileURL, // Return full URL to client
+			FileSize:       attachment.FileSize,
+			ContentType:    attachment.ContentType,
+			UploadedBy:     attachment.UploadedBy,
+			UploadedAt:     attachment.CreatedAt,
+			ExpiresAt:      attachment.ExpiresAt,
+			ChecksumSHA256: attachment.ChecksumSHA256,
+			DownloadCount:  attachment.DownloadCount,
+			Visibility:     string(attachment.Visibility),
+
+			AnnotationCount: annotationCounts[attachment.ID],
 		}
 	}
 
@@ -74,6 +106,12 @@ func (h *AttachmentHandler) GetCommentAttachments(c *gin.Context) {
 		return
 	}
 
+	annotationCounts, err := h.annotationCounts(c.Request.Context(), attachments)
+	if err != nil {
+		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to retrieve attachments")
+		return
+	}
+
 	// Convert to response format
 	resp := make([]AttachmentResponse, len(attachments))
 	for i, attachment := range attachments {
@@ -81,17 +119,22 @@ func (h *AttachmentHandler) GetCommentAttachments(c *gin.Context) {
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
 
 		resp[i] = AttachmentResponse{
-			ID:          attachment.ID,
-			EntityType:  string(attachment.EntityType),
-			EntityID:    attachment.EntityID,
-			Status:      string(attachment.Status),
-			FileName:    attachment.FileName,
-			FileURL:     fileURL, // Return full URL to client
-			FileSize:    attachment.FileSize,
-			ContentType: attachment.ContentType,
-			UploadedBy:  attachment.UploadedBy,
-			UploadedAt:  attachment.CreatedAt,
-			ExpiresAt:   attachment.ExpiresAt,
+			ID:             attachment.ID,
+			EntityType:     string(attachment.EntityType),
+			EntityID:       attachment.EntityID,
+			Status:         string(attachment.Status),
+			FileName:       attachment.FileName,
+			FileURL:        fileURL, // Return full URL to client
+			FileSize:       attachment.FileSize,
+			ContentType:    attachment.ContentType,
+			UploadedBy:     attachment.UploadedBy,
+			UploadedAt:     attachment.CreatedAt,
+			ExpiresAt:      attachment.ExpiresAt,
+			ChecksumSHA256: attachment.ChecksumSHA256,
+			DownloadCount:  attachment.DownloadCount,
+			Visibility:     string(attachment.Visibility),
+
+			AnnotationCount: annotationCounts[attachment.ID],
 		}
 	}
 
@@ -102,6 +145,7 @@ func (h *AttachmentHandler) GetCommentAttachments(c *gin.Context) {
 // @Summary      Get project attachments
 // @Description  Retrieves all attachments associated with a specific project
 // @Description  Returns only confirmed attachments linked to the project
+// @Description  Restricted attachments are omitted unless the user is a project owner, admin or the uploader
 // @Tags         attachments
 // @Accept       json
 // @Produce      json
@@ -124,6 +168,18 @@ func (h *AttachmentHandler) GetProjectAttachments(c *gin.Context) {
 		return
 	}
 
+	attachments, err = h.visibleAttachments(c, domain.EntityTypeProject, projectID, attachments)
+	if err != nil {
+		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to retrieve attachments")
+		return
+	}
+
+	annotationCounts, err := h.annotationCounts(c.Request.Context(), attachments)
+	if err != nil {
+		response.SendError(c, http.StatusInternalServerError, response.ErrCodeInternal, "Failed to retrieve attachments")
+		return
+	}
+
 	// Convert to response format
 	resp := make([]AttachmentResponse, len(attachments))
 	for i, attachment := range attachments {
@@ -131,17 +187,22 @@ func (h *AttachmentHandler) GetProjectAttachments(c *gin.Context) {
 		fileURL := h.s3Client.GetFileURL(attachment.FileURL)
 
 		resp[i] = AttachmentResponse{
-			ID:          attachment.ID,
-			EntityType:  string(attachment.EntityType),
-			EntityID:    attachment.EntityID,
-			Status:      string(attachment.Status),
-			FileName:    attachment.FileName,
-			FileURL:     fileURL, // Return full URL to client
-			FileSize:    attachment.FileSize,
-			ContentType: attachment.ContentType,
-			UploadedBy:  attachment.UploadedBy,
-			UploadedAt:  attachment.CreatedAt,
-			ExpiresAt:   attachment.ExpiresAt,
+			ID:             attachment.ID,
+			EntityType:     string(attachment.EntityType),
+			EntityID:       attachment.EntityID,
+			Status:         string(attachment.Status),
+			FileName:       attachment.FileName,
+			FileURL:        fileURL, // Return full URL to client
+			FileSize:       attachment.FileSize,
+			ContentType:    attachment.ContentType,
+			UploadedBy:     attachment.UploadedBy,
+			UploadedAt:     attachment.CreatedAt,
+			ExpiresAt:      attachment.ExpiresAt,
+			ChecksumSHA256: attachment.ChecksumSHA256,
+			DownloadCount:  attachment.DownloadCount,
+			Visibility:     string(attachment.Visibility),
+
+			AnnotationCount: annotationCounts[attachment.ID],
 		}
 	}
 
@@ -38,7 +38,7 @@ func setupAttachmentRetrievalHandler(t *testing.T, mockRepo *mockAttachmentRepos
 		mockRepo = &mockAttachmentRepository{}
 	}
 
-	handler := NewAttachmentHandler(s3Client, mockRepo)
+	handler := NewAttachmentHandler(s3Client, mockRepo, nil, nil)
 
 	router := gin.New()
 	router.GET("/boards/:boardId/attachments", handler.GetBoardAttachments)
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// setupVisibilityTestRouter serves board attachments with access control; X-User-ID sets the caller
+func setupVisibilityTestRouter(t *testing.T, db *gorm.DB) *gin.Engine {
+	err := db.Exec(`
+		CREATE TABLE project_members (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			user_id TEXT NOT NULL,
+			role_name TEXT NOT NULL,
+			joined_at DATETIME NOT NULL,
+			UNIQUE(project_id, user_id)
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create project_members table")
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if userID, err := uuid.Parse(c.GetHeader("X-User-ID")); err == nil {
+			c.Set("user_id", userID)
+		}
+		c.Next()
+	})
+
+	h := NewAttachmentHandler(client.NewMockS3Client(), repository.NewAttachmentRepository(db), nil, nil,
+		WithAttachmentAccess(repository.NewBoardRepository(db), repository.NewProjectRepository(db)),
+	)
+	router.GET("/api/boards/:boardId/attachments", h.GetBoardAttachments)
+	router.GET("/api/attachments/:attachmentId/download-url", h.GetDownloadURL)
+	return router
+}
+
+func addTestMember(t *testing.T, db *gorm.DB, projectID uuid.UUID, role domain.ProjectRole) uuid.UUID {
+	member := &domain.ProjectMember{
+		ID:        uuid.New(),
+		ProjectID: projectID,
+		UserID:    uuid.New(),
+		RoleName:  role,
+		JoinedAt:  time.Now(),
+	}
+	require.NoError(t, db.Omit("Project").Create(member).Error)
+	return member.UserID
+}
+
+func createBoardAttachment(t *testing.T, db *gorm.DB, boardID, uploadedBy uuid.UUID, fileName string, visibility domain.AttachmentVisibility) *domain.Attachment {
+	attachment := &domain.Attachment{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		EntityType:  domain.EntityTypeBoard,
+		EntityID:    &boardID,
+		Status:      domain.AttachmentStatusConfirmed,
+		FileName:    fileName,
+		FileURL:     "board/files/" + fileName,
+		FileSize:    1024,
+		ContentType: "application/pdf",
+		UploadedBy:  uploadedBy,
+		Visibility:  visibility,
+	}
+	require.NoError(t, repository.NewAttachmentRepository(db).Create(context.Background(), attachment))
+	return attachment
+}
+
+func TestAttachmentVisibility_RestrictedHiddenFromMembers(t *testing.T) {
+	db := setupIntegrationTestDB(t)
+	router := setupVisibilityTestRouter(t, db)
+
+	project := createTestProject(t, db)
+	board := createTestBoard(t, db, project.ID)
+	admin := addTestMember(t, db, project.ID, domain.ProjectRoleAdmin)
+	viewer := addTestMember(t, db, project.ID, domain.ProjectRoleMember)
+
+	public := createBoardAttachment(t, db, board.ID, admin, "public.pdf", domain.AttachmentVisibilityBoard)
+	private := createBoardAttachment(t, db, board.ID, admin, "private.pdf", domain.AttachmentVisibilityRestricted)
+
+	list := func(userID uuid.UUID) []uuid.UUID {
+		req := httptest.NewRequest(http.MethodGet, "/api/boards/"+board.ID.String()+"/attachments", nil)
+		req.Header.Set("X-User-ID", userID.String())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var attachments []AttachmentResponse
+		resp := response.SuccessResponse{Data: &attachments}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		ids := make([]uuid.UUID, len(attachments))
+		for i, attachment := range attachments {
+			ids[i] = attachment.ID
+		}
+		return ids
+	}
+
+	download := func(userID uuid.UUID, attachmentID uuid.UUID) int {
+		req := httptest.NewRequest(http.MethodGet, "/api/attachments/"+attachmentID.String()+"/download-url", nil)
+		req.Header.Set("X-User-ID", userID.String())
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Code
+	}
+
+	assert.Equal(t, []uuid.UUID{public.ID}, list(viewer))
+	assert.Equal(t, http.StatusOK, download(viewer, public.ID))
+	assert.Equal(t, http.StatusForbidden, download(viewer, private.ID))
+
+	assert.ElementsMatch(t, []uuid.UUID{public.ID, private.ID}, list(admin))
+	assert.Equal(t, http.StatusOK, download(admin, private.ID))
+
+	// Users outside the project never see restricted files
+	assert.Equal(t, http.StatusForbidden, download(uuid.New(), private.ID))
+}
+
+func TestAttachmentVisibility_UploaderKeepsAccess(t *testing.T) {
+	db := setupIntegrationTestDB(t)
+	router := setupVisibilityTestRouter(t, db)
+
+	project := createTestProject(t, db)
+	board := createTestBoard(t, db, project.ID)
+	uploader := addTestMember(t, db, project.ID, domain.ProjectRoleMember)
+	private := createBoardAttachment(t, db, board.ID, uploader, "notes.pdf", domain.AttachmentVisibilityRestricted)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/attachments/"+private.ID.String()+"/download-url", nil)
+	req.Header.Set("X-User-ID", uploader.String())
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestValidateVisibility(t *testing.T) {
+	visibility, err := validateVisibility("", domain.EntityTypeBoard)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AttachmentVisibilityBoard, visibility)
+
+	visibility, err = validateVisibility("restricted", domain.EntityTypeProject)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AttachmentVisibilityRestricted, visibility)
+
+	_, err = validateVisibility("RESTRICTED", domain.EntityTypeComment)
+	assert.Error(t, err)
+
+	_, err = validateVisibility("SECRET", domain.EntityTypeBoard)
+	assert.Error(t, err)
+}
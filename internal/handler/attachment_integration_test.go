@@ -54,7 +54,11 @@ func setupAttachmentIntegrationTestDB(t *testing.T) *gorm.DB {
 			file_size INTEGER NOT NULL,
 			content_type TEXT NOT NULL,
 			uploaded_by TEXT NOT NULL,
-			expires_at DATETIME
+			expires_at DATETIME,
+			checksum_sha256 TEXT,
+			download_count INTEGER NOT NULL DEFAULT 0,
+			visibility TEXT NOT NULL DEFAULT 'BOARD',
+			project_id TEXT
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create attachments table")
@@ -81,7 +85,7 @@ func setupAttachmentIntegrationRouter(db *gorm.DB, s3Client client.S3ClientInter
 	attachmentRepo := repository.NewAttachmentRepository(db)
 
 	// Initialize handler
-	attachmentHandler := NewAttachmentHandler(s3Client, attachmentRepo)
+	attachmentHandler := NewAttachmentHandler(s3Client, attachmentRepo, nil, nil)
 
 	// Setup routes
 	api := router.Group("/api")
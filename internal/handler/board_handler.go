@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -20,12 +22,41 @@ import (
 
 type BoardHandler struct {
 	boardService service.BoardService
+	// strictDecoding rejects unknown fields in board create/update requests
+	strictDecoding bool
 }
 
-func NewBoardHandler(boardService service.BoardService) *BoardHandler {
-	return &BoardHandler{
+// BoardHandlerOption configures optional BoardHandler behaviour
+type BoardHandlerOption func(*BoardHandler)
+
+// WithStrictDecoding enables rejecting unknown JSON fields in board create/update requests
+func WithStrictDecoding(strict bool) BoardHandlerOption {
+	return func(h *BoardHandler) {
+		h.strictDecoding = strict
+	}
+}
+
+// userContext carries the authenticated user into the service layer, which reads it as "user_id"
+// The caller's token is passed along as "jwtToken" for services that call the user service on their behalf
+func userContext(c *gin.Context) context.Context {
+	ctx := c.Request.Context()
+	if userID, exists := c.Get("user_id"); exists {
+		ctx = context.WithValue(ctx, "user_id", userID)
+	}
+	if token, exists := c.Get("jwtToken"); exists {
+		ctx = context.WithValue(ctx, "jwtToken", token)
+	}
+	return ctx
+}
+
+func NewBoardHandler(boardService service.BoardService, opts ...BoardHandlerOption) *BoardHandler {
+	h := &BoardHandler{
 		boardService: boardService,
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 // CreateBoard godoc
@@ -53,17 +84,12 @@ func NewBoardHandler(boardService service.BoardService) *BoardHandler {
 // @Router       /boards [post]
 func (h *BoardHandler) CreateBoard(c *gin.Context) {
 	var req dto.CreateBoardRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
 		return
 	}
 
-	ctx := c.Request.Context()
-	if userID, exists := c.Get("user_id"); exists {
-		ctx = context.WithValue(ctx, "user_id", userID)
-	}
-
-	board, err := h.boardService.CreateBoard(ctx, &req)
+	board, err := h.boardService.CreateBoard(userContext(c), &req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -105,7 +131,7 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 		return
 	}
 
-	board, err := h.boardService.GetBoard(c.Request.Context(), boardID)
+	board, err := h.boardService.GetBoard(userContext(c), boardID)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -120,11 +146,13 @@ func (h *BoardHandler) GetBoard(c *gin.Context) {
 // @Description  응답의 customFields는 value 기반 (UUID가 아닌 문자열 값)
 // @Description  예시: {"importance": "high", "role": "developer", "stage": "in_progress"}
 // @Description  각 보드는 participantIds (참여자 ID 배열)와 attachments (첨부파일 메타데이터 배열)를 포함합니다
+// @Description  coverThumbnailUrl은 보드의 커버 이미지 URL이며, 커버가 없으면 null입니다
 // @Description  startDate와 dueDate는 설정된 경우에만 포함됩니다
 // @Tags         boards
 // @Produce      json
 // @Param        projectId    path      string  true   "Project ID (UUID)"
 // @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"importance\":\"high\",\"stage\":\"in_progress\"}"
+// @Param        includeArchived query  bool    false  "보관된 Board 포함 여부 (기본값 false)"
 // @Success      200 {object} response.SuccessResponse{data=[]dto.BoardResponse} "Board 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 필터 파라미터"
 // @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
@@ -149,8 +177,9 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 		}
 		filters.CustomFields = customFields
 	}
+	filters.IncludeArchived = c.Query("includeArchived") == "true"
 
-	boards, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
+	boards, err := h.boardService.GetBoardsByProject(userContext(c), projectID, filters)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -159,6 +188,338 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 	response.SendSuccess(c, http.StatusOK, boards)
 }
 
+// SearchBoards godoc
+// @Summary      Project의 Board 검색 (텍스트 + 필터)
+// @Description  제목과 내용에서 텍스트를 검색하면서 customFields 및 마감일 범위 필터를 함께 적용합니다
+// @Description  모든 조건을 만족하는 Board만 반환되며, 제목 일치가 내용 일치보다 먼저 정렬됩니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId    path      string  true   "Project ID (UUID)"
+// @Param        q            query     string  true   "검색어"
+// @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"stage\":\"in_progress\"}"
+// @Param        dueFrom      query     string  false  "마감일 시작 (RFC3339)"
+// @Param        dueTo        query     string  false  "마감일 끝 (RFC3339)"
+// @Param        page         query     int     false  "페이지 번호 (기본값 1)"
+// @Param        limit        query     int     false  "페이지 크기 (기본값 10, 최대 100)"
+// @Param        includeArchived query  bool    false  "보관된 Board 포함 여부 (기본값 false)"
+// @Success      200 {object} response.SuccessResponse{data=dto.PaginatedBoardsResponse} "Board 검색 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 검색 파라미터"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/search [get]
+func (h *BoardHandler) SearchBoards(c *gin.Context) {
+	projectIDStr := c.Param("projectId")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	req := dto.SearchBoardsRequest{Query: c.Query("q")}
+	if customFieldsStr := c.Query("customFields"); customFieldsStr != "" {
+		if err := json.Unmarshal([]byte(customFieldsStr), &req.Filters.CustomFields); err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid customFields format: must be valid JSON")
+			return
+		}
+	}
+	for param, target := range map[string]**time.Time{"dueFrom": &req.DueFrom, "dueTo": &req.DueTo} {
+		if value := c.Query(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid "+param+": must be RFC3339")
+				return
+			}
+			*target = &parsed
+		}
+	}
+	req.Page, _ = strconv.Atoi(c.Query("page"))
+	req.Limit, _ = strconv.Atoi(c.Query("limit"))
+	req.Filters.IncludeArchived = c.Query("includeArchived") == "true"
+
+	result, err := h.boardService.SearchBoards(userContext(c), projectID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// ListBoards godoc
+// @Summary      Project의 Board 목록 조회 (필터, 정렬 및 페이지네이션)
+// @Description  담당자, 참여자, 마감일 범위, Label, customFields 값으로 필터링하고 지정한 기준으로 정렬하여 페이지 단위로 조회합니다
+// @Description  customFields 필터는 옵션 ID가 아닌 값으로 전달합니다
+// @Description  시작일 또는 마감일로 정렬하면 날짜가 없는 Board는 정렬 방향과 관계없이 마지막에 위치합니다
+// @Description  다음 페이지는 응답의 nextCursor를 cursor로 전달하여 조회하며, hasMore가 false이면 마지막 페이지입니다
+// @Description  cursor는 같은 정렬 기준으로만 사용할 수 있으며, 마지막 Board가 수정되거나 삭제되어도 다음 페이지를 이어서 조회합니다
+// @Description  coverThumbnailUrl은 보드의 커버 이미지 URL이며, 커버가 없으면 null입니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId       path   string  true   "Project ID (UUID)"
+// @Param        assigneeId      query  string  false  "담당자 ID (UUID)"
+// @Param        participantId   query  string  false  "참여자 ID (UUID)"
+// @Param        dueFrom         query  string  false  "마감일 시작 (RFC3339)"
+// @Param        dueTo           query  string  false  "마감일 끝 (RFC3339)"
+// @Param        labelIds        query  string  false  "쉼표로 구분한 Label ID 목록 (하나라도 붙은 Board와 일치)"
+// @Param        customFields    query  string  false  "Custom Fields 필터 JSON 객체. 예시: {\"stage\":\"in_progress\"}"
+// @Param        includeArchived query  bool    false  "보관된 Board 포함 여부 (기본값 false)"
+// @Param        sortBy          query  string  false  "정렬 기준: createdAt (기본값), title, startDate, dueDate"
+// @Param        order           query  string  false  "정렬 방향: desc (기본값), asc"
+// @Param        sort            query  string  false  "다중 정렬 (sortBy, order 대신 사용). 예시: dueDate:asc,createdAt:desc. 방향 생략 시 createdAt은 desc, 나머지는 asc"
+// @Param        cursor          query  string  false  "이전 페이지의 nextCursor"
+// @Param        limit           query  int     false  "페이지 크기 (기본값 50, 최대 100)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardListPageResponse} "Board 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/page [get]
+func (h *BoardHandler) ListBoards(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	query, ok := bindBoardListQuery(c)
+	if !ok {
+		return
+	}
+
+	page, err := h.boardService.ListBoards(userContext(c), projectID, &query)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, page)
+}
+
+// bindBoardListQuery reads the board list filters, sort and page from the query string
+// It responds with 400 and returns false when a parameter is malformed
+func bindBoardListQuery(c *gin.Context) (dto.BoardListQuery, bool) {
+	query := dto.BoardListQuery{
+		SortBy: c.Query("sortBy"),
+		Order:  c.Query("order"),
+		Cursor: c.Query("cursor"),
+	}
+	for param, target := range map[string]**uuid.UUID{"assigneeId": &query.AssigneeID, "participantId": &query.ParticipantID} {
+		if value := c.Query(param); value != "" {
+			parsed, err := uuid.Parse(value)
+			if err != nil {
+				response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid "+param)
+				return query, false
+			}
+			*target = &parsed
+		}
+	}
+	for param, target := range map[string]**time.Time{"dueFrom": &query.DueFrom, "dueTo": &query.DueTo} {
+		if value := c.Query(param); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid "+param+": must be RFC3339")
+				return query, false
+			}
+			*target = &parsed
+		}
+	}
+	if sortSpec := c.Query("sort"); sortSpec != "" {
+		for _, item := range strings.Split(sortSpec, ",") {
+			key, direction, hasDirection := strings.Cut(strings.TrimSpace(item), ":")
+			field := dto.SortField{Key: key}
+			if hasDirection {
+				if direction != "asc" && direction != "desc" {
+					response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid sort: direction must be asc or desc")
+					return query, false
+				}
+				desc := direction == "desc"
+				field.Desc = &desc
+			}
+			query.SortSpec = append(query.SortSpec, field)
+		}
+	}
+	if labelIDs := c.Query("labelIds"); labelIDs != "" {
+		for _, value := range strings.Split(labelIDs, ",") {
+			parsed, err := uuid.Parse(strings.TrimSpace(value))
+			if err != nil {
+				response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid labelIds")
+				return query, false
+			}
+			query.LabelIDs = append(query.LabelIDs, parsed)
+		}
+	}
+	if customFieldsStr := c.Query("customFields"); customFieldsStr != "" {
+		if err := json.Unmarshal([]byte(customFieldsStr), &query.CustomFields); err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid customFields format: must be valid JSON")
+			return query, false
+		}
+	}
+	query.IncludeArchived = c.Query("includeArchived") == "true"
+	query.Limit, _ = strconv.Atoi(c.Query("limit"))
+
+	return query, true
+}
+
+// CountBoards godoc
+// @Summary      Project의 Board 개수 조회
+// @Description  Board 목록 조회와 동일한 필터로 Board 개수를 조회합니다 (페이지네이션 UI용)
+// @Description  approximate=true이면 테이블 통계 기반의 근사값을 반환합니다 (대용량 테이블용)
+// @Tags         boards
+// @Produce      json
+// @Param        projectId    path      string  true   "Project ID (UUID)"
+// @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"importance\":\"high\",\"stage\":\"in_progress\"}"
+// @Param        approximate  query     bool    false  "근사 개수 사용 여부"
+// @Param        includeArchived query  bool    false  "보관된 Board 포함 여부 (기본값 false)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardCountResponse} "Board 개수 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 필터 파라미터"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/count [get]
+func (h *BoardHandler) CountBoards(c *gin.Context) {
+	projectIDStr := c.Param("projectId")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	filters := &dto.BoardFilters{}
+	customFieldsStr := c.Query("customFields")
+
+	if customFieldsStr != "" {
+		var customFields map[string]interface{}
+		if err := json.Unmarshal([]byte(customFieldsStr), &customFields); err != nil {
+			response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid customFields format: must be valid JSON")
+			return
+		}
+		filters.CustomFields = customFields
+	}
+	filters.IncludeArchived = c.Query("includeArchived") == "true"
+
+	approximate := c.Query("approximate") == "true"
+
+	count, err := h.boardService.CountBoards(c.Request.Context(), projectID, filters, approximate)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, count)
+}
+
+// GetProjectEffort godoc
+// @Summary      Project의 공수 집계 조회
+// @Description  Project에 속한 Board들의 예상 공수(estimateHours)와 실제 공수(actualHours) 합계를 조회합니다
+// @Description  variance는 실제 공수 합계 - 예상 공수 합계입니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ProjectEffortResponse} "공수 집계 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/effort [get]
+func (h *BoardHandler) GetProjectEffort(c *gin.Context) {
+	projectIDStr := c.Param("projectId")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	effort, err := h.boardService.GetProjectEffort(c.Request.Context(), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, effort)
+}
+
+// AggregateCustomField godoc
+// @Summary      Project의 Custom Field 옵션별 Board 수 집계
+// @Description  Project에 속한 Board들을 지정한 Custom Field(stage, role, importance)의 옵션별로 집계합니다
+// @Description  사용되지 않는 옵션은 count 0으로 포함되며, 값이 없는 Board는 unset으로 집계됩니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        fieldKey  path string true "Custom Field 키 (stage, role, importance)"
+// @Success      200 {object} response.SuccessResponse{data=dto.CustomFieldAggregationResponse} "집계 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 Custom Field"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/custom-fields/{fieldKey}/aggregate [get]
+func (h *BoardHandler) AggregateCustomField(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	aggregation, err := h.boardService.AggregateCustomField(c.Request.Context(), projectID, c.Param("fieldKey"))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, aggregation)
+}
+
+// GetOrphanedAssignees godoc
+// @Summary      Project 멤버가 아닌 담당자가 지정된 Board 조회
+// @Description  담당자(assigneeId)가 더 이상 Project 멤버가 아닌 Board 목록을 조회합니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.OrphanedAssigneesResponse} "조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/orphaned-assignees [get]
+func (h *BoardHandler) GetOrphanedAssignees(c *gin.Context) {
+	projectIDStr := c.Param("projectId")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	orphans, err := h.boardService.FindOrphanedAssignees(c.Request.Context(), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, orphans)
+}
+
+// CleanOrphanedAssignees godoc
+// @Summary      Project 멤버가 아닌 담당자 정리
+// @Description  담당자가 더 이상 Project 멤버가 아닌 Board에서 담당자를 해제합니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.CleanOrphanedAssigneesResponse} "정리 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/orphaned-assignees/clean [post]
+func (h *BoardHandler) CleanOrphanedAssignees(c *gin.Context) {
+	projectIDStr := c.Param("projectId")
+	projectID, err := uuid.Parse(projectIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	result, err := h.boardService.CleanOrphanedAssignees(userContext(c), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
 // GetBoardsByProjectQuery godoc
 // @Summary      Project의 Board 목록 조회 (쿼리 파라미터 방식)
 // @Description  특정 Project에 속한 모든 Board를 조회합니다. 프론트엔드 호환용 엔드포인트
@@ -170,6 +531,7 @@ func (h *BoardHandler) GetBoardsByProject(c *gin.Context) {
 // @Produce      json
 // @Param        projectId    query     string  true   "Project ID (UUID)"
 // @Param        customFields query     string  false  "Custom Fields 필터 JSON 객체. 예시: {\"importance\":\"high\",\"stage\":\"in_progress\"}"
+// @Param        includeArchived query  bool    false  "보관된 Board 포함 여부 (기본값 false)"
 // @Success      200 {object} response.SuccessResponse{data=[]dto.BoardResponse} "Board 목록 조회 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 필터 파라미터"
 // @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
@@ -199,8 +561,9 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 		}
 		filters.CustomFields = customFields
 	}
+	filters.IncludeArchived = c.Query("includeArchived") == "true"
 
-	boards, err := h.boardService.GetBoardsByProject(c.Request.Context(), projectID, filters)
+	boards, err := h.boardService.GetBoardsByProject(userContext(c), projectID, filters)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -217,6 +580,9 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 // @Description  예시 값: stage="completed", role="designer", importance="medium"
 // @Description  잘못된 field value 제공 시 400 에러 반환
 // @Description  startDate와 dueDate를 수정할 수 있으며, startDate는 dueDate보다 이전이어야 합니다
+// @Description  expectedVersion을 보내면 그 사이 다른 사용자가 수정한 경우 409 에러를 반환합니다 (응답의 version 사용)
+// @Description  participants는 Project 멤버여야 하며, 멤버가 아닌 사용자 ID는 400 에러의 fields에 표시됩니다
+// @Description  Board, 참여자, Label, 첨부파일 확정은 하나의 트랜잭션으로 처리되어 일부만 반영되지 않습니다
 // @Tags         boards
 // @Accept       json
 // @Produce      json
@@ -225,6 +591,7 @@ func (h *BoardHandler) GetBoardsByProjectQuery(c *gin.Context) {
 // @Success      200 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 수정 성공"
 // @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 유효하지 않은 field value"
 // @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "다른 사용자가 먼저 수정함 (version 불일치)"
 // @Failure      500 {object} response.ErrorResponse "서버 에러"
 // @Router       /boards/{boardId} [put]
 func (h *BoardHandler) UpdateBoard(c *gin.Context) {
@@ -236,12 +603,12 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 	}
 
 	var req dto.UpdateBoardRequest
-	if err := c.ShouldBindJSON(&req); err != nil {
-		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
 		return
 	}
 
-	board, err := h.boardService.UpdateBoard(c.Request.Context(), boardID, &req)
+	board, err := h.boardService.UpdateBoard(userContext(c), boardID, &req)
 	if err != nil {
 		handleServiceError(c, err)
 		return
@@ -259,9 +626,566 @@ func (h *BoardHandler) UpdateBoard(c *gin.Context) {
 	BroadcastEvent(board.ProjectID.String(), event)
 }
 
+// CloneBoard godoc
+// @Summary      Board 복제
+// @Description  Board를 같은 Project에 복제합니다. 요청한 사용자가 복제본의 작성자가 됩니다
+// @Description  포함할 항목을 플래그로 선택할 수 있으며, 생략하면 customFields와 참여자만 복사합니다
+// @Description  첨부파일은 새 파일로 복사되고, 댓글은 includeComments=true일 때만 복사됩니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.CloneBoardRequest false "복제 옵션"
+// @Success      201 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 복제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "Project의 Board 개수 제한 초과"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/clone [post]
+func (h *BoardHandler) CloneBoard(c *gin.Context) {
+	boardIDStr := c.Param("boardId")
+	boardID, err := uuid.Parse(boardIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	// The body is optional; without one the default components are copied
+	var req dto.CloneBoardRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+			sendBindError(c, err)
+			return
+		}
+	}
+
+	board, err := h.boardService.CloneBoard(userContext(c), boardID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, board)
+
+	event := WSEvent{
+		Type:    "BOARD_CREATED",
+		BoardID: board.ID.String(),
+		Payload: board,
+	}
+	BroadcastEvent(board.ProjectID.String(), event)
+}
+
+// ListBoardAttachments godoc
+// @Summary      Board 첨부파일 목록 조회 (필터 및 페이지네이션)
+// @Description  Board의 첨부파일을 contentType으로 필터링하고 생성일 또는 크기로 정렬하여 페이지 단위로 조회합니다
+// @Description  contentType이 "/"로 끝나면 해당 계열 전체를 조회합니다 (예: "image/")
+// @Description  확정되지 않은 첨부파일은 includePending=true일 때만 포함됩니다
+// @Description  다음 페이지는 응답의 nextCursor를 cursor로 전달하여 조회합니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId        path   string  true   "Board ID (UUID)"
+// @Param        contentType    query  string  false  "Content type 또는 계열 (예: image/png, image/)"
+// @Param        includePending query  bool    false  "확정되지 않은 첨부파일 포함 여부"
+// @Param        sortBy         query  string  false  "정렬 기준: createdAt (기본값), size"
+// @Param        order          query  string  false  "정렬 방향: desc (기본값), asc"
+// @Param        cursor         query  string  false  "이전 페이지의 nextCursor"
+// @Param        limit          query  int     false  "페이지 크기 (기본값 20, 최대 100)"
+// @Success      200 {object} response.SuccessResponse{data=dto.AttachmentPageResponse} "첨부파일 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/attachments/page [get]
+func (h *BoardHandler) ListBoardAttachments(c *gin.Context) {
+	boardIDStr := c.Param("boardId")
+	boardID, err := uuid.Parse(boardIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var filter dto.AttachmentFilter
+	var pagination dto.AttachmentPagination
+	if err := c.ShouldBindQuery(&filter); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid query parameters")
+		return
+	}
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid query parameters")
+		return
+	}
+
+	page, err := h.boardService.ListAttachments(userContext(c), boardID, &filter, &pagination)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, page)
+}
+
+// ImportBoards godoc
+// @Summary      외부 시스템 Board 가져오기
+// @Description  외부 시스템의 Board를 Project로 가져옵니다. 각 항목은 externalId로 식별됩니다
+// @Description  같은 externalId의 Board가 이미 있으면 수정하고, 없으면 새로 생성하므로 반복해서 가져와도 중복되지 않습니다
+// @Description  실패한 항목은 results에 error로 표시되며 나머지 항목은 계속 처리됩니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.ImportBoardsRequest true "Board 가져오기 요청 (최대 500개)"
+// @Success      200 {object} response.SuccessResponse{data=dto.ImportBoardsResponse} "가져오기 결과"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/import [post]
+func (h *BoardHandler) ImportBoards(c *gin.Context) {
+	var req dto.ImportBoardsRequest
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
+		return
+	}
+
+	result, err := h.boardService.ImportBoards(userContext(c), &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// BulkCreateBoards godoc
+// @Summary      Board 일괄 생성
+// @Description  제목 목록으로 여러 Board를 한 번에 생성합니다. 모든 Board는 defaults의 날짜, customFields, 담당자를 공유합니다
+// @Description  하나의 트랜잭션으로 처리되어 전부 생성되거나 하나도 생성되지 않습니다
+// @Description  기존 Board 또는 요청 내 앞선 제목과 중복되는 제목은 생성되지만 results에 warning으로 표시됩니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.BulkCreateBoardsRequest true "Board 일괄 생성 요청 (최대 100개)"
+// @Success      201 {object} response.SuccessResponse{data=dto.BulkCreateBoardsResponse} "일괄 생성 결과"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/bulk-create [post]
+func (h *BoardHandler) BulkCreateBoards(c *gin.Context) {
+	var req dto.BulkCreateBoardsRequest
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
+		return
+	}
+
+	result, err := h.boardService.BulkCreateBoards(userContext(c), &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, result)
+}
+
+// BulkUpdateBoards godoc
+// @Summary      Board 일괄 수정 (진행 상황 스트리밍)
+// @Description  여러 Board를 순서대로 수정하고, 각 항목이 끝날 때마다 결과를 NDJSON 한 줄로 바로 내려보냅니다
+// @Description  각 항목은 PUT /boards/{boardId}와 동일한 규칙으로 검증되며, 실패한 항목은 success=false와 error로 표시됩니다
+// @Description  클라이언트가 연결을 끊으면 남은 항목은 처리되지 않습니다
+// @Tags         boards
+// @Accept       json
+// @Produce      application/x-ndjson
+// @Param        request body dto.BulkUpdateBoardsRequest true "Board 일괄 수정 요청 (최대 500개)"
+// @Success      200 {object} dto.BulkBoardUpdateResult "항목별 결과 (한 줄에 하나씩)"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Router       /boards/bulk-update [post]
+func (h *BoardHandler) BulkUpdateBoards(c *gin.Context) {
+	var req dto.BulkUpdateBoardsRequest
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Status(http.StatusOK)
+
+	log := getLogger(c)
+	encoder := json.NewEncoder(c.Writer)
+	err := h.boardService.BulkUpdateBoardsStream(userContext(c), req.Items, func(result dto.BulkBoardUpdateResult) {
+		if err := encoder.Encode(result); err != nil {
+			log.Warn("Failed to write bulk update result", zap.Error(err))
+		}
+		c.Writer.Flush()
+
+		if result.Success {
+			BroadcastEvent(result.Board.ProjectID.String(), WSEvent{
+				Type:    "BOARD_UPDATED",
+				BoardID: result.BoardID.String(),
+				Payload: result.Board,
+			})
+		}
+	})
+	if err != nil {
+		// Headers are already sent; the client sees a truncated stream
+		log.Info("Bulk board update stopped early", zap.Error(err))
+	}
+}
+
+// BatchUpdateBoards godoc
+// @Summary      여러 Board 일괄 수정 (트랜잭션)
+// @Description  여러 Board를 하나의 트랜잭션으로 수정합니다. 모든 Board가 수정되거나 하나도 수정되지 않습니다
+// @Description  각 항목은 boardId와 PUT /boards/{boardId}와 같은 필드를 가지며, 같은 규칙으로 검증됩니다
+// @Description  하나라도 실패하면 applied=false이며, 항목별 결과의 error로 실패 원인(또는 롤백 여부)을 확인할 수 있습니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.BatchUpdateBoardsRequest true "Board 일괄 수정 요청 (최대 100개)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BatchUpdateBoardsResponse} "항목별 결과 (applied=false이면 아무것도 변경되지 않음)"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 최대 개수 초과"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/batch-update [post]
+func (h *BoardHandler) BatchUpdateBoards(c *gin.Context) {
+	var req dto.BatchUpdateBoardsRequest
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
+		return
+	}
+
+	result, err := h.boardService.BatchUpdateBoards(userContext(c), req.Items)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+
+	for _, item := range result.Results {
+		if item.Success {
+			BroadcastEvent(item.Board.ProjectID.String(), WSEvent{
+				Type:    "BOARD_UPDATED",
+				BoardID: item.BoardID.String(),
+				Payload: item.Board,
+			})
+		}
+	}
+}
+
+// MoveBoardToProject godoc
+// @Summary      Board를 다른 Project로 이동
+// @Description  Board를 대상 Project로 이동하며, Custom Field는 대상 Project의 옵션으로 다시 매핑됩니다
+// @Description  대상 Project에 없는 필드나 옵션은 제거되어 droppedFields로 보고되며, strict=true이면 이동이 거부됩니다
+// @Description  대상 Project의 멤버가 아닌 참여자는 nonMemberParticipants로 보고됩니다 (참여자에서 제거되지 않음)
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.MoveBoardToProjectRequest true "Board 이동 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.MoveBoardToProjectResponse} "Board 이동 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 strict 모드에서 호환되지 않는 Custom Field"
+// @Failure      404 {object} response.ErrorResponse "Board 또는 대상 Project를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "동시 수정 충돌, 제목 중복 또는 Board 개수 제한 초과"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/move-project [post]
+func (h *BoardHandler) MoveBoardToProject(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var req dto.MoveBoardToProjectRequest
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
+		return
+	}
+
+	result, err := h.boardService.MoveBoard(userContext(c), boardID, req.TargetProjectID, req.Strict)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+
+	BroadcastEvent(result.FromProjectID.String(), WSEvent{
+		Type:    "BOARD_DELETED",
+		BoardID: boardID.String(),
+		Payload: map[string]string{
+			"boardId": boardID.String(),
+		},
+	})
+	BroadcastEvent(req.TargetProjectID.String(), WSEvent{
+		Type:    "BOARD_CREATED",
+		BoardID: boardID.String(),
+		Payload: result.Board,
+	})
+}
+
+// BulkMoveBoards godoc
+// @Summary      여러 Board를 다른 Project로 일괄 이동
+// @Description  Board들을 대상 Project로 이동하며, Custom Field는 대상 Project의 옵션으로 다시 매핑됩니다
+// @Description  대상 Project에 없는 필드나 옵션은 제거되어 droppedFields로 보고되며, strict=true이면 해당 Board는 이동되지 않습니다
+// @Description  allOrNothing=true이면 하나의 트랜잭션으로 이동하여 하나라도 실패하면 아무것도 이동되지 않습니다 (applied=false)
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.BulkMoveBoardsRequest true "Board 일괄 이동 요청 (최대 100개)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BulkMoveBoardsResponse} "Board별 이동 결과"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 최대 개수 초과"
+// @Failure      404 {object} response.ErrorResponse "대상 Project를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "대상 Project의 Board 개수 제한 초과"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/bulk-move [post]
+func (h *BoardHandler) BulkMoveBoards(c *gin.Context) {
+	var req dto.BulkMoveBoardsRequest
+	if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+		sendBindError(c, err)
+		return
+	}
+
+	result, err := h.boardService.BulkMoveBoards(userContext(c), &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+
+	// The board leaves one project's view and appears in the other's
+	for _, item := range result.Results {
+		if !item.Success {
+			continue
+		}
+		BroadcastEvent(item.FromProjectID.String(), WSEvent{
+			Type:    "BOARD_DELETED",
+			BoardID: item.BoardID.String(),
+			Payload: map[string]string{
+				"boardId": item.BoardID.String(),
+			},
+		})
+		BroadcastEvent(req.TargetProjectID.String(), WSEvent{
+			Type:    "BOARD_CREATED",
+			BoardID: item.BoardID.String(),
+			Payload: item.Board,
+		})
+	}
+}
+
+// PatchBoard godoc
+// @Summary      Board 부분 수정 (JSON Patch)
+// @Description  RFC 6902 JSON Patch 연산(add, remove, replace, test)으로 Board를 수정합니다
+// @Description  지원 경로: /title, /content, /assigneeId, /startDate, /dueDate, /estimateHours, /actualHours, /customFields, /customFields/{fieldType}
+// @Description  패치 결과는 수정 API와 동일한 규칙(날짜, customFields, 담당자)으로 다시 검증됩니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body []dto.PatchOp true "JSON Patch 연산 목록"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 패치 연산 또는 지원하지 않는 경로"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId} [patch]
+func (h *BoardHandler) PatchBoard(c *gin.Context) {
+	boardIDStr := c.Param("boardId")
+	boardID, err := uuid.Parse(boardIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var ops []dto.PatchOp
+	if err := c.ShouldBindJSON(&ops); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	board, err := h.boardService.PatchBoard(userContext(c), boardID, ops)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, board)
+
+	event := WSEvent{
+		Type:    "BOARD_UPDATED",
+		BoardID: boardID.String(),
+		Payload: board,
+	}
+	BroadcastEvent(board.ProjectID.String(), event)
+}
+
+// GetBoardActivity godoc
+// @Summary      Board 변경 이력 조회
+// @Description  Board 수정으로 변경된 필드의 이전 값과 새 값, 변경한 사용자, 변경 시각을 최신순으로 조회합니다
+// @Description  Custom Field는 옵션 ID가 아닌 값으로 기록됩니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path  string true  "Board ID (UUID)"
+// @Param        page    query int    false "페이지 번호 (기본값 1)"
+// @Param        limit   query int    false "페이지 크기 (기본값 20, 최대 100)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardActivityPageResponse} "변경 이력 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/activity [get]
+func (h *BoardHandler) GetBoardActivity(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(c.Query("page"))
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	activity, err := h.boardService.GetBoardActivity(c.Request.Context(), boardID, page, limit)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, activity)
+}
+
+// GetBoardAtTime godoc
+// @Summary      특정 시점의 Board 상태 조회
+// @Description  변경 이력을 현재 상태에서 거꾸로 되돌려 지정한 시점의 Board 필드 값을 재구성합니다
+// @Description  변경 이력에 기록되는 필드만 재구성되며, Custom Field는 옵션 ID가 아닌 값으로 반환됩니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path  string true "Board ID (UUID)"
+// @Param        at      query string true "조회 시점 (RFC3339)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardStateResponse} "Board 상태 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID 또는 시점"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없거나 해당 시점에 존재하지 않음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/state [get]
+func (h *BoardHandler) GetBoardAtTime(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	at, err := time.Parse(time.RFC3339, c.Query("at"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid at: must be RFC3339")
+		return
+	}
+
+	state, err := h.boardService.GetBoardAtTime(c.Request.Context(), boardID, at)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, state)
+}
+
+// TouchBoard godoc
+// @Summary      Board 활동 시각 갱신
+// @Description  필드 변경 없이 Board의 updatedAt만 갱신합니다 (예: 조회 시 최근 활동 표시)
+// @Description  Board 수정 이벤트는 브로드캐스트되지 않습니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "갱신 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/touch [post]
+func (h *BoardHandler) TouchBoard(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	if err := h.boardService.TouchBoard(c.Request.Context(), boardID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// ArchiveBoard godoc
+// @Summary      Board 보관
+// @Description  Board를 삭제하지 않고 보관합니다. 보관된 Board는 includeArchived=true 없이는 목록/검색/개수 조회에서 제외됩니다
+// @Description  참여자, 댓글, 첨부파일은 그대로 유지되며, 보관 중에는 수정할 수 없습니다 (400 에러)
+// @Description  보관 사유(reason)를 함께 기록할 수 있으며, 허용된 사유가 설정된 경우 그 중 하나여야 합니다. 복원하면 사유는 지워집니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.ArchiveBoardRequest false "보관 사유"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardDetailResponse} "Board 보관 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID, 허용되지 않은 사유 또는 이미 보관된 Board"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "동시 수정 충돌"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/archive [post]
+func (h *BoardHandler) ArchiveBoard(c *gin.Context) {
+	// The body is optional; without one the board is archived without a reason
+	var req dto.ArchiveBoardRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+			sendBindError(c, err)
+			return
+		}
+	}
+
+	h.setBoardArchived(c, func(ctx context.Context, boardID uuid.UUID) error {
+		return h.boardService.ArchiveBoard(ctx, boardID, req.Reason)
+	})
+}
+
+// RestoreBoard godoc
+// @Summary      보관된 Board 복원
+// @Description  보관된 Board를 다시 목록에 표시합니다. 프로젝트의 Board 개수 제한을 초과하면 복원할 수 없습니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.BoardDetailResponse} "Board 복원 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID 또는 보관되지 않은 Board"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "동시 수정 충돌 또는 Board 개수 제한 초과"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/restore [post]
+func (h *BoardHandler) RestoreBoard(c *gin.Context) {
+	h.setBoardArchived(c, h.boardService.RestoreBoard)
+}
+
+// setBoardArchived runs an archive or restore and responds with the board as it is now
+func (h *BoardHandler) setBoardArchived(c *gin.Context, apply func(ctx context.Context, boardID uuid.UUID) error) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	if err := apply(userContext(c), boardID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	board, err := h.boardService.GetBoard(userContext(c), boardID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, board)
+
+	BroadcastEvent(board.ProjectID.String(), WSEvent{
+		Type:    "BOARD_UPDATED",
+		BoardID: boardID.String(),
+		Payload: board,
+	})
+}
+
 // DeleteBoard godoc
 // @Summary      Board 삭제
-// @Description  Board를 소프트 삭제합니다
+// @Description  Board를 영구 삭제합니다. 참여자, 댓글, 첨부파일(S3 객체 포함)도 함께 삭제됩니다
+// @Description  나중에 복원할 수 있도록 남겨두려면 보관(POST /boards/{boardId}/archive)을 사용하세요
 // @Tags         boards
 // @Produce      json
 // @Param        boardId path string true "Board ID (UUID)"
@@ -279,13 +1203,13 @@ func (h *BoardHandler) DeleteBoard(c *gin.Context) {
 	}
 
 	// 💡 [수정] 삭제 전에 보드 정보 가져오기 (projectId 필요)
-	board, err := h.boardService.GetBoard(c.Request.Context(), boardID)
+	board, err := h.boardService.GetBoard(userContext(c), boardID)
 	if err != nil {
 		handleServiceError(c, err)
 		return
 	}
 
-	err = h.boardService.DeleteBoard(c.Request.Context(), boardID)
+	err = h.boardService.DeleteBoard(userContext(c), boardID)
 	if err != nil {
 		handleServiceError(c, err)
 		return
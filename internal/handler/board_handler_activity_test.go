@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/metrics"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/service"
+)
+
+// newActivityTestHandler wires a board handler to real repositories so the activities it records can be inspected
+func newActivityTestHandler(db *gorm.DB) *BoardHandler {
+	boardRepo := repository.NewBoardRepository(db)
+	fieldOptionRepo := repository.NewFieldOptionRepository(db)
+	boardService := service.NewBoardService(
+		boardRepo,
+		repository.NewProjectRepository(db),
+		fieldOptionRepo,
+		repository.NewParticipantRepository(db),
+		repository.NewAttachmentRepository(db),
+		nil,
+		converter.NewFieldOptionConverter(fieldOptionRepo),
+		metrics.NewTestMetrics(),
+		zap.NewNop(),
+		service.WithTransactor(repository.NewTransactor(db)),
+	)
+	return NewBoardHandler(boardService)
+}
+
+// TestBoardHandler_RecordsAuthenticatedActor drives each board-changing endpoint through the auth middleware
+// and checks the stored activity names the requesting user rather than uuid.Nil
+func TestBoardHandler_RecordsAuthenticatedActor(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		route  string
+		target func(boardID uuid.UUID) string
+		body   func(boardID uuid.UUID) string
+		setup  func(h *BoardHandler) gin.HandlerFunc
+	}{
+		{
+			name:   "UpdateBoard",
+			method: http.MethodPut,
+			route:  "/api/boards/:boardId",
+			target: func(boardID uuid.UUID) string { return "/api/boards/" + boardID.String() },
+			body:   func(uuid.UUID) string { return `{"title":"Updated"}` },
+			setup:  func(h *BoardHandler) gin.HandlerFunc { return h.UpdateBoard },
+		},
+		{
+			name:   "PatchBoard",
+			method: http.MethodPatch,
+			route:  "/api/boards/:boardId",
+			target: func(boardID uuid.UUID) string { return "/api/boards/" + boardID.String() },
+			body:   func(uuid.UUID) string { return `[{"op":"replace","path":"/title","value":"Patched"}]` },
+			setup:  func(h *BoardHandler) gin.HandlerFunc { return h.PatchBoard },
+		},
+		{
+			name:   "BatchUpdateBoards",
+			method: http.MethodPost,
+			route:  "/api/boards/batch-update",
+			target: func(uuid.UUID) string { return "/api/boards/batch-update" },
+			body: func(boardID uuid.UUID) string {
+				return `{"items":[{"boardId":"` + boardID.String() + `","title":"Batched"}]}`
+			},
+			setup: func(h *BoardHandler) gin.HandlerFunc { return h.BatchUpdateBoards },
+		},
+		{
+			name:   "BulkUpdateBoards",
+			method: http.MethodPost,
+			route:  "/api/boards/bulk-update",
+			target: func(uuid.UUID) string { return "/api/boards/bulk-update" },
+			body: func(boardID uuid.UUID) string {
+				return `{"items":[{"boardId":"` + boardID.String() + `","update":{"title":"Bulk"}}]}`
+			},
+			setup: func(h *BoardHandler) gin.HandlerFunc { return h.BulkUpdateBoards },
+		},
+		{
+			name:   "ArchiveBoard",
+			method: http.MethodPost,
+			route:  "/api/boards/:boardId/archive",
+			target: func(boardID uuid.UUID) string { return "/api/boards/" + boardID.String() + "/archive" },
+			body:   func(uuid.UUID) string { return "" },
+			setup:  func(h *BoardHandler) gin.HandlerFunc { return h.ArchiveBoard },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			db := setupIntegrationTestDB(t)
+			project := createTestProject(t, db)
+			board := createTestBoard(t, db, project.ID)
+			userID := uuid.New()
+
+			router := setupAuthTestRouter()
+			router.Handle(tt.method, tt.route, tt.setup(newActivityTestHandler(db)))
+
+			req := newAuthRequest(t, tt.method, tt.target(board.ID), bytes.NewBufferString(tt.body(board.ID)), userID)
+			w := httptest.NewRecorder()
+
+			// When
+			router.ServeHTTP(w, req)
+
+			// Then
+			require.Equal(t, http.StatusOK, w.Code, "Response body: %s", w.Body.String())
+
+			var activities []domain.BoardActivity
+			require.NoError(t, db.Where("board_id = ?", board.ID).Find(&activities).Error)
+			require.NotEmpty(t, activities, "expected the change to be recorded")
+			for _, activity := range activities {
+				assert.Equal(t, userID, activity.ActorID, "activity for %s", activity.Field)
+			}
+		})
+	}
+}
+
+// TestBoardHandler_MoveRecordsAuthenticatedActor checks the project moves credit their activity to the requesting user
+func TestBoardHandler_MoveRecordsAuthenticatedActor(t *testing.T) {
+	tests := []struct {
+		name   string
+		route  string
+		target func(boardID uuid.UUID) string
+		body   func(boardID, targetProjectID uuid.UUID) string
+		setup  func(h *BoardHandler) gin.HandlerFunc
+	}{
+		{
+			name:   "MoveBoardToProject",
+			route:  "/api/boards/:boardId/move-project",
+			target: func(boardID uuid.UUID) string { return "/api/boards/" + boardID.String() + "/move-project" },
+			body: func(_, targetProjectID uuid.UUID) string {
+				return `{"targetProjectId":"` + targetProjectID.String() + `"}`
+			},
+			setup: func(h *BoardHandler) gin.HandlerFunc { return h.MoveBoardToProject },
+		},
+		{
+			name:   "BulkMoveBoards",
+			route:  "/api/boards/bulk-move",
+			target: func(uuid.UUID) string { return "/api/boards/bulk-move" },
+			body: func(boardID, targetProjectID uuid.UUID) string {
+				return `{"boardIds":["` + boardID.String() + `"],"targetProjectId":"` + targetProjectID.String() + `"}`
+			},
+			setup: func(h *BoardHandler) gin.HandlerFunc { return h.BulkMoveBoards },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			db := setupIntegrationTestDB(t)
+			// The move checks the participants against the target project's members
+			require.NoError(t, db.Exec(`
+				CREATE TABLE project_members (
+					id TEXT PRIMARY KEY,
+					project_id TEXT NOT NULL,
+					user_id TEXT NOT NULL,
+					role_name TEXT NOT NULL,
+					joined_at DATETIME NOT NULL
+				)
+			`).Error, "Failed to create project_members table")
+			project := createTestProject(t, db)
+			target := createTestProject(t, db)
+			board := createTestBoard(t, db, project.ID)
+			userID := uuid.New()
+
+			router := setupAuthTestRouter()
+			router.POST(tt.route, tt.setup(newActivityTestHandler(db)))
+
+			req := newAuthRequest(t, http.MethodPost, tt.target(board.ID), bytes.NewBufferString(tt.body(board.ID, target.ID)), userID)
+			w := httptest.NewRecorder()
+
+			// When
+			router.ServeHTTP(w, req)
+
+			// Then
+			require.Equal(t, http.StatusOK, w.Code, "Response body: %s", w.Body.String())
+
+			var activity domain.BoardActivity
+			require.NoError(t, db.Where("board_id = ? AND field = ?", board.ID, "projectId").First(&activity).Error)
+			assert.Equal(t, target.ID.String(), activity.NewValue)
+			assert.Equal(t, userID, activity.ActorID)
+		})
+	}
+}
+
+func TestBoardHandler_UpdateBoard_AutoParticipantActor(t *testing.T) {
+	// Given
+	db := setupIntegrationTestDB(t)
+	project := createTestProject(t, db)
+	board := createTestBoard(t, db, project.ID)
+	userID := uuid.New()
+	assigneeID := uuid.New()
+
+	router := setupAuthTestRouter()
+	router.PUT("/api/boards/:boardId", newActivityTestHandler(db).UpdateBoard)
+
+	body := `{"assigneeId":"` + assigneeID.String() + `"}`
+	req := newAuthRequest(t, http.MethodPut, "/api/boards/"+board.ID.String(), bytes.NewBufferString(body), userID)
+	w := httptest.NewRecorder()
+
+	// When
+	router.ServeHTTP(w, req)
+
+	// Then
+	require.Equal(t, http.StatusOK, w.Code, "Response body: %s", w.Body.String())
+
+	// The participant added for the new assignee is credited to the user who assigned them
+	var activity domain.BoardActivity
+	require.NoError(t, db.Where("board_id = ? AND field = ?", board.ID, "participantAutoAdded").First(&activity).Error)
+	assert.Equal(t, assigneeID.String(), activity.NewValue)
+	assert.Equal(t, userID, activity.ActorID)
+}
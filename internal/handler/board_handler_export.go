@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+// ExportBoard godoc
+// @Summary      Board 내보내기 (CSV/JSON)
+// @Description  Board를 CSV 또는 JSON 파일로 내보냅니다
+// @Description  customFields는 옵션 ID가 아닌 값으로, 날짜는 UTC ISO-8601 형식으로 내보냅니다
+// @Description  작성자, 담당자, 참여자는 가능한 경우 워크스페이스 표시 이름이 함께 포함됩니다
+// @Description  CSV의 customFields 컬럼은 Project에 정의된 필드 기준이므로 값이 없는 필드도 빈 컬럼으로 포함됩니다
+// @Tags         boards
+// @Produce      text/csv
+// @Produce      json
+// @Param        boardId path  string true  "Board ID (UUID)"
+// @Param        format  query string false "내보내기 형식: csv (기본값), json"
+// @Success      200 {file} file "내보낸 파일"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID 또는 형식"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/export [get]
+func (h *BoardHandler) ExportBoard(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	export, err := h.boardService.ExportBoard(userContext(c), boardID, c.DefaultQuery("format", service.BoardExportFormatCSV))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	sendExport(c, export)
+}
+
+// ExportProjectBoards godoc
+// @Summary      Project의 Board 내보내기 (CSV/JSON)
+// @Description  Board 목록 조회와 동일한 필터와 정렬로 Project의 모든 Board를 CSV 또는 JSON 파일로 내보냅니다
+// @Description  cursor와 limit은 무시되며, 파일은 Board를 페이지 단위로 조회하면서 스트리밍으로 전송됩니다
+// @Description  customFields는 옵션 ID가 아닌 값으로, 날짜는 UTC ISO-8601 형식으로 내보냅니다
+// @Description  CSV의 customFields 컬럼은 Project에 정의된 필드 기준이므로 모든 행의 컬럼이 동일합니다
+// @Tags         boards
+// @Produce      text/csv
+// @Produce      json
+// @Param        projectId       path   string  true   "Project ID (UUID)"
+// @Param        format          query  string  false  "내보내기 형식: csv (기본값), json"
+// @Param        assigneeId      query  string  false  "담당자 ID (UUID)"
+// @Param        participantId   query  string  false  "참여자 ID (UUID)"
+// @Param        dueFrom         query  string  false  "마감일 시작 (RFC3339)"
+// @Param        dueTo           query  string  false  "마감일 끝 (RFC3339)"
+// @Param        labelIds        query  string  false  "쉼표로 구분한 Label ID 목록 (하나라도 붙은 Board와 일치)"
+// @Param        customFields    query  string  false  "Custom Fields 필터 JSON 객체. 예시: {\"stage\":\"in_progress\"}"
+// @Param        includeArchived query  bool    false  "보관된 Board 포함 여부 (기본값 false)"
+// @Param        sortBy          query  string  false  "정렬 기준: createdAt (기본값), title, startDate, dueDate"
+// @Param        order           query  string  false  "정렬 방향: desc (기본값), asc"
+// @Param        sort            query  string  false  "다중 정렬 (sortBy, order 대신 사용). 예시: dueDate:asc,createdAt:desc"
+// @Success      200 {file} file "내보낸 파일"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 형식"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/export [get]
+func (h *BoardHandler) ExportProjectBoards(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	query, ok := bindBoardListQuery(c)
+	if !ok {
+		return
+	}
+
+	export, err := h.boardService.ExportProjectBoards(userContext(c), projectID, &query, c.DefaultQuery("format", service.BoardExportFormatCSV))
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	sendExport(c, export)
+}
+
+// ExportProjectBackup godoc
+// @Summary      Project 백업 내보내기
+// @Description  재해 복구용으로 Project의 모든 Board(보관된 Board 포함)와 첨부파일 원본을 하나의 tar.gz 아카이브로 내보냅니다
+// @Description  아카이브는 manifest.json(형식 버전, customFields 옵션, Label), Board별 JSON, 첨부파일 객체로 구성됩니다
+// @Description  Board를 페이지 단위로 조회하고 첨부파일을 S3에서 바로 스트리밍하므로 Project 크기와 관계없이 메모리 사용량이 일정합니다
+// @Tags         boards
+// @Produce      application/gzip
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {file} file "백업 아카이브"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/backup [get]
+func (h *BoardHandler) ExportProjectBackup(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	w := &downloadWriter{c: c, fileName: fmt.Sprintf("project-%s-backup.tar.gz", projectID), contentType: "application/gzip"}
+	err = h.boardService.ExportProjectBackup(c.Request.Context(), projectID, w)
+	if err == nil {
+		w.start()
+		return
+	}
+	if !w.started {
+		handleServiceError(c, err)
+		return
+	}
+	// Headers are already sent; the client sees a truncated archive
+	getLogger(c).Warn("Project backup stopped early", zap.String("project_id", projectID.String()), zap.Error(err))
+}
+
+// ImportProjectBackup godoc
+// @Summary      Project 백업 가져오기
+// @Description  백업 내보내기로 만든 tar.gz 아카이브를 요청 본문으로 받아 Board와 첨부파일을 Project에 복원합니다
+// @Description  복원된 Board와 첨부파일은 새 ID를 받으며, boardIds에 백업의 Board ID별 새 ID가 담깁니다
+// @Description  Project에 없는 customFields 옵션과 Label은 manifest 기준으로 먼저 생성됩니다
+// @Description  아카이브 전체를 먼저 검증하고 첨부파일을 업로드한 뒤, Board마다 별도의 트랜잭션으로 Board 개수 제한 안에서 복원합니다
+// @Description  이미 사용 중인 제목이나 externalId는 번호를 붙인 제목으로 바꾸거나 비워서 복원하고, 복원하지 못한 Board는 failed와 issues로 알려줍니다
+// @Tags         boards
+// @Accept       application/gzip
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      201 {object} response.SuccessResponse{data=dto.ImportBoardBackupResponse} "복원 결과"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID 또는 아카이브"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/backup [post]
+func (h *BoardHandler) ImportProjectBackup(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	result, err := h.boardService.ImportProjectBackup(c.Request.Context(), projectID, c.Request.Body)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, result)
+}
+
+// downloadWriter sends download headers with the first write
+// Until then an error can still be answered with a regular error response
+type downloadWriter struct {
+	c           *gin.Context
+	fileName    string
+	contentType string
+	started     bool
+}
+
+func (w *downloadWriter) Write(p []byte) (int, error) {
+	w.start()
+	return w.c.Writer.Write(p)
+}
+
+// start sends the status and headers unless they were already sent
+func (w *downloadWriter) start() {
+	if w.started {
+		return
+	}
+	w.started = true
+	w.c.Header("Content-Type", w.contentType)
+	w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.fileName))
+	w.c.Status(http.StatusOK)
+	w.c.Writer.WriteHeaderNow()
+}
+
+// sendExport streams an export as a file download
+// The status is sent before the content is read, so a failure while streaming ends the response early
+func sendExport(c *gin.Context, export *service.BoardExport) {
+	defer export.Content.Close()
+
+	c.DataFromReader(http.StatusOK, -1, export.ContentType, export.Content, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s"`, export.FileName),
+	})
+}
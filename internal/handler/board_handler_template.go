@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// SaveBoardAsTemplate godoc
+// @Summary      Board를 템플릿으로 저장
+// @Description  Board의 제목, 내용, customFields, 담당자, 참여자를 같은 Project의 템플릿으로 저장합니다
+// @Description  customFields는 옵션 ID가 아닌 값으로 저장되며, 템플릿 이름은 Board 제목입니다
+// @Tags         boards
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      201 {object} response.SuccessResponse{data=dto.BoardTemplateResponse} "템플릿 저장 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/{boardId}/template [post]
+func (h *BoardHandler) SaveBoardAsTemplate(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	template, err := h.boardService.SaveBoardAsTemplate(userContext(c), boardID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, template)
+}
+
+// GetBoardTemplates godoc
+// @Summary      Project의 Board 템플릿 목록 조회
+// @Description  Project에 저장된 Board 템플릿을 최신순으로 조회합니다
+// @Tags         boards
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.BoardTemplateResponse} "템플릿 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/project/{projectId}/templates [get]
+func (h *BoardHandler) GetBoardTemplates(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	templates, err := h.boardService.GetBoardTemplates(c.Request.Context(), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, templates)
+}
+
+// CreateBoardFromTemplate godoc
+// @Summary      템플릿으로 Board 생성
+// @Description  템플릿의 값으로 Board를 생성한 뒤 요청 본문의 값으로 덮어씁니다
+// @Description  템플릿의 customFields 중 삭제되거나 보관된 옵션은 건너뛰고, 나머지 값으로 Board를 생성합니다
+// @Description  요청 본문의 customFields는 키 단위로 템플릿 값을 덮어쓰며, 잘못된 값이면 400 에러를 반환합니다
+// @Tags         boards
+// @Accept       json
+// @Produce      json
+// @Param        templateId path string true "Template ID (UUID)"
+// @Param        request body dto.CreateBoardFromTemplateRequest false "템플릿 값을 덮어쓸 항목"
+// @Success      201 {object} response.SuccessResponse{data=dto.BoardResponse} "Board 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "템플릿을 찾을 수 없음"
+// @Failure      409 {object} response.ErrorResponse "Project의 Board 개수 제한 초과"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /boards/templates/{templateId}/boards [post]
+func (h *BoardHandler) CreateBoardFromTemplate(c *gin.Context) {
+	templateID, err := uuid.Parse(c.Param("templateId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid template ID")
+		return
+	}
+
+	// The body is optional; without one the template is used as is
+	var req dto.CreateBoardFromTemplateRequest
+	if c.Request.ContentLength != 0 {
+		if err := bindJSON(c, &req, h.strictDecoding); err != nil {
+			sendBindError(c, err)
+			return
+		}
+	}
+
+	board, err := h.boardService.CreateBoardFromTemplate(userContext(c), templateID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, board)
+
+	event := WSEvent{
+		Type:    "BOARD_CREATED",
+		BoardID: board.ID.String(),
+		Payload: board,
+	}
+	BroadcastEvent(board.ProjectID.String(), event)
+}
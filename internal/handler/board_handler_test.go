@@ -4,23 +4,148 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 
 	"project-board-api/internal/dto"
+	"project-board-api/internal/middleware"
 	"project-board-api/internal/response"
+	"project-board-api/internal/service"
 )
 
 // MockBoardService is a mock implementation of BoardService
 type MockBoardService struct {
-	CreateBoardFunc        func(ctx context.Context, req *dto.CreateBoardRequest) (*dto.BoardResponse, error)
-	GetBoardFunc           func(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error)
-	GetBoardsByProjectFunc func(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) ([]*dto.BoardResponse, error)
-	UpdateBoardFunc        func(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
-	DeleteBoardFunc        func(ctx context.Context, boardID uuid.UUID) error
+	CreateBoardFunc             func(ctx context.Context, req *dto.CreateBoardRequest) (*dto.BoardResponse, error)
+	GetBoardFunc                func(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error)
+	GetBoardsByProjectFunc      func(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) ([]*dto.BoardResponse, error)
+	UpdateBoardFunc             func(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
+	DeleteBoardFunc             func(ctx context.Context, boardID uuid.UUID) error
+	TouchBoardFunc              func(ctx context.Context, boardID uuid.UUID) error
+	ArchiveBoardFunc            func(ctx context.Context, boardID uuid.UUID, reason string) error
+	RestoreBoardFunc            func(ctx context.Context, boardID uuid.UUID) error
+	GetBoardActivityFunc        func(ctx context.Context, boardID uuid.UUID, page, limit int) (*dto.BoardActivityPageResponse, error)
+	GetBoardAtTimeFunc          func(ctx context.Context, boardID uuid.UUID, at time.Time) (*dto.BoardStateResponse, error)
+	ListBoardsFunc              func(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery) (*dto.BoardListPageResponse, error)
+	PatchBoardFunc              func(ctx context.Context, boardID uuid.UUID, ops []dto.PatchOp) (*dto.BoardResponse, error)
+	CountBoardsFunc             func(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters, approximate bool) (*dto.BoardCountResponse, error)
+	AggregateCustomFieldFunc    func(ctx context.Context, projectID uuid.UUID, fieldKey string) (*dto.CustomFieldAggregationResponse, error)
+	GetProjectEffortFunc        func(ctx context.Context, projectID uuid.UUID) (*dto.ProjectEffortResponse, error)
+	FindOrphanedAssigneesFunc   func(ctx context.Context, projectID uuid.UUID) (*dto.OrphanedAssigneesResponse, error)
+	CleanOrphanedAssigneesFunc  func(ctx context.Context, projectID uuid.UUID) (*dto.CleanOrphanedAssigneesResponse, error)
+	BulkUpdateBoardsStreamFunc  func(ctx context.Context, items []dto.BulkBoardUpdateItem, onResult func(dto.BulkBoardUpdateResult)) error
+	BatchUpdateBoardsFunc       func(ctx context.Context, items []dto.BatchBoardUpdateItem) (*dto.BatchUpdateBoardsResponse, error)
+	BulkMoveBoardsFunc          func(ctx context.Context, req *dto.BulkMoveBoardsRequest) (*dto.BulkMoveBoardsResponse, error)
+	MoveBoardFunc               func(ctx context.Context, boardID, targetProjectID uuid.UUID, strict bool) (*dto.MoveBoardToProjectResponse, error)
+	CloneBoardFunc              func(ctx context.Context, boardID uuid.UUID, req *dto.CloneBoardRequest) (*dto.BoardResponse, error)
+	SearchBoardsFunc            func(ctx context.Context, projectID uuid.UUID, req *dto.SearchBoardsRequest) (*dto.PaginatedBoardsResponse, error)
+	BulkCreateBoardsFunc        func(ctx context.Context, req *dto.BulkCreateBoardsRequest) (*dto.BulkCreateBoardsResponse, error)
+	ImportBoardsFunc            func(ctx context.Context, req *dto.ImportBoardsRequest) (*dto.ImportBoardsResponse, error)
+	ListAttachmentsFunc         func(ctx context.Context, boardID uuid.UUID, filter *dto.AttachmentFilter, pagination *dto.AttachmentPagination) (*dto.AttachmentPageResponse, error)
+	SaveBoardAsTemplateFunc     func(ctx context.Context, boardID uuid.UUID) (*dto.BoardTemplateResponse, error)
+	GetBoardTemplatesFunc       func(ctx context.Context, projectID uuid.UUID) ([]*dto.BoardTemplateResponse, error)
+	CreateBoardFromTemplateFunc func(ctx context.Context, templateID uuid.UUID, overrides *dto.CreateBoardFromTemplateRequest) (*dto.BoardResponse, error)
+	ExportBoardFunc             func(ctx context.Context, boardID uuid.UUID, format string) (*service.BoardExport, error)
+	ExportProjectBoardsFunc     func(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery, format string) (*service.BoardExport, error)
+	ExportProjectBackupFunc     func(ctx context.Context, projectID uuid.UUID, w io.Writer) error
+	ImportProjectBackupFunc     func(ctx context.Context, projectID uuid.UUID, r io.Reader) (*dto.ImportBoardBackupResponse, error)
+}
+
+func (m *MockBoardService) ExportBoard(ctx context.Context, boardID uuid.UUID, format string) (*service.BoardExport, error) {
+	if m.ExportBoardFunc != nil {
+		return m.ExportBoardFunc(ctx, boardID, format)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) ExportProjectBoards(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery, format string) (*service.BoardExport, error) {
+	if m.ExportProjectBoardsFunc != nil {
+		return m.ExportProjectBoardsFunc(ctx, projectID, query, format)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) ExportProjectBackup(ctx context.Context, projectID uuid.UUID, w io.Writer) error {
+	if m.ExportProjectBackupFunc != nil {
+		return m.ExportProjectBackupFunc(ctx, projectID, w)
+	}
+	return nil
+}
+
+func (m *MockBoardService) ImportProjectBackup(ctx context.Context, projectID uuid.UUID, r io.Reader) (*dto.ImportBoardBackupResponse, error) {
+	if m.ImportProjectBackupFunc != nil {
+		return m.ImportProjectBackupFunc(ctx, projectID, r)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) SaveBoardAsTemplate(ctx context.Context, boardID uuid.UUID) (*dto.BoardTemplateResponse, error) {
+	if m.SaveBoardAsTemplateFunc != nil {
+		return m.SaveBoardAsTemplateFunc(ctx, boardID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) GetBoardTemplates(ctx context.Context, projectID uuid.UUID) ([]*dto.BoardTemplateResponse, error) {
+	if m.GetBoardTemplatesFunc != nil {
+		return m.GetBoardTemplatesFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) CreateBoardFromTemplate(ctx context.Context, templateID uuid.UUID, overrides *dto.CreateBoardFromTemplateRequest) (*dto.BoardResponse, error) {
+	if m.CreateBoardFromTemplateFunc != nil {
+		return m.CreateBoardFromTemplateFunc(ctx, templateID, overrides)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) SearchBoards(ctx context.Context, projectID uuid.UUID, req *dto.SearchBoardsRequest) (*dto.PaginatedBoardsResponse, error) {
+	if m.SearchBoardsFunc != nil {
+		return m.SearchBoardsFunc(ctx, projectID, req)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) BulkCreateBoards(ctx context.Context, req *dto.BulkCreateBoardsRequest) (*dto.BulkCreateBoardsResponse, error) {
+	if m.BulkCreateBoardsFunc != nil {
+		return m.BulkCreateBoardsFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) ImportBoards(ctx context.Context, req *dto.ImportBoardsRequest) (*dto.ImportBoardsResponse, error) {
+	if m.ImportBoardsFunc != nil {
+		return m.ImportBoardsFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) ListAttachments(ctx context.Context, boardID uuid.UUID, filter *dto.AttachmentFilter, pagination *dto.AttachmentPagination) (*dto.AttachmentPageResponse, error) {
+	if m.ListAttachmentsFunc != nil {
+		return m.ListAttachmentsFunc(ctx, boardID, filter, pagination)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) CloneBoard(ctx context.Context, boardID uuid.UUID, req *dto.CloneBoardRequest) (*dto.BoardResponse, error) {
+	if m.CloneBoardFunc != nil {
+		return m.CloneBoardFunc(ctx, boardID, req)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) GetProjectEffort(ctx context.Context, projectID uuid.UUID) (*dto.ProjectEffortResponse, error) {
+	if m.GetProjectEffortFunc != nil {
+		return m.GetProjectEffortFunc(ctx, projectID)
+	}
+	return nil, nil
 }
 
 func (m *MockBoardService) CreateBoard(ctx context.Context, req *dto.CreateBoardRequest) (*dto.BoardResponse, error) {
@@ -58,6 +183,111 @@ func (m *MockBoardService) DeleteBoard(ctx context.Context, boardID uuid.UUID) e
 	return nil
 }
 
+func (m *MockBoardService) TouchBoard(ctx context.Context, boardID uuid.UUID) error {
+	if m.TouchBoardFunc != nil {
+		return m.TouchBoardFunc(ctx, boardID)
+	}
+	return nil
+}
+
+func (m *MockBoardService) ArchiveBoard(ctx context.Context, boardID uuid.UUID, reason string) error {
+	if m.ArchiveBoardFunc != nil {
+		return m.ArchiveBoardFunc(ctx, boardID, reason)
+	}
+	return nil
+}
+
+func (m *MockBoardService) RestoreBoard(ctx context.Context, boardID uuid.UUID) error {
+	if m.RestoreBoardFunc != nil {
+		return m.RestoreBoardFunc(ctx, boardID)
+	}
+	return nil
+}
+
+func (m *MockBoardService) AggregateCustomField(ctx context.Context, projectID uuid.UUID, fieldKey string) (*dto.CustomFieldAggregationResponse, error) {
+	if m.AggregateCustomFieldFunc != nil {
+		return m.AggregateCustomFieldFunc(ctx, projectID, fieldKey)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) GetBoardActivity(ctx context.Context, boardID uuid.UUID, page, limit int) (*dto.BoardActivityPageResponse, error) {
+	if m.GetBoardActivityFunc != nil {
+		return m.GetBoardActivityFunc(ctx, boardID, page, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) GetBoardAtTime(ctx context.Context, boardID uuid.UUID, at time.Time) (*dto.BoardStateResponse, error) {
+	if m.GetBoardAtTimeFunc != nil {
+		return m.GetBoardAtTimeFunc(ctx, boardID, at)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) ListBoards(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery) (*dto.BoardListPageResponse, error) {
+	if m.ListBoardsFunc != nil {
+		return m.ListBoardsFunc(ctx, projectID, query)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) CountBoards(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters, approximate bool) (*dto.BoardCountResponse, error) {
+	if m.CountBoardsFunc != nil {
+		return m.CountBoardsFunc(ctx, projectID, filters, approximate)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) PatchBoard(ctx context.Context, boardID uuid.UUID, ops []dto.PatchOp) (*dto.BoardResponse, error) {
+	if m.PatchBoardFunc != nil {
+		return m.PatchBoardFunc(ctx, boardID, ops)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) FindOrphanedAssignees(ctx context.Context, projectID uuid.UUID) (*dto.OrphanedAssigneesResponse, error) {
+	if m.FindOrphanedAssigneesFunc != nil {
+		return m.FindOrphanedAssigneesFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) CleanOrphanedAssignees(ctx context.Context, projectID uuid.UUID) (*dto.CleanOrphanedAssigneesResponse, error) {
+	if m.CleanOrphanedAssigneesFunc != nil {
+		return m.CleanOrphanedAssigneesFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) BulkUpdateBoardsStream(ctx context.Context, items []dto.BulkBoardUpdateItem, onResult func(dto.BulkBoardUpdateResult)) error {
+	if m.BulkUpdateBoardsStreamFunc != nil {
+		return m.BulkUpdateBoardsStreamFunc(ctx, items, onResult)
+	}
+	return nil
+}
+
+func (m *MockBoardService) BatchUpdateBoards(ctx context.Context, items []dto.BatchBoardUpdateItem) (*dto.BatchUpdateBoardsResponse, error) {
+	if m.BatchUpdateBoardsFunc != nil {
+		return m.BatchUpdateBoardsFunc(ctx, items)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) BulkMoveBoards(ctx context.Context, req *dto.BulkMoveBoardsRequest) (*dto.BulkMoveBoardsResponse, error) {
+	if m.BulkMoveBoardsFunc != nil {
+		return m.BulkMoveBoardsFunc(ctx, req)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardService) MoveBoard(ctx context.Context, boardID, targetProjectID uuid.UUID, strict bool) (*dto.MoveBoardToProjectResponse, error) {
+	if m.MoveBoardFunc != nil {
+		return m.MoveBoardFunc(ctx, boardID, targetProjectID, strict)
+	}
+	return nil, nil
+}
+
 func TestBoardHandler_CreateBoard(t *testing.T) {
 	projectID := uuid.New()
 	boardID := uuid.New()
@@ -496,6 +726,39 @@ func TestBoardHandler_UpdateBoard(t *testing.T) {
 			},
 			expectedStatus: http.StatusNotFound,
 		},
+		{
+			name:    "실패: 커스텀 필드 값 검증 실패 시 필드별 메시지 반환",
+			boardID: boardID.String(),
+			requestBody: dto.UpdateBoardRequest{
+				CustomFields: &map[string]interface{}{"stage": "typo", "priority": "high"},
+			},
+			mockService: func(m *MockBoardService) {
+				m.UpdateBoardFunc = func(ctx context.Context, id uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error) {
+					return nil, response.NewFieldValidationError("Invalid custom field values", map[string]string{
+						"stage":    "invalid field option value 'typo' for field type 'stage'",
+						"priority": "unknown field 'priority'",
+					})
+				}
+			},
+			expectedStatus: http.StatusBadRequest,
+			checkResponse: func(t *testing.T, w *httptest.ResponseRecorder) {
+				var body struct {
+					Error struct {
+						Code   string            `json:"code"`
+						Fields map[string]string `json:"fields"`
+					} `json:"error"`
+				}
+				if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+					t.Fatalf("Failed to unmarshal response: %v", err)
+				}
+				if body.Error.Code != response.ErrCodeValidation {
+					t.Errorf("error code = %v, want %v", body.Error.Code, response.ErrCodeValidation)
+				}
+				if len(body.Error.Fields) != 2 || body.Error.Fields["stage"] == "" || body.Error.Fields["priority"] == "" {
+					t.Errorf("fields = %v, want messages for stage and priority", body.Error.Fields)
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -597,3 +860,259 @@ func TestBoardHandler_DeleteBoard(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardHandler_UpdateBoard_StrictDecoding(t *testing.T) {
+	boardID := uuid.New()
+	// "assigneeIds" is a typo for "assigneeId"
+	body := `{"title":"Updated","assigneeIds":"` + uuid.New().String() + `"}`
+
+	tests := []struct {
+		name           string
+		strict         bool
+		expectedStatus int
+		wantCalled     bool
+	}{
+		{
+			name:           "실패: strict 모드에서 알 수 없는 필드 거부",
+			strict:         true,
+			expectedStatus: http.StatusBadRequest,
+			wantCalled:     false,
+		},
+		{
+			name:           "성공: lenient 모드에서 알 수 없는 필드 무시",
+			strict:         false,
+			expectedStatus: http.StatusOK,
+			wantCalled:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			called := false
+			mockService := &MockBoardService{
+				UpdateBoardFunc: func(ctx context.Context, id uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error) {
+					called = true
+					return &dto.BoardResponse{ID: id, ProjectID: uuid.New(), Title: *req.Title}, nil
+				},
+			}
+			handler := NewBoardHandler(mockService, WithStrictDecoding(tt.strict))
+
+			router := setupTestRouter()
+			router.PUT("/api/boards/:boardId", handler.UpdateBoard)
+
+			req := httptest.NewRequest(http.MethodPut, "/api/boards/"+boardID.String(), bytes.NewBufferString(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			// When
+			router.ServeHTTP(w, req)
+
+			// Then
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if called != tt.wantCalled {
+				t.Errorf("UpdateBoard called = %v, want %v", called, tt.wantCalled)
+			}
+			if tt.strict && !bytes.Contains(w.Body.Bytes(), []byte("assigneeIds")) {
+				t.Errorf("Expected error to name the unknown field, got %s", w.Body.String())
+			}
+		})
+	}
+}
+
+const testJWTSecret = "board-handler-test-secret"
+
+// setupAuthTestRouter returns a test router whose routes run behind the auth middleware, as they do in production
+func setupAuthTestRouter() *gin.Engine {
+	router := setupTestRouter()
+	router.Use(middleware.Auth(testJWTSecret))
+	return router
+}
+
+// newAuthRequest builds a request carrying a bearer token for userID that setupAuthTestRouter accepts
+func newAuthRequest(t *testing.T, method, target string, body io.Reader, userID uuid.UUID) *http.Request {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"user_id": userID.String(),
+	}).SignedString([]byte(testJWTSecret))
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+// serviceUserID returns the user the handler passed to the service, or uuid.Nil when there was none
+func serviceUserID(ctx context.Context) uuid.UUID {
+	userID, _ := ctx.Value("user_id").(uuid.UUID)
+	return userID
+}
+
+func TestBoardHandler_CloneBoard_PassesAuthenticatedUser(t *testing.T) {
+	// Given
+	userID := uuid.New()
+	boardID := uuid.New()
+	var gotUserID uuid.UUID
+	mockService := &MockBoardService{
+		CloneBoardFunc: func(ctx context.Context, id uuid.UUID, req *dto.CloneBoardRequest) (*dto.BoardResponse, error) {
+			gotUserID = serviceUserID(ctx)
+			return &dto.BoardResponse{ID: uuid.New(), ProjectID: uuid.New(), AuthorID: gotUserID}, nil
+		},
+	}
+	handler := NewBoardHandler(mockService)
+
+	router := setupAuthTestRouter()
+	router.POST("/api/boards/:boardId/clone", handler.CloneBoard)
+
+	req := newAuthRequest(t, http.MethodPost, "/api/boards/"+boardID.String()+"/clone", nil, userID)
+	w := httptest.NewRecorder()
+
+	// When
+	router.ServeHTTP(w, req)
+
+	// Then
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if gotUserID != userID {
+		t.Errorf("CloneBoard user = %v, want %v", gotUserID, userID)
+	}
+}
+
+func TestBoardHandler_BulkCreateBoards_PassesAuthenticatedUser(t *testing.T) {
+	// Given
+	userID := uuid.New()
+	var gotUserID uuid.UUID
+	mockService := &MockBoardService{
+		BulkCreateBoardsFunc: func(ctx context.Context, req *dto.BulkCreateBoardsRequest) (*dto.BulkCreateBoardsResponse, error) {
+			gotUserID = serviceUserID(ctx)
+			return &dto.BulkCreateBoardsResponse{}, nil
+		},
+	}
+	handler := NewBoardHandler(mockService)
+
+	router := setupAuthTestRouter()
+	router.POST("/api/boards/bulk-create", handler.BulkCreateBoards)
+
+	body, _ := json.Marshal(dto.BulkCreateBoardsRequest{ProjectID: uuid.New(), Titles: []string{"Design", "Build"}})
+	req := newAuthRequest(t, http.MethodPost, "/api/boards/bulk-create", bytes.NewBuffer(body), userID)
+	w := httptest.NewRecorder()
+
+	// When
+	router.ServeHTTP(w, req)
+
+	// Then
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if gotUserID != userID {
+		t.Errorf("BulkCreateBoards user = %v, want %v", gotUserID, userID)
+	}
+}
+
+func TestBoardHandler_ImportBoards_PassesAuthenticatedUser(t *testing.T) {
+	// Given
+	userID := uuid.New()
+	var gotUserID uuid.UUID
+	mockService := &MockBoardService{
+		ImportBoardsFunc: func(ctx context.Context, req *dto.ImportBoardsRequest) (*dto.ImportBoardsResponse, error) {
+			gotUserID = serviceUserID(ctx)
+			return &dto.ImportBoardsResponse{}, nil
+		},
+	}
+	handler := NewBoardHandler(mockService)
+
+	router := setupAuthTestRouter()
+	router.POST("/api/boards/import", handler.ImportBoards)
+
+	body, _ := json.Marshal(dto.ImportBoardsRequest{
+		ProjectID: uuid.New(),
+		Items:     []dto.ImportBoardItem{{ExternalID: "JIRA-1", Title: "Imported"}},
+	})
+	req := newAuthRequest(t, http.MethodPost, "/api/boards/import", bytes.NewBuffer(body), userID)
+	w := httptest.NewRecorder()
+
+	// When
+	router.ServeHTTP(w, req)
+
+	// Then
+	if w.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if gotUserID != userID {
+		t.Errorf("ImportBoards user = %v, want %v", gotUserID, userID)
+	}
+}
+
+func TestBoardHandler_Templates_PassAuthenticatedUser(t *testing.T) {
+	userID := uuid.New()
+	id := uuid.New()
+
+	tests := []struct {
+		name           string
+		route          string
+		target         string
+		handler        func(*BoardHandler) gin.HandlerFunc
+		mockService    func(m *MockBoardService, got *uuid.UUID)
+		expectedStatus int
+	}{
+		{
+			name:    "SaveBoardAsTemplate",
+			route:   "/api/boards/:boardId/template",
+			target:  "/api/boards/" + id.String() + "/template",
+			handler: func(h *BoardHandler) gin.HandlerFunc { return h.SaveBoardAsTemplate },
+			mockService: func(m *MockBoardService, got *uuid.UUID) {
+				m.SaveBoardAsTemplateFunc = func(ctx context.Context, boardID uuid.UUID) (*dto.BoardTemplateResponse, error) {
+					*got = serviceUserID(ctx)
+					return &dto.BoardTemplateResponse{ID: uuid.New()}, nil
+				}
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:    "CreateBoardFromTemplate",
+			route:   "/api/boards/templates/:templateId/boards",
+			target:  "/api/boards/templates/" + id.String() + "/boards",
+			handler: func(h *BoardHandler) gin.HandlerFunc { return h.CreateBoardFromTemplate },
+			mockService: func(m *MockBoardService, got *uuid.UUID) {
+				m.CreateBoardFromTemplateFunc = func(ctx context.Context, templateID uuid.UUID, overrides *dto.CreateBoardFromTemplateRequest) (*dto.BoardResponse, error) {
+					*got = serviceUserID(ctx)
+					return &dto.BoardResponse{ID: uuid.New(), ProjectID: uuid.New()}, nil
+				}
+			},
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var gotUserID uuid.UUID
+			mockService := &MockBoardService{}
+			tt.mockService(mockService, &gotUserID)
+			handler := NewBoardHandler(mockService)
+
+			router := setupAuthTestRouter()
+			router.POST(tt.route, tt.handler(handler))
+
+			req := newAuthRequest(t, http.MethodPost, tt.target, nil, userID)
+			w := httptest.NewRecorder()
+
+			// When
+			router.ServeHTTP(w, req)
+
+			// Then
+			if w.Code != tt.expectedStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedStatus, w.Code, w.Body.String())
+			}
+			if gotUserID != userID {
+				t.Errorf("%s user = %v, want %v", tt.name, gotUserID, userID)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ChecklistHandler struct {
+	checklistService service.ChecklistService
+}
+
+func NewChecklistHandler(checklistService service.ChecklistService) *ChecklistHandler {
+	return &ChecklistHandler{
+		checklistService: checklistService,
+	}
+}
+
+// AddChecklistItem godoc
+// @Summary      체크리스트 항목 추가
+// @Description  Board의 체크리스트 마지막에 항목을 추가합니다
+// @Tags         checklist
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.CreateChecklistItemRequest true "체크리스트 항목 추가 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.ChecklistItemResponse} "항목 추가 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /checklist/board/{boardId} [post]
+func (h *ChecklistHandler) AddChecklistItem(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var req dto.CreateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	item, err := h.checklistService.AddChecklistItem(c.Request.Context(), boardID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, item)
+}
+
+// ReorderChecklistItems godoc
+// @Summary      체크리스트 순서 변경
+// @Description  Board의 모든 체크리스트 항목 ID를 원하는 순서대로 전달하면 그 순서로 저장합니다
+// @Description  누락되거나 중복되거나 다른 Board의 항목 ID가 있으면 400 에러를 반환합니다
+// @Description  동시에 요청된 순서 변경은 하나씩 차례로 적용됩니다
+// @Tags         checklist
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.ReorderChecklistItemsRequest true "새 순서"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.ChecklistItemResponse} "순서 변경 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /checklist/board/{boardId}/order [put]
+func (h *ChecklistHandler) ReorderChecklistItems(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var req dto.ReorderChecklistItemsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	items, err := h.checklistService.ReorderChecklistItems(c.Request.Context(), boardID, req.ItemIDs)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, items)
+}
+
+// UpdateChecklistItem godoc
+// @Summary      체크리스트 항목 수정
+// @Description  항목의 내용 또는 완료 여부를 수정합니다. 생략한 필드는 변경되지 않습니다
+// @Tags         checklist
+// @Accept       json
+// @Produce      json
+// @Param        itemId path string true "Checklist Item ID (UUID)"
+// @Param        request body dto.UpdateChecklistItemRequest true "체크리스트 항목 수정 요청"
+// @Success      200 {object} response.SuccessResponse{data=dto.ChecklistItemResponse} "항목 수정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "항목을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /checklist/{itemId} [put]
+func (h *ChecklistHandler) UpdateChecklistItem(c *gin.Context) {
+	itemID, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid checklist item ID")
+		return
+	}
+
+	var req dto.UpdateChecklistItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	item, err := h.checklistService.UpdateChecklistItem(c.Request.Context(), itemID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, item)
+}
+
+// DeleteChecklistItem godoc
+// @Summary      체크리스트 항목 삭제
+// @Description  체크리스트 항목을 삭제합니다. 남은 항목의 순서는 유지됩니다
+// @Tags         checklist
+// @Produce      json
+// @Param        itemId path string true "Checklist Item ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "항목 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 항목 ID"
+// @Failure      404 {object} response.ErrorResponse "항목을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /checklist/{itemId} [delete]
+func (h *ChecklistHandler) DeleteChecklistItem(c *gin.Context) {
+	itemID, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid checklist item ID")
+		return
+	}
+
+	if err := h.checklistService.DeleteChecklistItem(c.Request.Context(), itemID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
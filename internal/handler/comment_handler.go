@@ -185,3 +185,88 @@ func (h *CommentHandler) DeleteComment(c *gin.Context) {
 
 	response.SendSuccess(c, http.StatusOK, nil)
 }
+
+// PinComment godoc
+// @Summary      Comment 고정
+// @Description  Comment를 Board 댓글 목록의 맨 위에 고정합니다
+// @Description  기본 설정에서는 Board당 하나만 고정되며, 새 Comment를 고정하면 기존 고정이 해제됩니다
+// @Tags         comments
+// @Produce      json
+// @Param        commentId path string true "Comment ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.CommentResponse} "Comment 고정 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Comment ID"
+// @Failure      404 {object} response.ErrorResponse "Comment를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /comments/{commentId}/pin [post]
+func (h *CommentHandler) PinComment(c *gin.Context) {
+	commentIDStr := c.Param("commentId")
+	commentID, err := uuid.Parse(commentIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid comment ID")
+		return
+	}
+
+	comment, err := h.commentService.PinComment(c.Request.Context(), commentID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, comment)
+}
+
+// UnpinComment godoc
+// @Summary      Comment 고정 해제
+// @Description  Comment의 고정을 해제합니다
+// @Tags         comments
+// @Produce      json
+// @Param        commentId path string true "Comment ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.CommentResponse} "Comment 고정 해제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Comment ID"
+// @Failure      404 {object} response.ErrorResponse "Comment를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /comments/{commentId}/pin [delete]
+func (h *CommentHandler) UnpinComment(c *gin.Context) {
+	commentIDStr := c.Param("commentId")
+	commentID, err := uuid.Parse(commentIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid comment ID")
+		return
+	}
+
+	comment, err := h.commentService.UnpinComment(c.Request.Context(), commentID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, comment)
+}
+
+// GetCommentHistory godoc
+// @Summary      Comment 수정 이력 조회
+// @Description  Comment가 수정되기 전의 내용을 오래된 순서로 조회합니다. 현재 내용은 포함되지 않습니다
+// @Tags         comments
+// @Produce      json
+// @Param        commentId path string true "Comment ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.CommentRevisionResponse} "수정 이력 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Comment ID"
+// @Failure      404 {object} response.ErrorResponse "Comment를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /comments/{commentId}/history [get]
+func (h *CommentHandler) GetCommentHistory(c *gin.Context) {
+	commentIDStr := c.Param("commentId")
+	commentID, err := uuid.Parse(commentIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid comment ID")
+		return
+	}
+
+	history, err := h.commentService.GetCommentHistory(c.Request.Context(), commentID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, history)
+}
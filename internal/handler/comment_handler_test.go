@@ -21,6 +21,31 @@ type MockCommentService struct {
 	GetCommentsFunc   func(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
 	UpdateCommentFunc func(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error)
 	DeleteCommentFunc func(ctx context.Context, commentID uuid.UUID) error
+	PinCommentFunc    func(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error)
+	UnpinCommentFunc  func(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error)
+
+	GetCommentHistoryFunc func(ctx context.Context, commentID uuid.UUID) ([]*dto.CommentRevisionResponse, error)
+}
+
+func (m *MockCommentService) GetCommentHistory(ctx context.Context, commentID uuid.UUID) ([]*dto.CommentRevisionResponse, error) {
+	if m.GetCommentHistoryFunc != nil {
+		return m.GetCommentHistoryFunc(ctx, commentID)
+	}
+	return nil, nil
+}
+
+func (m *MockCommentService) PinComment(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error) {
+	if m.PinCommentFunc != nil {
+		return m.PinCommentFunc(ctx, commentID)
+	}
+	return nil, nil
+}
+
+func (m *MockCommentService) UnpinComment(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error) {
+	if m.UnpinCommentFunc != nil {
+		return m.UnpinCommentFunc(ctx, commentID)
+	}
+	return nil, nil
 }
 
 func (m *MockCommentService) CreateComment(ctx context.Context, userID uuid.UUID, req *dto.CreateCommentRequest) (*dto.CommentResponse, error) {
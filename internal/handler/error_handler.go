@@ -27,6 +27,10 @@ func handleServiceError(c *gin.Context, err error) {
 	if errors.As(err, &appErr) {
 		fmt.Printf("[ERROR] AppError - Code: %s, Message: %s, Details: %s\n", appErr.Code, appErr.Message, appErr.Details)
 		statusCode := mapErrorCodeToHTTPStatus(appErr.Code)
+		if len(appErr.Fields) > 0 {
+			response.SendFieldErrors(c, statusCode, appErr.Code, appErr.Message, appErr.Fields)
+			return
+		}
 		response.SendError(c, statusCode, appErr.Code, appErr.Message)
 		return
 	}
@@ -49,6 +53,12 @@ func mapErrorCodeToHTTPStatus(code string) int {
 		return http.StatusUnauthorized
 	case response.ErrCodeForbidden:
 		return http.StatusForbidden
+	case response.ErrCodeQuotaExceeded:
+		return http.StatusConflict
+	case response.ErrCodeConflict:
+		return http.StatusConflict
+	case response.ErrCodeTooLarge:
+		return http.StatusRequestEntityTooLarge
 	case "ALREADY_MEMBER", "PENDING_REQUEST_EXISTS":
 		return http.StatusConflict
 	default:
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -28,12 +29,23 @@ func setupIntegrationTestDB(t *testing.T) *gorm.DB {
 
 	// Register callback to generate UUIDs for SQLite (since it doesn't support gen_random_uuid())
 	db.Callback().Create().Before("gorm:create").Register("generate_uuid", func(db *gorm.DB) {
-		if db.Statement.Schema != nil {
+		if db.Statement.Schema == nil {
+			return
+		}
+		// Batch inserts carry a slice of records; each one needs its own ID
+		records := []reflect.Value{db.Statement.ReflectValue}
+		if kind := db.Statement.ReflectValue.Kind(); kind == reflect.Slice || kind == reflect.Array {
+			records = records[:0]
+			for i := 0; i < db.Statement.ReflectValue.Len(); i++ {
+				records = append(records, reflect.Indirect(db.Statement.ReflectValue.Index(i)))
+			}
+		}
+		for _, record := range records {
 			for _, field := range db.Statement.Schema.PrimaryFields {
 				if field.DataType == "uuid" {
-					fieldValue := field.ReflectValueOf(db.Statement.Context, db.Statement.ReflectValue)
+					fieldValue := field.ReflectValueOf(db.Statement.Context, record)
 					if fieldValue.IsZero() {
-						field.Set(db.Statement.Context, db.Statement.ReflectValue, uuid.New())
+						field.Set(db.Statement.Context, record, uuid.New())
 					}
 				}
 			}
@@ -55,7 +67,8 @@ func setupIntegrationTestDB(t *testing.T) *gorm.DB {
 			start_date DATETIME,
 			due_date DATETIME,
 			is_default INTEGER DEFAULT 0,
-			is_public INTEGER DEFAULT 0
+			is_public INTEGER DEFAULT 0,
+			enforce_unique_titles INTEGER NOT NULL DEFAULT 0
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create projects table")
@@ -72,8 +85,16 @@ func setupIntegrationTestDB(t *testing.T) *gorm.DB {
 			title TEXT NOT NULL,
 			content TEXT,
 			custom_fields TEXT,
+			external_id TEXT,
 			start_date DATETIME,
-			due_date DATETIME
+			due_date DATETIME,
+			estimate_hours REAL,
+			actual_hours REAL,
+			version INTEGER NOT NULL DEFAULT 1,
+			title_unique INTEGER NOT NULL DEFAULT 0,
+			archived_at DATETIME,
+			archive_reason VARCHAR(100),
+			last_activity_at DATETIME
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create boards table")
@@ -86,6 +107,7 @@ func setupIntegrationTestDB(t *testing.T) *gorm.DB {
 			deleted_at DATETIME,
 			board_id TEXT NOT NULL,
 			user_id TEXT NOT NULL,
+			last_seen_at DATETIME,
 			UNIQUE(board_id, user_id)
 		)
 	`).Error
@@ -104,6 +126,42 @@ func setupIntegrationTestDB(t *testing.T) *gorm.DB {
 	`).Error
 	require.NoError(t, err, "Failed to create comments table")
 
+	err = db.Exec(`
+		CREATE TABLE labels (
+			id TEXT PRIMARY KEY,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME,
+			project_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			color TEXT NOT NULL
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create labels table")
+
+	err = db.Exec(`
+		CREATE TABLE checklist_items (
+			id TEXT PRIMARY KEY,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME,
+			board_id TEXT NOT NULL,
+			text TEXT NOT NULL,
+			done INTEGER NOT NULL DEFAULT 0,
+			position INTEGER NOT NULL DEFAULT 0
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create checklist_items table")
+
+	err = db.Exec(`
+		CREATE TABLE board_labels (
+			board_id TEXT NOT NULL,
+			label_id TEXT NOT NULL,
+			PRIMARY KEY (board_id, label_id)
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create board_labels table")
+
 	err = db.Exec(`
 		CREATE TABLE field_options (
 			id TEXT PRIMARY KEY,
@@ -135,11 +193,30 @@ func setupIntegrationTestDB(t *testing.T) *gorm.DB {
 			file_size INTEGER NOT NULL,
 			content_type TEXT NOT NULL,
 			uploaded_by TEXT NOT NULL,
-			expires_at DATETIME
+			expires_at DATETIME,
+			checksum_sha256 TEXT,
+			download_count INTEGER NOT NULL DEFAULT 0,
+			visibility TEXT NOT NULL DEFAULT 'BOARD',
+			project_id TEXT
 		)
 	`).Error
 	require.NoError(t, err, "Failed to create attachments table")
 
+	err = db.Exec(`
+		CREATE TABLE board_activities (
+			id TEXT PRIMARY KEY,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			deleted_at DATETIME,
+			board_id TEXT NOT NULL,
+			actor_id TEXT NOT NULL,
+			field TEXT NOT NULL,
+			old_value TEXT,
+			new_value TEXT
+		)
+	`).Error
+	require.NoError(t, err, "Failed to create board_activities table")
+
 	return db
 }
 
@@ -184,7 +261,8 @@ func setupIntegrationRouter(db *gorm.DB) *gin.Engine {
 	s3Client, _ := client.NewS3Client(cfg)
 	m := metrics.NewTestMetrics()
 
-	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, s3Client, fieldOptionConverter, m, logger)
+	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, s3Client, fieldOptionConverter, m, logger,
+		service.WithTransactor(repository.NewTransactor(db)))
 
 	commentService := service.NewCommentService(commentRepo, boardRepo, attachmentRepo, s3Client, logger)
 
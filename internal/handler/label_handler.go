@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type LabelHandler struct {
+	labelService service.LabelService
+}
+
+func NewLabelHandler(labelService service.LabelService) *LabelHandler {
+	return &LabelHandler{
+		labelService: labelService,
+	}
+}
+
+// CreateLabel godoc
+// @Summary      Label 생성
+// @Description  Project에 이름과 색상으로 구성된 Label을 생성합니다
+// @Description  같은 Project에 동일한 이름의 Label이 있으면 VALIDATION_ERROR를 반환합니다
+// @Tags         labels
+// @Accept       json
+// @Produce      json
+// @Param        request body dto.CreateLabelRequest true "Label 생성 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.LabelResponse} "Label 생성 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 중복된 이름"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /labels [post]
+func (h *LabelHandler) CreateLabel(c *gin.Context) {
+	var req dto.CreateLabelRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	label, err := h.labelService.CreateLabel(c.Request.Context(), &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, label)
+}
+
+// GetLabels godoc
+// @Summary      Project의 Label 목록 조회
+// @Description  Project의 모든 Label을 이름 순으로 조회합니다
+// @Tags         labels
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.LabelResponse} "Label 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      404 {object} response.ErrorResponse "Project를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /labels/project/{projectId} [get]
+func (h *LabelHandler) GetLabels(c *gin.Context) {
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	labels, err := h.labelService.GetLabels(c.Request.Context(), projectID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, labels)
+}
+
+// DeleteLabel godoc
+// @Summary      Label 삭제
+// @Description  Label을 삭제하고 모든 Board에서 제거합니다
+// @Tags         labels
+// @Produce      json
+// @Param        labelId path string true "Label ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "Label 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Label ID"
+// @Failure      404 {object} response.ErrorResponse "Label을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /labels/{labelId} [delete]
+func (h *LabelHandler) DeleteLabel(c *gin.Context) {
+	labelID, err := uuid.Parse(c.Param("labelId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid label ID")
+		return
+	}
+
+	if err := h.labelService.DeleteLabel(c.Request.Context(), labelID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
+
+// AddBoardLabels godoc
+// @Summary      Board에 Label 추가
+// @Description  Board의 Project에 속한 Label을 Board에 추가하고 Board의 전체 Label을 반환합니다
+// @Description  이미 추가된 Label은 무시합니다
+// @Tags         labels
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.BoardLabelsRequest true "추가할 Label ID 목록"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.LabelResponse} "Label 추가 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 다른 Project의 Label"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /labels/board/{boardId} [post]
+func (h *LabelHandler) AddBoardLabels(c *gin.Context) {
+	h.changeBoardLabels(c, h.labelService.AddBoardLabels)
+}
+
+// RemoveBoardLabels godoc
+// @Summary      Board에서 Label 제거
+// @Description  Board에서 Label을 제거하고 남은 Label을 반환합니다
+// @Description  Board에 없는 Label은 무시합니다
+// @Tags         labels
+// @Accept       json
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Param        request body dto.BoardLabelsRequest true "제거할 Label ID 목록"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.LabelResponse} "Label 제거 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /labels/board/{boardId} [delete]
+func (h *LabelHandler) RemoveBoardLabels(c *gin.Context) {
+	h.changeBoardLabels(c, h.labelService.RemoveBoardLabels)
+}
+
+// changeBoardLabels parses the board ID and label IDs and applies change
+func (h *LabelHandler) changeBoardLabels(c *gin.Context, change func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) ([]dto.LabelResponse, error)) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	var req dto.BoardLabelsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	labels, err := change(c.Request.Context(), boardID, req.LabelIDs)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, labels)
+}
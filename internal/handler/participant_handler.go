@@ -126,3 +126,67 @@ func (h *ParticipantHandler) RemoveParticipant(c *gin.Context) {
 
 	response.SendSuccess(c, http.StatusOK, nil)
 }
+
+// SyncParticipantsFromAssignees godoc
+// @Summary      담당자 기반 Participant 동기화
+// @Description  Board의 담당자가 참여자 목록에 없으면 참여자로 추가합니다
+// @Description  기존 참여자는 제거하지 않습니다 (복구 용도로 사용 가능)
+// @Tags         participants
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=dto.SyncParticipantsResponse} "동기화 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      404 {object} response.ErrorResponse "Board를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /participants/board/{boardId}/sync-assignees [post]
+func (h *ParticipantHandler) SyncParticipantsFromAssignees(c *gin.Context) {
+	boardIDStr := c.Param("boardId")
+	boardID, err := uuid.Parse(boardIDStr)
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	result, err := h.participantService.SyncParticipantsFromAssignees(c.Request.Context(), boardID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, result)
+}
+
+// MarkBoardSeen godoc
+// @Summary      Board 읽음 처리
+// @Description  요청한 사용자가 Board의 현재까지의 활동을 확인했음을 기록합니다
+// @Description  이후 새로운 활동이 생기기 전까지 Board 응답의 hasUnread는 false입니다
+// @Description  Board의 참여자만 읽음 상태를 기록할 수 있습니다
+// @Tags         participants
+// @Produce      json
+// @Param        boardId path string true "Board ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "읽음 처리 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Board ID"
+// @Failure      401 {object} response.ErrorResponse "인증되지 않은 사용자"
+// @Failure      404 {object} response.ErrorResponse "Board 또는 Participant를 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /participants/board/{boardId}/seen [post]
+func (h *ParticipantHandler) MarkBoardSeen(c *gin.Context) {
+	boardID, err := uuid.Parse(c.Param("boardId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid board ID")
+		return
+	}
+
+	userID := requestUserID(c)
+	if userID == uuid.Nil {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+
+	if err := h.participantService.MarkBoardSeen(c.Request.Context(), boardID, userID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
@@ -16,10 +16,26 @@ import (
 
 // MockParticipantService is a mock implementation of ParticipantService
 type MockParticipantService struct {
-	AddParticipantsFunc         func(ctx context.Context, req *dto.AddParticipantsRequest) (*dto.AddParticipantsResponse, error)
-	AddParticipantsInternalFunc func(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) (int, error)
-	GetParticipantsFunc         func(ctx context.Context, boardID uuid.UUID) ([]*dto.ParticipantResponse, error)
-	RemoveParticipantFunc       func(ctx context.Context, boardID, userID uuid.UUID) error
+	AddParticipantsFunc               func(ctx context.Context, req *dto.AddParticipantsRequest) (*dto.AddParticipantsResponse, error)
+	AddParticipantsInternalFunc       func(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) (int, error)
+	GetParticipantsFunc               func(ctx context.Context, boardID uuid.UUID) ([]*dto.ParticipantResponse, error)
+	RemoveParticipantFunc             func(ctx context.Context, boardID, userID uuid.UUID) error
+	SyncParticipantsFromAssigneesFunc func(ctx context.Context, boardID uuid.UUID) (*dto.SyncParticipantsResponse, error)
+	MarkBoardSeenFunc                 func(ctx context.Context, boardID, userID uuid.UUID) error
+}
+
+func (m *MockParticipantService) MarkBoardSeen(ctx context.Context, boardID, userID uuid.UUID) error {
+	if m.MarkBoardSeenFunc != nil {
+		return m.MarkBoardSeenFunc(ctx, boardID, userID)
+	}
+	return nil
+}
+
+func (m *MockParticipantService) SyncParticipantsFromAssignees(ctx context.Context, boardID uuid.UUID) (*dto.SyncParticipantsResponse, error) {
+	if m.SyncParticipantsFromAssigneesFunc != nil {
+		return m.SyncParticipantsFromAssigneesFunc(ctx, boardID)
+	}
+	return nil, nil
 }
 
 func (m *MockParticipantService) AddParticipants(ctx context.Context, req *dto.AddParticipantsRequest) (*dto.AddParticipantsResponse, error) {
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+
+	"project-board-api/internal/response"
+)
+
+// unknownFieldPrefix is the prefix encoding/json uses for DisallowUnknownFields errors
+const unknownFieldPrefix = "json: unknown field "
+
+// bindJSON binds the JSON request body into obj and runs binding validation
+// In strict mode unknown fields are rejected instead of silently ignored
+func bindJSON(c *gin.Context, obj interface{}, strict bool) error {
+	if !strict {
+		return c.ShouldBindJSON(obj)
+	}
+
+	if c.Request == nil || c.Request.Body == nil {
+		return fmt.Errorf("invalid request")
+	}
+
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(obj); err != nil {
+		return err
+	}
+
+	return binding.Validator.ValidateStruct(obj)
+}
+
+// sendBindError writes a validation error for a failed request body bind
+// Unknown fields are named explicitly so client typos are easy to spot
+func sendBindError(c *gin.Context, err error) {
+	if msg := err.Error(); strings.HasPrefix(msg, unknownFieldPrefix) {
+		field := strings.TrimPrefix(msg, unknownFieldPrefix)
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Unknown field in request body: "+field)
+		return
+	}
+	response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+}
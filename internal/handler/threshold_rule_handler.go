@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type ThresholdRuleHandler struct {
+	ruleService service.ThresholdRuleService
+}
+
+func NewThresholdRuleHandler(ruleService service.ThresholdRuleService) *ThresholdRuleHandler {
+	return &ThresholdRuleHandler{
+		ruleService: ruleService,
+	}
+}
+
+// CreateThresholdRule godoc
+// @Summary      Board 임계값 알림 규칙 등록
+// @Description  Board의 숫자 필드(estimateHours, actualHours)가 임계값을 넘으면 수신자에게 알림을 보내는 규칙을 등록합니다 (프로젝트 멤버만 가능)
+// @Description  Board 수정 시 평가되며, 조건을 새로 만족한 경우에만 알림을 한 번 보냅니다. 조건을 벗어났다가 다시 만족하면 다시 알립니다
+// @Description  알림은 board.threshold_alert Webhook 이벤트로 전달됩니다
+// @Tags         projects
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateThresholdRuleRequest true "임계값 알림 규칙"
+// @Success      201 {object} response.SuccessResponse{data=dto.ThresholdRuleResponse} "규칙 등록 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청 또는 멤버가 아닌 수신자"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/threshold-rules [post]
+func (h *ThresholdRuleHandler) CreateThresholdRule(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateThresholdRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	rule, err := h.ruleService.CreateRule(c.Request.Context(), projectID, userUUID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, rule)
+}
+
+// ListThresholdRules godoc
+// @Summary      Board 임계값 알림 규칙 목록 조회
+// @Description  프로젝트에 등록된 임계값 알림 규칙을 등록 순으로 조회합니다 (프로젝트 멤버만 가능)
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.ThresholdRuleResponse} "규칙 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/threshold-rules [get]
+func (h *ThresholdRuleHandler) ListThresholdRules(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	rules, err := h.ruleService.ListRules(c.Request.Context(), projectID, userUUID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, rules)
+}
+
+// DeleteThresholdRule godoc
+// @Summary      Board 임계값 알림 규칙 삭제
+// @Description  임계값 알림 규칙을 삭제합니다 (규칙을 만든 사용자 또는 OWNER, ADMIN만 가능)
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        ruleId    path string true "Rule ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "규칙 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "규칙을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/threshold-rules/{ruleId} [delete]
+func (h *ThresholdRuleHandler) DeleteThresholdRule(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	ruleID, err := uuid.Parse(c.Param("ruleId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid rule ID")
+		return
+	}
+
+	if err := h.ruleService.DeleteRule(c.Request.Context(), projectID, ruleID, userUUID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
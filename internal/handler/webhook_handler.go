@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+	"project-board-api/internal/service"
+)
+
+type WebhookHandler struct {
+	webhookService service.WebhookService
+}
+
+func NewWebhookHandler(webhookService service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// CreateWebhook godoc
+// @Summary      프로젝트 Webhook 등록
+// @Description  Board 생성/수정/삭제 이벤트를 전달받을 URL을 등록합니다 (OWNER 또는 ADMIN만 가능)
+// @Description  응답의 secret은 생성 시에만 반환되며, 전송되는 요청의 X-Webhook-Signature 헤더(HMAC-SHA256) 검증에 사용합니다
+// @Description  X-Webhook-Delivery 헤더는 재시도 시에도 동일하므로 중복 수신 여부 판단에 사용할 수 있습니다
+// @Description  전송은 최대 1회(at-most-once)로 보장되며, 전송 대기열이 가득 차거나 서버가 재시작되면 이벤트가 유실될 수 있으므로 누락 없는 동기화가 필요하면 Board API로 주기적으로 대조해야 합니다
+// @Description  targetUrl은 공개 주소여야 하며, loopback, 사설망, link-local 주소(169.254.169.254 등)로 해석되는 URL은 거부됩니다
+// @Tags         projects
+// @Accept       json
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        request body dto.CreateWebhookRequest true "Webhook 등록 요청"
+// @Success      201 {object} response.SuccessResponse{data=dto.WebhookResponse} "Webhook 등록 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 요청"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid request body")
+		return
+	}
+
+	webhook, err := h.webhookService.CreateWebhook(c.Request.Context(), projectID, userUUID, &req)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusCreated, webhook)
+}
+
+// ListWebhooks godoc
+// @Summary      프로젝트 Webhook 목록 조회
+// @Description  프로젝트에 등록된 Webhook을 등록 순으로 조회합니다 (OWNER 또는 ADMIN만 가능, secret은 포함되지 않음)
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Success      200 {object} response.SuccessResponse{data=[]dto.WebhookResponse} "Webhook 목록 조회 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 Project ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+
+	webhooks, err := h.webhookService.ListWebhooks(c.Request.Context(), projectID, userUUID)
+	if err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, webhooks)
+}
+
+// DeleteWebhook godoc
+// @Summary      프로젝트 Webhook 삭제
+// @Description  등록된 Webhook을 삭제합니다 (OWNER 또는 ADMIN만 가능)
+// @Tags         projects
+// @Produce      json
+// @Param        projectId path string true "Project ID (UUID)"
+// @Param        webhookId path string true "Webhook ID (UUID)"
+// @Success      200 {object} response.SuccessResponse "Webhook 삭제 성공"
+// @Failure      400 {object} response.ErrorResponse "잘못된 ID"
+// @Failure      403 {object} response.ErrorResponse "권한 없음"
+// @Failure      404 {object} response.ErrorResponse "Webhook을 찾을 수 없음"
+// @Failure      500 {object} response.ErrorResponse "서버 에러"
+// @Router       /projects/{projectId}/webhooks/{webhookId} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "User ID not found in context")
+		return
+	}
+	userUUID, ok := userID.(uuid.UUID)
+	if !ok {
+		response.SendError(c, http.StatusUnauthorized, response.ErrCodeUnauthorized, "Invalid user ID format")
+		return
+	}
+
+	projectID, err := uuid.Parse(c.Param("projectId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid project ID")
+		return
+	}
+	webhookID, err := uuid.Parse(c.Param("webhookId"))
+	if err != nil {
+		response.SendError(c, http.StatusBadRequest, response.ErrCodeValidation, "Invalid webhook ID")
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(c.Request.Context(), projectID, webhookID, userUUID); err != nil {
+		handleServiceError(c, err)
+		return
+	}
+
+	response.SendSuccess(c, http.StatusOK, nil)
+}
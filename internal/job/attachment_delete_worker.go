@@ -0,0 +1,162 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+const (
+	// DefaultAttachmentDeleteMaxAttempts is how many times a deletion is tried before the job is marked dead
+	DefaultAttachmentDeleteMaxAttempts = 8
+	// attachmentDeleteBaseBackoff is the delay after the first failure; it doubles with every further failure
+	attachmentDeleteBaseBackoff = 30 * time.Second
+	attachmentDeleteMaxBackoff  = time.Hour
+	attachmentDeleteBatchSize   = 100
+)
+
+// AttachmentDeleteWorker drains the attachment deletion outbox
+// The S3 object is deleted first and the attachment row only once that succeeded,
+// so a failing S3 call never leaves an object without its row
+type AttachmentDeleteWorker struct {
+	jobRepo        repository.AttachmentDeleteJobRepository
+	attachmentRepo repository.AttachmentRepository
+	s3Client       client.S3ClientInterface
+	logger         *zap.Logger
+	interval       time.Duration
+	maxAttempts    int
+
+	ticker *time.Ticker
+	done   chan bool
+}
+
+// NewAttachmentDeleteWorker creates a new AttachmentDeleteWorker that polls every interval once started
+func NewAttachmentDeleteWorker(
+	jobRepo repository.AttachmentDeleteJobRepository,
+	attachmentRepo repository.AttachmentRepository,
+	s3Client client.S3ClientInterface,
+	logger *zap.Logger,
+	interval time.Duration,
+	maxAttempts int,
+) *AttachmentDeleteWorker {
+	if maxAttempts < 1 {
+		maxAttempts = DefaultAttachmentDeleteMaxAttempts
+	}
+	return &AttachmentDeleteWorker{
+		jobRepo:        jobRepo,
+		attachmentRepo: attachmentRepo,
+		s3Client:       s3Client,
+		logger:         logger,
+		interval:       interval,
+		maxAttempts:    maxAttempts,
+		done:           make(chan bool),
+	}
+}
+
+// RunOnce processes the jobs that are due now
+func (w *AttachmentDeleteWorker) RunOnce(ctx context.Context) error {
+	now := time.Now()
+	jobs, err := w.jobRepo.FindDue(ctx, now, attachmentDeleteBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		if err := w.process(ctx, job); err != nil {
+			w.fail(ctx, job, err, now)
+			continue
+		}
+		if err := w.jobRepo.Complete(ctx, job.ID); err != nil {
+			// The attachment is gone; a retry only repeats idempotent deletes
+			w.logger.Warn("Failed to complete attachment delete job",
+				zap.String("job_id", job.ID.String()),
+				zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (w *AttachmentDeleteWorker) process(ctx context.Context, job *domain.AttachmentDeleteJob) error {
+	if err := w.s3Client.DeleteFile(ctx, job.FileKey); err != nil {
+		return err
+	}
+	return w.attachmentRepo.Delete(ctx, job.AttachmentID)
+}
+
+// fail records a failed attempt and schedules the next one, or marks the job dead
+func (w *AttachmentDeleteWorker) fail(ctx context.Context, job *domain.AttachmentDeleteJob, cause error, now time.Time) {
+	job.Attempts++
+	job.LastError = cause.Error()
+
+	if job.Attempts >= w.maxAttempts {
+		job.Status = domain.AttachmentDeleteJobDead
+		w.logger.Error("Giving up on attachment deletion",
+			zap.String("job_id", job.ID.String()),
+			zap.String("attachment_id", job.AttachmentID.String()),
+			zap.String("file_key", job.FileKey),
+			zap.Int("attempts", job.Attempts),
+			zap.Error(cause))
+	} else {
+		job.NextAttemptAt = now.Add(attachmentDeleteBackoff(job.Attempts))
+		w.logger.Warn("Attachment deletion failed, will retry",
+			zap.String("job_id", job.ID.String()),
+			zap.String("attachment_id", job.AttachmentID.String()),
+			zap.Int("attempts", job.Attempts),
+			zap.Time("next_attempt_at", job.NextAttemptAt),
+			zap.Error(cause))
+	}
+
+	if err := w.jobRepo.Update(ctx, job); err != nil {
+		w.logger.Error("Failed to record attachment delete attempt",
+			zap.String("job_id", job.ID.String()),
+			zap.Error(err))
+	}
+}
+
+// attachmentDeleteBackoff returns the delay before the attempt following the given number of failures
+func attachmentDeleteBackoff(failures int) time.Duration {
+	delay := attachmentDeleteBaseBackoff
+	for i := 1; i < failures && delay < attachmentDeleteMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > attachmentDeleteMaxBackoff {
+		delay = attachmentDeleteMaxBackoff
+	}
+	return delay
+}
+
+// Start begins polling periodically
+func (w *AttachmentDeleteWorker) Start() {
+	w.ticker = time.NewTicker(w.interval)
+	go func() {
+		for {
+			select {
+			case <-w.ticker.C:
+				w.runWithTimeout()
+			case <-w.done:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops polling; pending jobs stay queued for the next start
+func (w *AttachmentDeleteWorker) Stop() {
+	w.ticker.Stop()
+	w.done <- true
+}
+
+func (w *AttachmentDeleteWorker) runWithTimeout() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.interval)
+	defer cancel()
+
+	if err := w.RunOnce(ctx); err != nil {
+		w.logger.Error("Failed to fetch due attachment delete jobs", zap.Error(err))
+	}
+}
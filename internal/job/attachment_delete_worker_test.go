@@ -0,0 +1,130 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+)
+
+// MockAttachmentDeleteJobRepository is a mock implementation of AttachmentDeleteJobRepository
+type MockAttachmentDeleteJobRepository struct {
+	mock.Mock
+}
+
+func (m *MockAttachmentDeleteJobRepository) Enqueue(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error {
+	args := m.Called(ctx, jobs)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentDeleteJobRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.AttachmentDeleteJob, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.AttachmentDeleteJob), args.Error(1)
+}
+
+func (m *MockAttachmentDeleteJobRepository) Update(ctx context.Context, job *domain.AttachmentDeleteJob) error {
+	args := m.Called(ctx, job)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentDeleteJobRepository) Complete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentDeleteJobRepository) ListFailedAttachmentJobs(ctx context.Context) ([]*domain.AttachmentDeleteJob, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.AttachmentDeleteJob), args.Error(1)
+}
+
+func newDeleteJob(attempts int) *domain.AttachmentDeleteJob {
+	return &domain.AttachmentDeleteJob{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		AttachmentID: uuid.New(),
+		FileKey:      "board/files/" + uuid.NewString() + ".pdf",
+		Status:       domain.AttachmentDeleteJobPending,
+		Attempts:     attempts,
+	}
+}
+
+func TestAttachmentDeleteWorker_DeletesObjectThenRow(t *testing.T) {
+	job := newDeleteJob(0)
+
+	jobRepo := new(MockAttachmentDeleteJobRepository)
+	attachmentRepo := new(MockAttachmentRepository)
+	s3 := new(MockS3Client)
+
+	jobRepo.On("FindDue", mock.Anything, mock.Anything, attachmentDeleteBatchSize).Return([]*domain.AttachmentDeleteJob{job}, nil)
+	s3.On("DeleteFile", mock.Anything, job.FileKey).Return(nil).Once()
+	attachmentRepo.On("Delete", mock.Anything, job.AttachmentID).Return(nil).Once()
+	jobRepo.On("Complete", mock.Anything, job.ID).Return(nil).Once()
+
+	worker := NewAttachmentDeleteWorker(jobRepo, attachmentRepo, s3, zap.NewNop(), time.Minute, 3)
+	assert.NoError(t, worker.RunOnce(context.Background()))
+
+	jobRepo.AssertExpectations(t)
+	attachmentRepo.AssertExpectations(t)
+	s3.AssertExpectations(t)
+}
+
+func TestAttachmentDeleteWorker_S3FailureKeepsRowAndBacksOff(t *testing.T) {
+	job := newDeleteJob(1)
+
+	jobRepo := new(MockAttachmentDeleteJobRepository)
+	attachmentRepo := new(MockAttachmentRepository)
+	s3 := new(MockS3Client)
+
+	jobRepo.On("FindDue", mock.Anything, mock.Anything, attachmentDeleteBatchSize).Return([]*domain.AttachmentDeleteJob{job}, nil)
+	s3.On("DeleteFile", mock.Anything, job.FileKey).Return(errors.New("s3 unavailable")).Once()
+	jobRepo.On("Update", mock.Anything, job).Return(nil).Once()
+
+	before := time.Now()
+	worker := NewAttachmentDeleteWorker(jobRepo, attachmentRepo, s3, zap.NewNop(), time.Minute, 3)
+	assert.NoError(t, worker.RunOnce(context.Background()))
+
+	// The row is left alone and the second failure waits twice the base delay
+	attachmentRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	jobRepo.AssertNotCalled(t, "Complete", mock.Anything, mock.Anything)
+	assert.Equal(t, 2, job.Attempts)
+	assert.Equal(t, domain.AttachmentDeleteJobPending, job.Status)
+	assert.Equal(t, "s3 unavailable", job.LastError)
+	assert.True(t, job.NextAttemptAt.After(before.Add(2*attachmentDeleteBaseBackoff-time.Second)))
+}
+
+func TestAttachmentDeleteWorker_MarksJobDeadAfterMaxAttempts(t *testing.T) {
+	job := newDeleteJob(2)
+
+	jobRepo := new(MockAttachmentDeleteJobRepository)
+	attachmentRepo := new(MockAttachmentRepository)
+	s3 := new(MockS3Client)
+
+	jobRepo.On("FindDue", mock.Anything, mock.Anything, attachmentDeleteBatchSize).Return([]*domain.AttachmentDeleteJob{job}, nil)
+	s3.On("DeleteFile", mock.Anything, job.FileKey).Return(errors.New("access denied")).Once()
+	jobRepo.On("Update", mock.Anything, job).Return(nil).Once()
+
+	worker := NewAttachmentDeleteWorker(jobRepo, attachmentRepo, s3, zap.NewNop(), time.Minute, 3)
+	assert.NoError(t, worker.RunOnce(context.Background()))
+
+	assert.Equal(t, 3, job.Attempts)
+	assert.Equal(t, domain.AttachmentDeleteJobDead, job.Status)
+	jobRepo.AssertExpectations(t)
+}
+
+func TestAttachmentDeleteBackoff(t *testing.T) {
+	assert.Equal(t, attachmentDeleteBaseBackoff, attachmentDeleteBackoff(1))
+	assert.Equal(t, 4*attachmentDeleteBaseBackoff, attachmentDeleteBackoff(3))
+	assert.Equal(t, attachmentDeleteMaxBackoff, attachmentDeleteBackoff(20))
+}
@@ -13,6 +13,7 @@ import (
 	"go.uber.org/zap"
 
 	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
 )
 
 // MockAttachmentRepository is a mock implementation of AttachmentRepository
@@ -41,6 +42,14 @@ func (m *MockAttachmentRepository) FindByEntityID(ctx context.Context, entityTyp
 	return args.Get(0).([]*domain.Attachment), args.Error(1)
 }
 
+func (m *MockAttachmentRepository) FindByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error) {
+	args := m.Called(ctx, entityType, entityIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID][]*domain.Attachment), args.Error(1)
+}
+
 func (m *MockAttachmentRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
 	args := m.Called(ctx, ids)
 	if args.Get(0) == nil {
@@ -72,6 +81,32 @@ func (m *MockAttachmentRepository) DeleteBatch(ctx context.Context, attachmentID
 	return args.Error(0)
 }
 
+func (m *MockAttachmentRepository) UpdateChecksum(ctx context.Context, id uuid.UUID, checksum string) error {
+	args := m.Called(ctx, id, checksum)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) ListByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID, query repository.AttachmentListQuery) ([]*domain.Attachment, error) {
+	args := m.Called(ctx, entityType, entityID, query)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Attachment), args.Error(1)
+}
+
+func (m *MockAttachmentRepository) IncrementDownloadCounts(ctx context.Context, counts map[uuid.UUID]int64) error {
+	args := m.Called(ctx, counts)
+	return args.Error(0)
+}
+
+func (m *MockAttachmentRepository) FindCoverImages(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]*domain.Attachment, error) {
+	args := m.Called(ctx, entityType, entityIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]*domain.Attachment), args.Error(1)
+}
+
 // MockS3Client is a mock implementation of S3ClientInterface
 type MockS3Client struct {
 	mock.Mock
@@ -102,6 +137,27 @@ func (m *MockS3Client) GetFileURL(key string) string {
 	return args.String(0)
 }
 
+func (m *MockS3Client) ComputeSHA256(ctx context.Context, key string) (string, error) {
+	args := m.Called(ctx, key)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockS3Client) CopyFile(ctx context.Context, srcKey, dstKey string) error {
+	args := m.Called(ctx, srcKey, dstKey)
+	return args.Error(0)
+}
+
+func (m *MockS3Client) ObjectExists(ctx context.Context, key string) (bool, error) {
+	args := m.Called(ctx, key)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockS3Client) OpenFile(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	args := m.Called(ctx, key)
+	reader, _ := args.Get(0).(io.ReadCloser)
+	return reader, args.Get(1).(int64), args.Error(2)
+}
+
 func TestCleanupJob_Run_ExpiredFilesDeleted(t *testing.T) {
 	// Setup
 	mockRepo := new(MockAttachmentRepository)
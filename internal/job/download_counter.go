@@ -0,0 +1,106 @@
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/repository"
+)
+
+// DownloadCounter buffers attachment download counts in memory and flushes them periodically
+// Batching turns many increments of a popular attachment into one UPDATE per flush
+type DownloadCounter struct {
+	attachmentRepo repository.AttachmentRepository
+	logger         *zap.Logger
+	interval       time.Duration
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]int64
+
+	ticker *time.Ticker
+	done   chan bool
+}
+
+// NewDownloadCounter creates a new DownloadCounter that flushes every interval once started
+func NewDownloadCounter(attachmentRepo repository.AttachmentRepository, logger *zap.Logger, interval time.Duration) *DownloadCounter {
+	return &DownloadCounter{
+		attachmentRepo: attachmentRepo,
+		logger:         logger,
+		interval:       interval,
+		pending:        make(map[uuid.UUID]int64),
+		done:           make(chan bool),
+	}
+}
+
+// Record counts one download of the attachment
+func (d *DownloadCounter) Record(attachmentID uuid.UUID) {
+	d.mu.Lock()
+	d.pending[attachmentID]++
+	d.mu.Unlock()
+}
+
+// Pending returns the downloads of the attachment not yet flushed
+func (d *DownloadCounter) Pending(attachmentID uuid.UUID) int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.pending[attachmentID]
+}
+
+// Flush writes the buffered counts to the database
+// On failure the counts are put back so the next flush retries them
+func (d *DownloadCounter) Flush(ctx context.Context) error {
+	d.mu.Lock()
+	batch := d.pending
+	d.pending = make(map[uuid.UUID]int64)
+	d.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := d.attachmentRepo.IncrementDownloadCounts(ctx, batch); err != nil {
+		d.mu.Lock()
+		for id, count := range batch {
+			d.pending[id] += count
+		}
+		d.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// Start begins flushing periodically
+func (d *DownloadCounter) Start() {
+	d.ticker = time.NewTicker(d.interval)
+	go func() {
+		for {
+			select {
+			case <-d.ticker.C:
+				d.flushWithTimeout()
+			case <-d.done:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic flush and writes whatever is still buffered
+func (d *DownloadCounter) Stop() {
+	d.ticker.Stop()
+	d.done <- true
+	d.flushWithTimeout()
+}
+
+func (d *DownloadCounter) flushWithTimeout() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := d.Flush(ctx); err != nil {
+		d.logger.Error("Failed to flush attachment download counts", zap.Error(err))
+	}
+}
@@ -0,0 +1,41 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/zap"
+)
+
+func TestDownloadCounter_FlushBatchesAndRetriesOnFailure(t *testing.T) {
+	popular, other := uuid.New(), uuid.New()
+
+	mockRepo := new(MockAttachmentRepository)
+	counter := NewDownloadCounter(mockRepo, zap.NewNop(), time.Minute)
+
+	for i := 0; i < 3; i++ {
+		counter.Record(popular)
+	}
+	counter.Record(other)
+
+	// A failed flush keeps the counts for the next attempt
+	mockRepo.On("IncrementDownloadCounts", mock.Anything, map[uuid.UUID]int64{popular: 3, other: 1}).
+		Return(errors.New("database unavailable")).Once()
+	assert.Error(t, counter.Flush(context.Background()))
+	assert.Equal(t, int64(3), counter.Pending(popular))
+
+	counter.Record(popular)
+	mockRepo.On("IncrementDownloadCounts", mock.Anything, map[uuid.UUID]int64{popular: 4, other: 1}).
+		Return(nil).Once()
+	assert.NoError(t, counter.Flush(context.Background()))
+	assert.Equal(t, int64(0), counter.Pending(popular))
+
+	// Nothing buffered means no write
+	assert.NoError(t, counter.Flush(context.Background()))
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,215 @@
+package job
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// Headers sent with every webhook delivery
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"  // same on every retry, so receivers can drop duplicates
+	WebhookSignatureHeader = "X-Webhook-Signature" // "sha256=" followed by the hex HMAC of the body
+)
+
+const (
+	// DefaultWebhookMaxAttempts is how many times a delivery is tried before it is dropped
+	DefaultWebhookMaxAttempts = 5
+	// DefaultWebhookBaseBackoff is the delay after the first failed attempt; it doubles with every further failure
+	DefaultWebhookBaseBackoff = time.Second
+
+	webhookQueueSize      = 1000
+	webhookWorkers        = 4
+	webhookRequestTimeout = 10 * time.Second
+)
+
+// webhookPayload is the JSON body of a delivery
+type webhookPayload struct {
+	DeliveryID uuid.UUID           `json:"deliveryId"`
+	Event      domain.WebhookEvent `json:"event"`
+}
+
+// WebhookDispatcher delivers board events to the project's webhook subscribers in the background
+// Publish only queues the event, so a slow or dead endpoint never holds up the request that caused it
+//
+// Delivery is at-most-once: the queue lives in memory, so events are dropped when it is full and
+// events still queued or being retried are lost when the process stops. Subscribers that must not
+// miss a change should reconcile against the board API rather than rely on webhooks alone.
+type WebhookDispatcher struct {
+	subscriptions repository.WebhookSubscriptionRepository
+	httpClient    *http.Client
+	logger        *zap.Logger
+	maxAttempts   int
+	baseBackoff   time.Duration
+
+	queue chan domain.WebhookEvent
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// NewWebhookDispatcher creates a new WebhookDispatcher that delivers queued events once started
+func NewWebhookDispatcher(
+	subscriptions repository.WebhookSubscriptionRepository,
+	logger *zap.Logger,
+	maxAttempts int,
+	baseBackoff time.Duration,
+) *WebhookDispatcher {
+	if maxAttempts < 1 {
+		maxAttempts = DefaultWebhookMaxAttempts
+	}
+	return &WebhookDispatcher{
+		subscriptions: subscriptions,
+		httpClient:    &http.Client{Timeout: webhookRequestTimeout},
+		logger:        logger,
+		maxAttempts:   maxAttempts,
+		baseBackoff:   baseBackoff,
+		queue:         make(chan domain.WebhookEvent, webhookQueueSize),
+		done:          make(chan struct{}),
+	}
+}
+
+// Publish queues an event for delivery without waiting
+// When the queue is full the event is dropped and logged rather than blocking the caller
+func (d *WebhookDispatcher) Publish(event domain.WebhookEvent) {
+	select {
+	case d.queue <- event:
+	default:
+		d.logger.Warn("Webhook queue is full, dropping event",
+			zap.String("event_type", string(event.Type)),
+			zap.String("board_id", event.BoardID.String()))
+	}
+}
+
+// Start begins delivering queued events
+func (d *WebhookDispatcher) Start() {
+	for i := 0; i < webhookWorkers; i++ {
+		d.wg.Add(1)
+		go func() {
+			defer d.wg.Done()
+			for {
+				select {
+				case event := <-d.queue:
+					d.dispatch(event)
+				case <-d.done:
+					return
+				}
+			}
+		}()
+	}
+}
+
+// Stop stops delivering; retries in progress are abandoned and queued events are dropped
+func (d *WebhookDispatcher) Stop() {
+	close(d.done)
+	d.wg.Wait()
+}
+
+// dispatch delivers an event to every subscription of its project that wants it
+// Subscriptions are delivered to concurrently, so one slow or retrying endpoint does not delay the others
+func (d *WebhookDispatcher) dispatch(event domain.WebhookEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	subscriptions, err := d.subscriptions.FindByProjectID(ctx, event.ProjectID)
+	cancel()
+	if err != nil {
+		d.logger.Error("Failed to fetch webhook subscriptions",
+			zap.String("project_id", event.ProjectID.String()),
+			zap.Error(err))
+		return
+	}
+
+	var deliveries sync.WaitGroup
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(event.Type) {
+			continue
+		}
+		deliveries.Add(1)
+		go func(subscription *domain.WebhookSubscription) {
+			defer deliveries.Done()
+			d.deliver(subscription, event)
+		}(subscription)
+	}
+	deliveries.Wait()
+}
+
+// deliver posts the event to one subscription, retrying non-2xx responses with exponential backoff
+func (d *WebhookDispatcher) deliver(subscription *domain.WebhookSubscription, event domain.WebhookEvent) {
+	deliveryID := uuid.New()
+	body, err := json.Marshal(webhookPayload{DeliveryID: deliveryID, Event: event})
+	if err != nil {
+		d.logger.Error("Failed to encode webhook payload", zap.Error(err))
+		return
+	}
+
+	backoff := d.baseBackoff
+	for attempt := 1; ; attempt++ {
+		err := d.send(subscription, event.Type, deliveryID, body)
+		if err == nil {
+			return
+		}
+		if attempt >= d.maxAttempts {
+			d.logger.Error("Giving up on webhook delivery",
+				zap.String("subscription_id", subscription.ID.String()),
+				zap.String("delivery_id", deliveryID.String()),
+				zap.Int("attempts", attempt),
+				zap.Error(err))
+			return
+		}
+
+		d.logger.Warn("Webhook delivery failed, will retry",
+			zap.String("subscription_id", subscription.ID.String()),
+			zap.String("delivery_id", deliveryID.String()),
+			zap.Int("attempt", attempt),
+			zap.Duration("backoff", backoff),
+			zap.Error(err))
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-d.done:
+			return
+		}
+	}
+}
+
+func (d *WebhookDispatcher) send(subscription *domain.WebhookSubscription, eventType domain.WebhookEventType, deliveryID uuid.UUID, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, subscription.TargetURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, string(eventType))
+	req.Header.Set(WebhookDeliveryHeader, deliveryID.String())
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(subscription.Secret, body))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the signature header value for body, as receivers should recompute it
+func SignWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,249 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+)
+
+// MockWebhookSubscriptionRepository is a mock implementation of WebhookSubscriptionRepository
+type MockWebhookSubscriptionRepository struct {
+	mock.Mock
+}
+
+func (m *MockWebhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	args := m.Called(ctx, subscription)
+	return args.Error(0)
+}
+
+func (m *MockWebhookSubscriptionRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	args := m.Called(ctx, projectID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockWebhookSubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// webhookReceiver records deliveries and answers with the queued status codes, then 200
+type webhookReceiver struct {
+	mu        sync.Mutex
+	statuses  []int
+	requests  []*http.Request
+	bodies    [][]byte
+	delivered chan struct{}
+}
+
+func newWebhookReceiver(statuses ...int) *webhookReceiver {
+	return &webhookReceiver{statuses: statuses, delivered: make(chan struct{}, 10)}
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	r.mu.Unlock()
+
+	w.WriteHeader(status)
+	if status == http.StatusOK {
+		r.delivered <- struct{}{}
+	}
+}
+
+func (r *webhookReceiver) waitDelivered(t *testing.T) {
+	t.Helper()
+	select {
+	case <-r.delivered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+}
+
+func newSubscription(projectID uuid.UUID, url string, events ...domain.WebhookEventType) *domain.WebhookSubscription {
+	names := make([]string, len(events))
+	for i, event := range events {
+		names[i] = string(event)
+	}
+	return &domain.WebhookSubscription{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: projectID,
+		TargetURL: url,
+		Events:    names,
+		Secret:    "s3cret",
+	}
+}
+
+func TestWebhookDispatcher_DeliversSignedPayload(t *testing.T) {
+	receiver := newWebhookReceiver()
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	projectID := uuid.New()
+	repo := new(MockWebhookSubscriptionRepository)
+	repo.On("FindByProjectID", mock.Anything, projectID).
+		Return([]*domain.WebhookSubscription{newSubscription(projectID, server.URL, domain.WebhookEventBoardUpdated)}, nil)
+
+	dispatcher := NewWebhookDispatcher(repo, zap.NewNop(), 3, time.Millisecond)
+	dispatcher.Start()
+	defer dispatcher.Stop()
+
+	event := domain.WebhookEvent{
+		Type:          domain.WebhookEventBoardUpdated,
+		ProjectID:     projectID,
+		BoardID:       uuid.New(),
+		ChangedFields: []string{"title", "dueDate"},
+		ActorID:       uuid.New(),
+		OccurredAt:    time.Now().UTC(),
+	}
+	dispatcher.Publish(event)
+	receiver.waitDelivered(t)
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+	require.Len(t, receiver.requests, 1)
+	req, body := receiver.requests[0], receiver.bodies[0]
+
+	assert.Equal(t, "board.updated", req.Header.Get(WebhookEventHeader))
+	assert.Equal(t, SignWebhookPayload("s3cret", body), req.Header.Get(WebhookSignatureHeader))
+	assert.NotEqual(t, SignWebhookPayload("other", body), req.Header.Get(WebhookSignatureHeader))
+
+	var payload webhookPayload
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, req.Header.Get(WebhookDeliveryHeader), payload.DeliveryID.String())
+	assert.Equal(t, event.BoardID, payload.Event.BoardID)
+	assert.Equal(t, event.ActorID, payload.Event.ActorID)
+	assert.Equal(t, []string{"title", "dueDate"}, payload.Event.ChangedFields)
+}
+
+func TestWebhookDispatcher_RetriesWithSameDeliveryID(t *testing.T) {
+	receiver := newWebhookReceiver(http.StatusInternalServerError, http.StatusBadGateway)
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	projectID := uuid.New()
+	repo := new(MockWebhookSubscriptionRepository)
+	repo.On("FindByProjectID", mock.Anything, projectID).
+		Return([]*domain.WebhookSubscription{newSubscription(projectID, server.URL, domain.WebhookEventBoardUpdated)}, nil)
+
+	dispatcher := NewWebhookDispatcher(repo, zap.NewNop(), 3, time.Millisecond)
+	dispatcher.Start()
+	defer dispatcher.Stop()
+
+	dispatcher.Publish(domain.WebhookEvent{Type: domain.WebhookEventBoardUpdated, ProjectID: projectID, BoardID: uuid.New()})
+	receiver.waitDelivered(t)
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+	require.Len(t, receiver.requests, 3)
+	deliveryID := receiver.requests[0].Header.Get(WebhookDeliveryHeader)
+	assert.NotEmpty(t, deliveryID)
+	for _, req := range receiver.requests {
+		assert.Equal(t, deliveryID, req.Header.Get(WebhookDeliveryHeader))
+	}
+}
+
+func TestWebhookDispatcher_OnlySubscribedEvents(t *testing.T) {
+	receiver := newWebhookReceiver()
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	projectID := uuid.New()
+	repo := new(MockWebhookSubscriptionRepository)
+	repo.On("FindByProjectID", mock.Anything, projectID).
+		Return([]*domain.WebhookSubscription{newSubscription(projectID, server.URL, domain.WebhookEventBoardDeleted)}, nil)
+
+	dispatcher := NewWebhookDispatcher(repo, zap.NewNop(), 1, time.Millisecond)
+	dispatcher.Start()
+	defer dispatcher.Stop()
+
+	dispatcher.Publish(domain.WebhookEvent{Type: domain.WebhookEventBoardUpdated, ProjectID: projectID, BoardID: uuid.New()})
+	dispatcher.Publish(domain.WebhookEvent{Type: domain.WebhookEventBoardDeleted, ProjectID: projectID, BoardID: uuid.New()})
+	receiver.waitDelivered(t)
+
+	receiver.mu.Lock()
+	defer receiver.mu.Unlock()
+	require.Len(t, receiver.requests, 1)
+	assert.Equal(t, "board.deleted", receiver.requests[0].Header.Get(WebhookEventHeader))
+}
+
+func TestWebhookDispatcher_SlowSubscriberDoesNotDelayOthers(t *testing.T) {
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer slow.Close()
+
+	receiver := newWebhookReceiver()
+	fast := httptest.NewServer(receiver)
+	defer fast.Close()
+
+	projectID := uuid.New()
+	repo := new(MockWebhookSubscriptionRepository)
+	repo.On("FindByProjectID", mock.Anything, projectID).
+		Return([]*domain.WebhookSubscription{
+			newSubscription(projectID, slow.URL, domain.WebhookEventBoardUpdated),
+			newSubscription(projectID, fast.URL, domain.WebhookEventBoardUpdated),
+		}, nil)
+
+	dispatcher := NewWebhookDispatcher(repo, zap.NewNop(), 1, time.Millisecond)
+	dispatcher.Start()
+	defer dispatcher.Stop()
+	// Released before Stop, which waits for the stalled delivery
+	defer close(release)
+
+	// The fast endpoint is listed second but is reached while the first one is still stalled
+	dispatcher.Publish(domain.WebhookEvent{Type: domain.WebhookEventBoardUpdated, ProjectID: projectID, BoardID: uuid.New()})
+	receiver.waitDelivered(t)
+}
+
+func TestWebhookDispatcher_PublishDoesNotBlockWhenQueueIsFull(t *testing.T) {
+	// Not started, so nothing drains the queue
+	dispatcher := NewWebhookDispatcher(new(MockWebhookSubscriptionRepository), zap.NewNop(), 1, time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < webhookQueueSize+10; i++ {
+			dispatcher.Publish(domain.WebhookEvent{Type: domain.WebhookEventBoardUpdated})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Publish blocked on a full queue")
+	}
+	assert.Len(t, dispatcher.queue, webhookQueueSize)
+}
@@ -1,6 +1,8 @@
 // Package metrics provides Prometheus metrics for the application.
 package metrics
 
+import "time"
+
 // IncrementProjectCreated increments project creation counter
 func (m *Metrics) IncrementProjectCreated() {
 	m.safeExecute("IncrementProjectCreated", func() {
@@ -28,3 +30,36 @@ func (m *Metrics) SetBoardsTotal(count int64) {
 		m.BoardsTotal.Set(float64(count))
 	})
 }
+
+// SetBoardsByStatus replaces the board counts of every project, keyed by project ID and then stage value
+// Label sets missing from counts are dropped, so deleted projects and emptied stages disappear
+func (m *Metrics) SetBoardsByStatus(counts map[string]map[string]int64) {
+	m.safeExecute("SetBoardsByStatus", func() {
+		m.BoardsByStatus.Reset()
+		for projectID, statuses := range counts {
+			for status, count := range statuses {
+				m.BoardsByStatus.WithLabelValues(projectID, status).Set(float64(count))
+			}
+		}
+	})
+}
+
+// SetBoardsOverdue replaces the overdue board counts of every project, keyed by project ID
+func (m *Metrics) SetBoardsOverdue(counts map[string]int64) {
+	m.safeExecute("SetBoardsOverdue", func() {
+		m.BoardsOverdue.Reset()
+		for projectID, count := range counts {
+			m.BoardsOverdue.WithLabelValues(projectID).Set(float64(count))
+		}
+	})
+}
+
+// SetBoardCycleTimes replaces the average cycle time of every project, keyed by project ID
+func (m *Metrics) SetBoardCycleTimes(cycleTimes map[string]time.Duration) {
+	m.safeExecute("SetBoardCycleTimes", func() {
+		m.BoardCycleTime.Reset()
+		for projectID, cycleTime := range cycleTimes {
+			m.BoardCycleTime.WithLabelValues(projectID).Set(cycleTime.Seconds())
+		}
+	})
+}
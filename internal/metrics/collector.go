@@ -8,24 +8,46 @@ import (
 	"gorm.io/gorm"
 )
 
+// defaultCollectInterval is how often business metrics are collected unless configured otherwise
+const defaultCollectInterval = 60 * time.Second
+
 // BusinessMetricsCollector collects business metrics periodically
 type BusinessMetricsCollector struct {
-	db      *gorm.DB
-	metrics *Metrics
-	logger  *zap.Logger
-	ticker  *time.Ticker
-	done    chan bool
+	db       *gorm.DB
+	metrics  *Metrics
+	logger   *zap.Logger
+	interval time.Duration
+	ticker   *time.Ticker
+	done     chan bool
+}
+
+// CollectorOption configures optional behavior of the business metrics collector
+type CollectorOption func(*BusinessMetricsCollector)
+
+// WithCollectInterval sets how often metrics are collected
+// Non-positive intervals keep the default of 60 seconds
+func WithCollectInterval(interval time.Duration) CollectorOption {
+	return func(c *BusinessMetricsCollector) {
+		if interval > 0 {
+			c.interval = interval
+		}
+	}
 }
 
 // NewBusinessMetricsCollector creates a new collector
-func NewBusinessMetricsCollector(db *gorm.DB, metrics *Metrics, logger *zap.Logger) *BusinessMetricsCollector {
-	return &BusinessMetricsCollector{
-		db:      db,
-		metrics: metrics,
-		logger:  logger,
-		ticker:  time.NewTicker(60 * time.Second),
-		done:    make(chan bool),
+func NewBusinessMetricsCollector(db *gorm.DB, metrics *Metrics, logger *zap.Logger, opts ...CollectorOption) *BusinessMetricsCollector {
+	c := &BusinessMetricsCollector{
+		db:       db,
+		metrics:  metrics,
+		logger:   logger,
+		interval: defaultCollectInterval,
+		done:     make(chan bool),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.ticker = time.NewTicker(c.interval)
+	return c
 }
 
 // Start begins collecting metrics
@@ -80,4 +102,131 @@ func (c *BusinessMetricsCollector) collect() {
 	} else {
 		c.metrics.SetBoardsTotal(boardCount)
 	}
+
+	c.collectBoardsByStatus(ctx)
+	c.collectBoardsOverdue(ctx)
+	c.collectBoardCycleTimes(ctx)
+}
+
+// Status labels for boards whose stage cannot be reported as an option value
+const (
+	statusUnset   = "unset"   // the board has no stage
+	statusUnknown = "unknown" // the stage option no longer exists
+)
+
+// collectBoardsByStatus counts active boards per project and stage value
+// Boards store stage option IDs, so the counts are grouped by ID and the IDs resolved in one lookup
+func (c *BusinessMetricsCollector) collectBoardsByStatus(ctx context.Context) {
+	var rows []struct {
+		ProjectID string
+		OptionID  string
+		Count     int64
+	}
+	if err := c.db.WithContext(ctx).
+		Table("boards").
+		Select("project_id, COALESCE(custom_fields->>'stage', '') AS option_id, COUNT(*) AS count").
+		Where("deleted_at IS NULL AND archived_at IS NULL").
+		Group("project_id, option_id").
+		Scan(&rows).Error; err != nil {
+		c.logger.Error("Failed to count boards by status", zap.Error(err))
+		return
+	}
+
+	optionIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.OptionID != "" {
+			optionIDs = append(optionIDs, row.OptionID)
+		}
+	}
+	values := make(map[string]string, len(optionIDs))
+	if len(optionIDs) > 0 {
+		var options []struct {
+			ID    string
+			Value string
+		}
+		if err := c.db.WithContext(ctx).
+			Table("field_options").
+			Select("id, value").
+			Where("id IN ?", optionIDs).
+			Scan(&options).Error; err != nil {
+			c.logger.Error("Failed to look up stage options", zap.Error(err))
+			return
+		}
+		for _, option := range options {
+			values[option.ID] = option.Value
+		}
+	}
+
+	counts := make(map[string]map[string]int64)
+	for _, row := range rows {
+		status := statusUnset
+		if row.OptionID != "" {
+			value, ok := values[row.OptionID]
+			if !ok {
+				value = statusUnknown
+			}
+			status = value
+		}
+		if counts[row.ProjectID] == nil {
+			counts[row.ProjectID] = make(map[string]int64)
+		}
+		// Options of different IDs can share a value, e.g. an archived option and its replacement
+		counts[row.ProjectID][status] += row.Count
+	}
+	c.metrics.SetBoardsByStatus(counts)
+}
+
+// collectBoardsOverdue counts active boards past their due date per project
+// The reference time is bound in UTC because due_date is stored as a UTC timestamp without time zone
+func (c *BusinessMetricsCollector) collectBoardsOverdue(ctx context.Context) {
+	var rows []struct {
+		ProjectID string
+		Count     int64
+	}
+	if err := c.db.WithContext(ctx).
+		Table("boards").
+		Select("project_id, COUNT(*) AS count").
+		Where("deleted_at IS NULL AND archived_at IS NULL AND due_date IS NOT NULL AND due_date < ?", time.Now().UTC()).
+		Group("project_id").
+		Scan(&rows).Error; err != nil {
+		c.logger.Error("Failed to count overdue boards", zap.Error(err))
+		return
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.ProjectID] = row.Count
+	}
+	c.metrics.SetBoardsOverdue(counts)
+}
+
+// collectBoardCycleTimes averages the time from creation to archiving of each project's archived boards
+func (c *BusinessMetricsCollector) collectBoardCycleTimes(ctx context.Context) {
+	var rows []struct {
+		ProjectID    string
+		CycleSeconds float64
+	}
+	if err := c.db.WithContext(ctx).
+		Table("boards").
+		Select("project_id, AVG(" + c.cycleSecondsExpr() + ") AS cycle_seconds").
+		Where("deleted_at IS NULL AND archived_at IS NOT NULL").
+		Group("project_id").
+		Scan(&rows).Error; err != nil {
+		c.logger.Error("Failed to compute board cycle times", zap.Error(err))
+		return
+	}
+
+	cycleTimes := make(map[string]time.Duration, len(rows))
+	for _, row := range rows {
+		cycleTimes[row.ProjectID] = time.Duration(row.CycleSeconds * float64(time.Second))
+	}
+	c.metrics.SetBoardCycleTimes(cycleTimes)
+}
+
+// cycleSecondsExpr is the SQL for the seconds between a board's creation and archiving
+func (c *BusinessMetricsCollector) cycleSecondsExpr() string {
+	if c.db.Dialector.Name() == "postgres" {
+		return "EXTRACT(EPOCH FROM (archived_at - created_at))"
+	}
+	return "(julianday(archived_at) - julianday(created_at)) * 86400"
 }
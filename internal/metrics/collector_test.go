@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -26,16 +27,31 @@ func (testProject) TableName() string {
 
 // testBoard is a simple board model for testing
 type testBoard struct {
-	ID        string `gorm:"type:text;primaryKey"`
-	Title     string `gorm:"type:varchar(255)"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           string `gorm:"type:text;primaryKey"`
+	ProjectID    string `gorm:"type:text"`
+	Title        string `gorm:"type:varchar(255)"`
+	CustomFields *string
+	DueDate      *time.Time
+	ArchivedAt   *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	DeletedAt    *time.Time
 }
 
 func (testBoard) TableName() string {
 	return "boards"
 }
 
+// testFieldOption is a simple field option model for testing
+type testFieldOption struct {
+	ID    string `gorm:"type:text;primaryKey"`
+	Value string `gorm:"type:varchar(100)"`
+}
+
+func (testFieldOption) TableName() string {
+	return "field_options"
+}
+
 // setupCollectorTestDB creates an in-memory SQLite database for testing
 func setupCollectorTestDB(t *testing.T) *gorm.DB {
 	// Use a file-based database instead of :memory: to avoid connection issues
@@ -45,7 +61,7 @@ func setupCollectorTestDB(t *testing.T) *gorm.DB {
 	require.NoError(t, err, "Failed to open test database")
 
 	// Auto-migrate test models
-	err = db.AutoMigrate(&testProject{}, &testBoard{})
+	err = db.AutoMigrate(&testProject{}, &testBoard{}, &testFieldOption{})
 	require.NoError(t, err, "Failed to migrate test models")
 
 	// Verify tables were created
@@ -72,6 +88,92 @@ func TestNewBusinessMetricsCollector(t *testing.T) {
 	assert.NotNil(t, collector.logger, "Logger should not be nil")
 	assert.NotNil(t, collector.ticker, "Ticker should not be nil")
 	assert.NotNil(t, collector.done, "Done channel should not be nil")
+	assert.Equal(t, defaultCollectInterval, collector.interval, "Interval should default to 60 seconds")
+}
+
+// TestNewBusinessMetricsCollector_WithCollectInterval tests the configurable collection frequency
+func TestNewBusinessMetricsCollector_WithCollectInterval(t *testing.T) {
+	t.Parallel()
+	db := setupCollectorTestDB(t)
+
+	collector := NewBusinessMetricsCollector(db, NewTestMetrics(), zap.NewNop(), WithCollectInterval(15*time.Second))
+	defer collector.ticker.Stop()
+	assert.Equal(t, 15*time.Second, collector.interval)
+
+	collector = NewBusinessMetricsCollector(db, NewTestMetrics(), zap.NewNop(), WithCollectInterval(0))
+	defer collector.ticker.Stop()
+	assert.Equal(t, defaultCollectInterval, collector.interval, "A zero interval should keep the default")
+}
+
+// TestBusinessMetricsCollector_CollectBoardGauges tests that the board gauges match the stored boards
+func TestBusinessMetricsCollector_CollectBoardGauges(t *testing.T) {
+	t.Parallel()
+	db := setupCollectorTestDB(t)
+	m := NewTestMetrics()
+
+	todoID, doneID, missingID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	// An archived option and its replacement may share a value
+	oldDoneID := uuid.New().String()
+	for _, option := range []testFieldOption{{ID: todoID, Value: "todo"}, {ID: doneID, Value: "done"}, {ID: oldDoneID, Value: "done"}} {
+		require.NoError(t, db.Create(&option).Error)
+	}
+	stage := func(optionID string) *string {
+		fields := `{"stage":"` + optionID + `"}`
+		return &fields
+	}
+	at := func(t time.Time) *time.Time { return &t }
+
+	projectA, projectB := uuid.New().String(), uuid.New().String()
+	now := time.Now().UTC()
+	created := now.Add(-10 * 24 * time.Hour)
+	boards := []testBoard{
+		// Project A: active boards in every status bucket, two of them overdue
+		{ProjectID: projectA, CustomFields: stage(todoID), DueDate: at(now.Add(-time.Hour))},
+		{ProjectID: projectA, CustomFields: stage(todoID), DueDate: at(now.Add(24 * time.Hour))},
+		{ProjectID: projectA, CustomFields: stage(doneID)},
+		{ProjectID: projectA, CustomFields: stage(oldDoneID), DueDate: at(now.Add(-48 * time.Hour))},
+		{ProjectID: projectA, CustomFields: stage(missingID)},
+		{ProjectID: projectA},
+		// Project A: archived after 1 and 3 days, so the average cycle time is 2 days
+		{ProjectID: projectA, CustomFields: stage(doneID), CreatedAt: created, ArchivedAt: at(created.Add(24 * time.Hour)), DueDate: at(now.Add(-time.Hour))},
+		{ProjectID: projectA, CreatedAt: created, ArchivedAt: at(created.Add(72 * time.Hour))},
+		// Deleted boards count nowhere
+		{ProjectID: projectA, CustomFields: stage(todoID), DueDate: at(now.Add(-time.Hour)), DeletedAt: at(now)},
+		{ProjectID: projectA, CreatedAt: created, ArchivedAt: at(created.Add(240 * time.Hour)), DeletedAt: at(now)},
+		// Project B: one active board, nothing overdue or archived
+		{ProjectID: projectB, CustomFields: stage(doneID)},
+	}
+	for _, b := range boards {
+		b.ID = uuid.New().String()
+		require.NoError(t, db.Create(&b).Error)
+	}
+
+	collector := NewBusinessMetricsCollector(db, m, zap.NewNop())
+	defer collector.ticker.Stop()
+	collector.collect()
+
+	wantStatus := map[[2]string]float64{
+		{projectA, "todo"}:        2,
+		{projectA, "done"}:        2,
+		{projectA, statusUnknown}: 1,
+		{projectA, statusUnset}:   1,
+		{projectB, "done"}:        1,
+	}
+	assert.Equal(t, len(wantStatus), testutil.CollectAndCount(m.BoardsByStatus), "Only existing label sets should be exported")
+	for labels, want := range wantStatus {
+		assert.Equal(t, want, getGaugeValue(t, m.BoardsByStatus.WithLabelValues(labels[0], labels[1])), "boards of %s in %s", labels[0], labels[1])
+	}
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.BoardsOverdue), "Projects without overdue boards should not be exported")
+	assert.Equal(t, float64(2), getGaugeValue(t, m.BoardsOverdue.WithLabelValues(projectA)))
+
+	assert.Equal(t, 1, testutil.CollectAndCount(m.BoardCycleTime), "Projects without archived boards should not be exported")
+	assert.InDelta(t, (48 * time.Hour).Seconds(), getGaugeValue(t, m.BoardCycleTime.WithLabelValues(projectA)), 1)
+
+	// Archiving the last board of project B removes its status series on the next collection
+	require.NoError(t, db.Model(&testBoard{}).Where("project_id = ?", projectB).Update("archived_at", now).Error)
+	collector.collect()
+	assert.Equal(t, len(wantStatus)-1, testutil.CollectAndCount(m.BoardsByStatus))
 }
 
 // TestBusinessMetricsCollector_Collect tests the collect method
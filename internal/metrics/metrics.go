@@ -36,6 +36,12 @@ type Metrics struct {
 	BoardsTotal         prometheus.Gauge
 	ProjectCreatedTotal prometheus.Counter
 	BoardCreatedTotal   prometheus.Counter
+	// BoardsByStatus counts a project's active boards per stage value
+	BoardsByStatus *prometheus.GaugeVec
+	// BoardsOverdue counts a project's active boards past their due date
+	BoardsOverdue *prometheus.GaugeVec
+	// BoardCycleTime is the average time from creation to archiving of a project's boards
+	BoardCycleTime *prometheus.GaugeVec
 
 	// Logger for error reporting
 	logger *zap.Logger
@@ -206,6 +212,30 @@ func NewWithRegistry(registerer prometheus.Registerer, logger *zap.Logger) *Metr
 				Help:      "Total number of board creation events",
 			},
 		),
+		BoardsByStatus: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "boards_by_status",
+				Help:      "Number of active boards per project and stage value",
+			},
+			[]string{"project_id", "status"},
+		),
+		BoardsOverdue: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "boards_overdue",
+				Help:      "Number of active boards past their due date per project",
+			},
+			[]string{"project_id"},
+		),
+		BoardCycleTime: factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "board_cycle_time_seconds",
+				Help:      "Average time in seconds from creation to archiving of a project's archived boards",
+			},
+			[]string{"project_id"},
+		),
 
 		logger: logger,
 	}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// AttachmentAnnotationRepository defines the interface for attachment annotation data access
+type AttachmentAnnotationRepository interface {
+	Create(ctx context.Context, annotation *domain.AttachmentAnnotation) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.AttachmentAnnotation, error)
+	FindByAttachmentID(ctx context.Context, attachmentID uuid.UUID) ([]*domain.AttachmentAnnotation, error)
+	CountByAttachmentIDs(ctx context.Context, attachmentIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// attachmentAnnotationRepositoryImpl is the GORM implementation of AttachmentAnnotationRepository
+type attachmentAnnotationRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAttachmentAnnotationRepository creates a new instance of AttachmentAnnotationRepository
+func NewAttachmentAnnotationRepository(db *gorm.DB) AttachmentAnnotationRepository {
+	return &attachmentAnnotationRepositoryImpl{db: db}
+}
+
+// Create creates a new annotation
+func (r *attachmentAnnotationRepositoryImpl) Create(ctx context.Context, annotation *domain.AttachmentAnnotation) error {
+	if err := r.db.WithContext(ctx).Create(annotation).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindByID finds an annotation by ID
+func (r *attachmentAnnotationRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.AttachmentAnnotation, error) {
+	var annotation domain.AttachmentAnnotation
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&annotation).Error; err != nil {
+		return nil, err
+	}
+	return &annotation, nil
+}
+
+// FindByAttachmentID finds all annotations of an attachment, ordered by creation time
+func (r *attachmentAnnotationRepositoryImpl) FindByAttachmentID(ctx context.Context, attachmentID uuid.UUID) ([]*domain.AttachmentAnnotation, error) {
+	var annotations []*domain.AttachmentAnnotation
+	if err := r.db.WithContext(ctx).
+		Where("attachment_id = ?", attachmentID).
+		Order("created_at ASC, id ASC").
+		Find(&annotations).Error; err != nil {
+		return nil, err
+	}
+	return annotations, nil
+}
+
+// CountByAttachmentIDs counts annotations for multiple attachments in a single grouped query
+// Attachments without annotations are absent from the returned map
+func (r *attachmentAnnotationRepositoryImpl) CountByAttachmentIDs(ctx context.Context, attachmentIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64)
+	if len(attachmentIDs) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		AttachmentID uuid.UUID
+		Count        int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&domain.AttachmentAnnotation{}).
+		Select("attachment_id, COUNT(*) AS count").
+		Where("attachment_id IN ?", attachmentIDs).
+		Group("attachment_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		counts[row.AttachmentID] = row.Count
+	}
+	return counts, nil
+}
+
+// Delete deletes an annotation by ID
+func (r *attachmentAnnotationRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&domain.AttachmentAnnotation{}, id).Error; err != nil {
+		return err
+	}
+	return nil
+}
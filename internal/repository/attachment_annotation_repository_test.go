@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestAttachmentAnnotationRepository_CountByAttachmentIDs(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	db.Exec(`CREATE TABLE attachment_annotations (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		attachment_id TEXT NOT NULL,
+		author_id TEXT NOT NULL,
+		x REAL NOT NULL,
+		y REAL NOT NULL,
+		content TEXT NOT NULL
+	)`)
+	repo := NewAttachmentAnnotationRepository(db)
+	ctx := context.Background()
+
+	annotated := uuid.New()
+	other := uuid.New()
+	empty := uuid.New()
+
+	for _, attachmentID := range []uuid.UUID{annotated, annotated, other} {
+		annotation := &domain.AttachmentAnnotation{
+			BaseModel:    domain.BaseModel{ID: uuid.New()},
+			AttachmentID: attachmentID,
+			AuthorID:     uuid.New(),
+			X:            0.5,
+			Y:            0.5,
+			Content:      "note",
+		}
+		if err := repo.Create(ctx, annotation); err != nil {
+			t.Fatalf("failed to create annotation: %v", err)
+		}
+	}
+
+	counts, err := repo.CountByAttachmentIDs(ctx, []uuid.UUID{annotated, other, empty})
+	if err != nil {
+		t.Fatalf("CountByAttachmentIDs failed: %v", err)
+	}
+
+	if counts[annotated] != 2 {
+		t.Errorf("expected 2 annotations, got %d", counts[annotated])
+	}
+	if counts[other] != 1 {
+		t.Errorf("expected 1 annotation, got %d", counts[other])
+	}
+	if counts[empty] != 0 {
+		t.Errorf("expected 0 annotations, got %d", counts[empty])
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// AttachmentDeleteJobRepository defines the interface for the attachment deletion outbox
+type AttachmentDeleteJobRepository interface {
+	Enqueue(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error
+	FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.AttachmentDeleteJob, error)
+	Update(ctx context.Context, job *domain.AttachmentDeleteJob) error
+	Complete(ctx context.Context, id uuid.UUID) error
+	ListFailedAttachmentJobs(ctx context.Context) ([]*domain.AttachmentDeleteJob, error)
+}
+
+// attachmentDeleteJobRepositoryImpl is the GORM implementation of AttachmentDeleteJobRepository
+type attachmentDeleteJobRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewAttachmentDeleteJobRepository creates a new instance of AttachmentDeleteJobRepository
+func NewAttachmentDeleteJobRepository(db *gorm.DB) AttachmentDeleteJobRepository {
+	return &attachmentDeleteJobRepositoryImpl{db: db}
+}
+
+// Enqueue stores pending deletion jobs, due immediately unless NextAttemptAt is set
+// It joins the transaction carried by ctx, if any
+func (r *attachmentDeleteJobRepositoryImpl) Enqueue(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error {
+	if len(jobs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	for _, job := range jobs {
+		if job.ID == uuid.Nil {
+			job.ID = uuid.New()
+		}
+		job.Status = domain.AttachmentDeleteJobPending
+		if job.NextAttemptAt.IsZero() {
+			job.NextAttemptAt = now
+		}
+	}
+
+	return dbFromContext(ctx, r.db).Create(&jobs).Error
+}
+
+// FindDue returns up to limit pending jobs whose next attempt is due at now, oldest first
+func (r *attachmentDeleteJobRepositoryImpl) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.AttachmentDeleteJob, error) {
+	var jobs []*domain.AttachmentDeleteJob
+	if err := r.db.WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", domain.AttachmentDeleteJobPending, now).
+		Order("next_attempt_at ASC, id ASC").
+		Limit(limit).
+		Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// Update saves a job's attempt bookkeeping
+func (r *attachmentDeleteJobRepositoryImpl) Update(ctx context.Context, job *domain.AttachmentDeleteJob) error {
+	return r.db.WithContext(ctx).Save(job).Error
+}
+
+// Complete removes a job whose attachment has been deleted
+func (r *attachmentDeleteJobRepositoryImpl) Complete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Delete(&domain.AttachmentDeleteJob{}, id).Error
+}
+
+// ListFailedAttachmentJobs returns the jobs that were given up on, most recently failed first
+func (r *attachmentDeleteJobRepositoryImpl) ListFailedAttachmentJobs(ctx context.Context) ([]*domain.AttachmentDeleteJob, error) {
+	var jobs []*domain.AttachmentDeleteJob
+	if err := r.db.WithContext(ctx).
+		Where("status = ?", domain.AttachmentDeleteJobDead).
+		Order("updated_at DESC, id DESC").
+		Find(&jobs).Error; err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
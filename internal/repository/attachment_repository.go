@@ -4,6 +4,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,11 +19,39 @@ type AttachmentRepository interface {
 	Create(ctx context.Context, attachment *domain.Attachment) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error)
 	FindByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error)
+	FindByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error)
 	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindExpiredTempAttachments(ctx context.Context) ([]*domain.Attachment, error)
 	ConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error
 	DeleteBatch(ctx context.Context, attachmentIDs []uuid.UUID) error
+	UpdateChecksum(ctx context.Context, id uuid.UUID, checksum string) error
+	ListByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID, query AttachmentListQuery) ([]*domain.Attachment, error)
+	IncrementDownloadCounts(ctx context.Context, counts map[uuid.UUID]int64) error
+	FindCoverImages(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]*domain.Attachment, error)
+}
+
+// Attachment list sort columns
+const (
+	AttachmentSortCreatedAt = "created_at"
+	AttachmentSortFileSize  = "file_size"
+)
+
+// AttachmentListQuery filters, sorts and pages the attachments of an entity
+type AttachmentListQuery struct {
+	// ContentType matches a full type ("image/png") or, when it ends with "/", a type family ("image/")
+	ContentType string
+	// IncludeTemp also returns attachments that were never confirmed
+	IncludeTemp bool
+	// SortBy is AttachmentSortCreatedAt (default) or AttachmentSortFileSize
+	SortBy    string
+	Ascending bool
+	// After is the last attachment of the previous page; rows are returned strictly after its sort key
+	After *domain.Attachment
+	Limit int
+	// HideRestricted omits restricted attachments, except those uploaded by Viewer
+	HideRestricted bool
+	Viewer         uuid.UUID
 }
 
 // attachmentRepositoryImpl is the GORM implementation of AttachmentRepository
@@ -35,8 +65,9 @@ func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
 }
 
 // Create creates a new attachment
+// It joins the transaction carried by ctx, if any
 func (r *attachmentRepositoryImpl) Create(ctx context.Context, attachment *domain.Attachment) error {
-	if err := r.db.WithContext(ctx).Create(attachment).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(attachment).Error; err != nil {
 		return err
 	}
 	return nil
@@ -56,13 +87,35 @@ func (r *attachmentRepositoryImpl) FindByEntityID(ctx context.Context, entityTyp
 	var attachments []*domain.Attachment
 	if err := r.db.WithContext(ctx).
 		Where("entity_type = ? AND entity_id = ?", entityType, entityID).
-		Order("created_at DESC").
+		Order("created_at DESC, id DESC").
 		Find(&attachments).Error; err != nil {
 		return nil, err
 	}
 	return attachments, nil
 }
 
+// FindByEntityIDs finds the attachments of several entities with one query, keyed by entity ID
+// Each entity's attachments keep the newest-first order of FindByEntityID
+func (r *attachmentRepositoryImpl) FindByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error) {
+	byEntity := make(map[uuid.UUID][]*domain.Attachment, len(entityIDs))
+	if len(entityIDs) == 0 {
+		return byEntity, nil
+	}
+
+	var attachments []*domain.Attachment
+	if err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id IN ?", entityType, entityIDs).
+		Order("created_at DESC, id DESC").
+		Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range attachments {
+		byEntity[*attachment.EntityID] = append(byEntity[*attachment.EntityID], attachment)
+	}
+	return byEntity, nil
+}
+
 // FindByIDs finds attachments by their IDs
 func (r *attachmentRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
 	if len(ids) == 0 {
@@ -97,13 +150,15 @@ func (r *attachmentRepositoryImpl) FindExpiredTempAttachments(ctx context.Contex
 	return attachments, nil
 }
 
+// ConfirmAttachments marks TEMP attachments as confirmed for entityID
+// It joins the transaction carried by ctx, if any
 func (r *attachmentRepositoryImpl) ConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error {
 	if len(attachmentIDs) == 0 {
 		return nil
 	}
 
 	// ✅ TEMP 상태만 업데이트, 결과 검증
-	result := r.db.WithContext(ctx).
+	result := dbFromContext(ctx, r.db).
 		Model(&domain.Attachment{}).
 		Where("id IN ? AND status = ?", attachmentIDs, domain.AttachmentStatusTemp). // ✅
 		Updates(map[string]interface{}{
@@ -142,3 +197,116 @@ func (r *attachmentRepositoryImpl) DeleteBatch(ctx context.Context, attachmentID
 	}
 	return nil
 }
+
+// UpdateChecksum stores the content hash of an attachment
+func (r *attachmentRepositoryImpl) UpdateChecksum(ctx context.Context, id uuid.UUID, checksum string) error {
+	if err := r.db.WithContext(ctx).
+		Model(&domain.Attachment{}).
+		Where("id = ?", id).
+		Update("checksum_sha256", checksum).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// IncrementDownloadCounts adds the given deltas to the attachments' download counts in one transaction
+// Rows are updated in ID order so concurrent flushes lock them in the same order
+func (r *attachmentRepositoryImpl) IncrementDownloadCounts(ctx context.Context, counts map[uuid.UUID]int64) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, id := range ids {
+			// UpdateColumn leaves updated_at alone; a download is not an edit
+			if err := tx.Model(&domain.Attachment{}).
+				Where("id = ?", id).
+				UpdateColumn("download_count", gorm.Expr("download_count + ?", counts[id])).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ListByEntityID returns one page of an entity's attachments using keyset pagination
+// id breaks ties between equal sort values so pages never overlap or skip rows
+func (r *attachmentRepositoryImpl) ListByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID, query AttachmentListQuery) ([]*domain.Attachment, error) {
+	db := r.db.WithContext(ctx).Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+
+	if !query.IncludeTemp {
+		db = db.Where("status = ?", domain.AttachmentStatusConfirmed)
+	}
+	if query.ContentType != "" {
+		if strings.HasSuffix(query.ContentType, "/") {
+			db = db.Where("content_type LIKE ?", query.ContentType+"%")
+		} else {
+			db = db.Where("content_type = ?", query.ContentType)
+		}
+	}
+	if query.HideRestricted {
+		db = db.Where("(visibility <> ? OR uploaded_by = ?)", domain.AttachmentVisibilityRestricted, query.Viewer)
+	}
+
+	column := AttachmentSortCreatedAt
+	if query.SortBy == AttachmentSortFileSize {
+		column = AttachmentSortFileSize
+	}
+	direction, cmp := "DESC", "<"
+	if query.Ascending {
+		direction, cmp = "ASC", ">"
+	}
+
+	if query.After != nil {
+		var afterValue interface{} = query.After.CreatedAt
+		if column == AttachmentSortFileSize {
+			afterValue = query.After.FileSize
+		}
+		db = db.Where(
+			fmt.Sprintf("(%[1]s %[2]s ?) OR (%[1]s = ? AND id %[2]s ?)", column, cmp),
+			afterValue, afterValue, query.After.ID,
+		)
+	}
+
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
+	}
+
+	var attachments []*domain.Attachment
+	if err := db.Order(fmt.Sprintf("%[1]s %[2]s, id %[2]s", column, direction)).Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// FindCoverImages returns the cover image of each entity in a single query
+// The cover is the oldest confirmed image that is not restricted; entities without one are absent from the map
+func (r *attachmentRepositoryImpl) FindCoverImages(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]*domain.Attachment, error) {
+	covers := make(map[uuid.UUID]*domain.Attachment, len(entityIDs))
+	if len(entityIDs) == 0 {
+		return covers, nil
+	}
+
+	var images []*domain.Attachment
+	if err := r.db.WithContext(ctx).
+		Where("entity_type = ? AND entity_id IN ?", entityType, entityIDs).
+		Where("status = ? AND content_type LIKE ?", domain.AttachmentStatusConfirmed, "image/%").
+		Where("visibility <> ?", domain.AttachmentVisibilityRestricted).
+		Order("created_at ASC, id ASC").
+		Find(&images).Error; err != nil {
+		return nil, err
+	}
+
+	for _, image := range images {
+		if _, ok := covers[*image.EntityID]; !ok {
+			covers[*image.EntityID] = image
+		}
+	}
+	return covers, nil
+}
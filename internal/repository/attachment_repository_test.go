@@ -34,7 +34,11 @@ func setupAttachmentTestDB(t *testing.T) *gorm.DB {
 		file_size INTEGER NOT NULL,
 		content_type TEXT NOT NULL,
 		uploaded_by TEXT NOT NULL,
-		expires_at DATETIME
+		expires_at DATETIME,
+		checksum_sha256 TEXT,
+		download_count INTEGER NOT NULL DEFAULT 0,
+		visibility TEXT NOT NULL DEFAULT 'BOARD',
+		project_id TEXT
 	)`)
 
 	return db
@@ -319,3 +323,241 @@ func TestAttachmentRepository_FindByID_NotFound(t *testing.T) {
 		t.Error("FindByID() expected error for non-existent ID, got nil")
 	}
 }
+
+func TestAttachmentRepository_UpdateChecksum(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	repo := NewAttachmentRepository(db)
+	ctx := context.Background()
+
+	attachment := &domain.Attachment{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		EntityType:  domain.EntityTypeBoard,
+		Status:      domain.AttachmentStatusConfirmed,
+		FileName:    "test.jpg",
+		FileURL:     "board/boards/ws/test.jpg",
+		FileSize:    1024,
+		ContentType: "image/jpeg",
+		UploadedBy:  uuid.New(),
+	}
+	db.Create(attachment)
+
+	checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	if err := repo.UpdateChecksum(ctx, attachment.ID, checksum); err != nil {
+		t.Fatalf("UpdateChecksum() error = %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, attachment.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.ChecksumSHA256 != checksum {
+		t.Errorf("ChecksumSHA256 = %v, want %v", found.ChecksumSHA256, checksum)
+	}
+}
+
+func TestAttachmentRepository_ListByEntityID(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	repo := NewAttachmentRepository(db)
+	ctx := context.Background()
+
+	boardID := uuid.New()
+	base := time.Now().Add(-time.Hour)
+	newAttachment := func(name, contentType string, size int64, status domain.AttachmentStatus, age time.Duration) *domain.Attachment {
+		attachment := &domain.Attachment{
+			BaseModel:   domain.BaseModel{ID: uuid.New(), CreatedAt: base.Add(-age)},
+			EntityType:  domain.EntityTypeBoard,
+			EntityID:    &boardID,
+			Status:      status,
+			FileName:    name,
+			FileURL:     "board/boards/ws/" + name,
+			FileSize:    size,
+			ContentType: contentType,
+			UploadedBy:  uuid.New(),
+		}
+		if err := db.Create(attachment).Error; err != nil {
+			t.Fatalf("failed to create attachment: %v", err)
+		}
+		return attachment
+	}
+
+	png := newAttachment("a.png", "image/png", 300, domain.AttachmentStatusConfirmed, 1*time.Minute)
+	jpg := newAttachment("b.jpg", "image/jpeg", 100, domain.AttachmentStatusConfirmed, 2*time.Minute)
+	pdf := newAttachment("c.pdf", "application/pdf", 200, domain.AttachmentStatusConfirmed, 3*time.Minute)
+	pending := newAttachment("d.png", "image/png", 50, domain.AttachmentStatusTemp, 0)
+
+	ids := func(attachments []*domain.Attachment) []uuid.UUID {
+		result := make([]uuid.UUID, len(attachments))
+		for i, attachment := range attachments {
+			result[i] = attachment.ID
+		}
+		return result
+	}
+
+	t.Run("content type family filter excludes pending by default", func(t *testing.T) {
+		got, err := repo.ListByEntityID(ctx, domain.EntityTypeBoard, boardID, AttachmentListQuery{ContentType: "image/"})
+		if err != nil {
+			t.Fatalf("ListByEntityID() error = %v", err)
+		}
+		assertIDs(t, ids(got), []uuid.UUID{png.ID, jpg.ID})
+	})
+
+	t.Run("pending attachments can be included", func(t *testing.T) {
+		got, err := repo.ListByEntityID(ctx, domain.EntityTypeBoard, boardID, AttachmentListQuery{ContentType: "image/png", IncludeTemp: true})
+		if err != nil {
+			t.Fatalf("ListByEntityID() error = %v", err)
+		}
+		assertIDs(t, ids(got), []uuid.UUID{pending.ID, png.ID})
+	})
+
+	t.Run("pages by size continue after the cursor", func(t *testing.T) {
+		query := AttachmentListQuery{SortBy: AttachmentSortFileSize, Ascending: true, Limit: 2}
+		first, err := repo.ListByEntityID(ctx, domain.EntityTypeBoard, boardID, query)
+		if err != nil {
+			t.Fatalf("ListByEntityID() error = %v", err)
+		}
+		assertIDs(t, ids(first), []uuid.UUID{jpg.ID, pdf.ID})
+
+		query.After = first[len(first)-1]
+		second, err := repo.ListByEntityID(ctx, domain.EntityTypeBoard, boardID, query)
+		if err != nil {
+			t.Fatalf("ListByEntityID() error = %v", err)
+		}
+		assertIDs(t, ids(second), []uuid.UUID{png.ID})
+	})
+
+	t.Run("restricted attachments are hidden except from their uploader", func(t *testing.T) {
+		if err := db.Model(pdf).Update("visibility", domain.AttachmentVisibilityRestricted).Error; err != nil {
+			t.Fatalf("failed to restrict attachment: %v", err)
+		}
+
+		got, err := repo.ListByEntityID(ctx, domain.EntityTypeBoard, boardID, AttachmentListQuery{HideRestricted: true, Viewer: uuid.New()})
+		if err != nil {
+			t.Fatalf("ListByEntityID() error = %v", err)
+		}
+		assertIDs(t, ids(got), []uuid.UUID{png.ID, jpg.ID})
+
+		got, err = repo.ListByEntityID(ctx, domain.EntityTypeBoard, boardID, AttachmentListQuery{HideRestricted: true, Viewer: pdf.UploadedBy})
+		if err != nil {
+			t.Fatalf("ListByEntityID() error = %v", err)
+		}
+		assertIDs(t, ids(got), []uuid.UUID{png.ID, jpg.ID, pdf.ID})
+	})
+}
+
+func assertIDs(t *testing.T, got, want []uuid.UUID) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d attachments %v, want %v", len(got), got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("attachment[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestAttachmentRepository_FindCoverImages(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	repo := NewAttachmentRepository(db)
+	ctx := context.Background()
+
+	base := time.Now().Add(-time.Hour)
+	newAttachment := func(boardID uuid.UUID, contentType string, status domain.AttachmentStatus, visibility domain.AttachmentVisibility, age time.Duration) *domain.Attachment {
+		attachment := &domain.Attachment{
+			BaseModel:   domain.BaseModel{ID: uuid.New(), CreatedAt: base.Add(-age)},
+			EntityType:  domain.EntityTypeBoard,
+			EntityID:    &boardID,
+			Status:      status,
+			FileName:    "file",
+			FileURL:     "board/boards/ws/" + uuid.NewString(),
+			FileSize:    100,
+			ContentType: contentType,
+			UploadedBy:  uuid.New(),
+			Visibility:  visibility,
+		}
+		if err := db.Create(attachment).Error; err != nil {
+			t.Fatalf("failed to create attachment: %v", err)
+		}
+		return attachment
+	}
+
+	withCover, pdfOnly, restrictedOnly, empty := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	oldest := newAttachment(withCover, "image/png", domain.AttachmentStatusConfirmed, domain.AttachmentVisibilityBoard, 3*time.Minute)
+	newAttachment(withCover, "image/jpeg", domain.AttachmentStatusConfirmed, domain.AttachmentVisibilityBoard, 2*time.Minute)
+	newAttachment(withCover, "image/png", domain.AttachmentStatusTemp, domain.AttachmentVisibilityBoard, 5*time.Minute)
+	newAttachment(pdfOnly, "application/pdf", domain.AttachmentStatusConfirmed, domain.AttachmentVisibilityBoard, time.Minute)
+	newAttachment(restrictedOnly, "image/png", domain.AttachmentStatusConfirmed, domain.AttachmentVisibilityRestricted, time.Minute)
+
+	queries := 0
+	if err := db.Callback().Query().After("gorm:query").Register("test:count_queries", func(*gorm.DB) { queries++ }); err != nil {
+		t.Fatalf("failed to register callback: %v", err)
+	}
+
+	covers, err := repo.FindCoverImages(ctx, domain.EntityTypeBoard, []uuid.UUID{withCover, pdfOnly, restrictedOnly, empty})
+	if err != nil {
+		t.Fatalf("FindCoverImages() error = %v", err)
+	}
+	// The whole page resolves in one query, however many boards it holds
+	if queries != 1 {
+		t.Errorf("FindCoverImages() ran %d queries, want 1", queries)
+	}
+	if len(covers) != 1 || covers[withCover] == nil || covers[withCover].ID != oldest.ID {
+		t.Errorf("covers = %+v, want only the oldest confirmed image of %s", covers, withCover)
+	}
+}
+
+func TestAttachmentRepository_FindByEntityIDs(t *testing.T) {
+	db := setupAttachmentTestDB(t)
+	repo := NewAttachmentRepository(db)
+	ctx := context.Background()
+
+	base := time.Now().Add(-time.Hour)
+	newAttachment := func(entityType domain.EntityType, entityID uuid.UUID, age time.Duration) *domain.Attachment {
+		attachment := &domain.Attachment{
+			BaseModel:   domain.BaseModel{ID: uuid.New(), CreatedAt: base.Add(-age)},
+			EntityType:  entityType,
+			EntityID:    &entityID,
+			Status:      domain.AttachmentStatusConfirmed,
+			FileName:    "file",
+			FileURL:     "board/boards/ws/" + uuid.NewString(),
+			FileSize:    100,
+			ContentType: "application/pdf",
+			UploadedBy:  uuid.New(),
+			Visibility:  domain.AttachmentVisibilityBoard,
+		}
+		if err := db.Create(attachment).Error; err != nil {
+			t.Fatalf("failed to create attachment: %v", err)
+		}
+		return attachment
+	}
+
+	first, second, empty := uuid.New(), uuid.New(), uuid.New()
+	older := newAttachment(domain.EntityTypeBoard, first, 2*time.Minute)
+	newer := newAttachment(domain.EntityTypeBoard, first, time.Minute)
+	only := newAttachment(domain.EntityTypeBoard, second, time.Minute)
+	newAttachment(domain.EntityTypeComment, second, time.Minute)
+
+	queries := 0
+	if err := db.Callback().Query().After("gorm:query").Register("test:count_queries", func(*gorm.DB) { queries++ }); err != nil {
+		t.Fatalf("failed to register callback: %v", err)
+	}
+
+	byBoard, err := repo.FindByEntityIDs(ctx, domain.EntityTypeBoard, []uuid.UUID{first, second, empty})
+	if err != nil {
+		t.Fatalf("FindByEntityIDs() error = %v", err)
+	}
+	// The whole page resolves in one query, however many boards it holds
+	if queries != 1 {
+		t.Errorf("FindByEntityIDs() ran %d queries, want 1", queries)
+	}
+	if got := byBoard[first]; len(got) != 2 || got[0].ID != newer.ID || got[1].ID != older.ID {
+		t.Errorf("attachments of %s = %+v, want newest first", first, got)
+	}
+	// Attachments of other entity types sharing the ID are not returned
+	if got := byBoard[second]; len(got) != 1 || got[0].ID != only.ID {
+		t.Errorf("attachments of %s = %+v, want only its board attachment", second, got)
+	}
+	if len(byBoard[empty]) != 0 {
+		t.Errorf("attachments of %s = %+v, want none", empty, byBoard[empty])
+	}
+}
@@ -2,21 +2,48 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"project-board-api/internal/domain"
 )
 
+// ErrVersionConflict is returned by Update when the board changed since it was loaded
+var ErrVersionConflict = errors.New("board was modified concurrently")
+
 // BoardRepository defines the interface for board data access
 type BoardRepository interface {
 	Create(ctx context.Context, board *domain.Board) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	FindProjectIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+	FindByExternalID(ctx context.Context, projectID uuid.UUID, externalID string) (*domain.Board, error)
+	FindByUniqueTitle(ctx context.Context, projectID uuid.UUID, title string) (*domain.Board, error)
 	FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
+	SearchByProjectID(ctx context.Context, projectID uuid.UUID, query BoardSearchQuery) ([]*domain.Board, int64, error)
+	ListByProjectID(ctx context.Context, projectID uuid.UUID, query BoardPageQuery) ([]*domain.Board, error)
 	Update(ctx context.Context, board *domain.Board) error
+	Touch(ctx context.Context, id uuid.UUID) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Archive(ctx context.Context, id uuid.UUID, archivedAt time.Time, reason *string) error
+	Restore(ctx context.Context, id uuid.UUID) error
+	CountActiveByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error)
+	CountByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error)
+	EstimateCountByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error)
+	SumEffortByProjectID(ctx context.Context, projectID uuid.UUID) (estimateHours, actualHours float64, err error)
+	CountByCustomFieldOption(ctx context.Context, projectID uuid.UUID, fieldKey string) (map[string]int64, error)
+	FindOrphanedAssignees(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error)
+	ClearOrphanedAssignees(ctx context.Context, projectID uuid.UUID, boardIDs []uuid.UUID) (int64, error)
+	AddActivities(ctx context.Context, activities []*domain.BoardActivity) error
+	FindActivitiesByBoardID(ctx context.Context, boardID uuid.UUID, offset, limit int) ([]*domain.BoardActivity, int64, error)
+	FindActivitiesSince(ctx context.Context, boardID uuid.UUID, since time.Time) ([]*domain.BoardActivity, error)
 }
 
 // boardRepositoryImpl is the GORM implementation of BoardRepository
@@ -30,20 +57,80 @@ func NewBoardRepository(db *gorm.DB) BoardRepository {
 }
 
 // Create creates a new board
+// It joins the transaction carried by ctx, if any
 func (r *boardRepositoryImpl) Create(ctx context.Context, board *domain.Board) error {
-	if err := r.db.WithContext(ctx).Create(board).Error; err != nil {
+	normalizeBoardDates(board)
+	if err := dbFromContext(ctx, r.db).Create(board).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
-// FindByID finds a board by ID with preloaded participants and comments
+// FindByID finds a board by ID with preloaded participants, comments and labels
+// Soft-deleted boards are treated as not found; archived boards are returned so they can be viewed and restored
+// It joins the transaction carried by ctx, if any, so it sees changes made earlier in the transaction
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *boardRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+	return r.findByID(dbFromContext(ctx, r.db).Where("deleted_at IS NULL"), id)
+}
+
+// FindByIDIncludingDeleted finds a board by ID even if it was soft-deleted, for admin and restore flows
+func (r *boardRepositoryImpl) FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+	return r.findByID(r.db.WithContext(ctx), id)
+}
+
+// FindProjectIDs returns the project of each active board among ids, keyed by board ID
+// Missing and deleted boards are left out; it joins the transaction carried by ctx, if any
+func (r *boardRepositoryImpl) FindProjectIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	projectIDs := make(map[uuid.UUID]uuid.UUID, len(ids))
+	if len(ids) == 0 {
+		return projectIDs, nil
+	}
+
+	var boards []*domain.Board
+	if err := dbFromContext(ctx, r.db).
+		Select("id", "project_id").
+		Where("id IN ? AND deleted_at IS NULL", ids).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	for _, board := range boards {
+		projectIDs[board.ID] = board.ProjectID
+	}
+	return projectIDs, nil
+}
+
+// FindByExternalID finds the active board of a project that was imported with externalID
+func (r *boardRepositoryImpl) FindByExternalID(ctx context.Context, projectID uuid.UUID, externalID string) (*domain.Board, error) {
 	var board domain.Board
 	if err := r.db.WithContext(ctx).
+		Where("project_id = ? AND external_id = ? AND deleted_at IS NULL", projectID, externalID).
+		First(&board).Error; err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// FindByUniqueTitle finds the active board of a project that holds title under the unique title constraint
+// It joins the transaction carried by ctx, if any
+func (r *boardRepositoryImpl) FindByUniqueTitle(ctx context.Context, projectID uuid.UUID, title string) (*domain.Board, error) {
+	var board domain.Board
+	if err := dbFromContext(ctx, r.db).
+		Where("project_id = ? AND title = ? AND title_unique AND deleted_at IS NULL", projectID, title).
+		First(&board).Error; err != nil {
+		return nil, err
+	}
+	return &board, nil
+}
+
+// findByID loads a board with participants, comments and labels from the given base query
+func (r *boardRepositoryImpl) findByID(query *gorm.DB, id uuid.UUID) (*domain.Board, error) {
+	var board domain.Board
+	if err := query.
 		Preload("Participants").
 		Preload("Comments").
+		Preload("Labels").
+		Preload("ChecklistItems", orderChecklistItems).
 		// Preload("Attachments"). // ✅ 제거
 		Where("id = ?", id).
 		First(&board).Error; err != nil {
@@ -56,48 +143,564 @@ func (r *boardRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*doma
 }
 
 // FindByProjectID finds all boards by project ID with optional filters
+// Archived boards are excluded unless filters is a BoardListFilter with IncludeArchived set
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *boardRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error) {
 	var boards []*domain.Board
 
 	// Start building the query with Participants preload
-	query := r.db.WithContext(ctx).
+	query := applyBoardFilters(r.db.WithContext(ctx), projectID, filters).
+		Select("boards.*, "+overdueExpr+" AS is_overdue", r.overdueReference()).
 		Preload("Participants").
-		// Preload("Attachments"). // ✅ 제거
+		Preload("Labels").
+		Preload("ChecklistItems", orderChecklistItems).
+		Order(boardListOrder)
+
+	// Execute the query
+	if err := query.Find(&boards).Error; err != nil {
+		return nil, err
+	}
+
+	return boards, nil
+}
+
+// overdueExpr computes Board.IsOverdue in SQL so list queries can return and sort by it
+// The reference time is bound as a UTC parameter because due_date is stored as a UTC timestamp
+// without time zone; comparing against NOW() would depend on the session time zone.
+const overdueExpr = "CASE WHEN due_date IS NOT NULL AND due_date < ? THEN TRUE ELSE FALSE END"
+
+// normalizeBoardDates stores start and due dates in UTC so SQL comparisons like overdueExpr stay correct
+// regardless of the offset a client sent them with
+func normalizeBoardDates(board *domain.Board) {
+	if board.StartDate != nil {
+		startDate := board.StartDate.UTC()
+		board.StartDate = &startDate
+	}
+	if board.DueDate != nil {
+		dueDate := board.DueDate.UTC()
+		board.DueDate = &dueDate
+	}
+}
+
+// overdueReference returns the current time used for the overdue flag
+func (r *boardRepositoryImpl) overdueReference() time.Time {
+	return r.db.NowFunc().UTC()
+}
+
+// boardListOrder orders boards newest first; id breaks ties between boards sharing a created_at
+const boardListOrder = "created_at DESC, id DESC"
+
+// BoardListFilter narrows list and count queries
+// A plain custom field map is accepted as well and filters active boards only
+type BoardListFilter struct {
+	CustomFields    map[string]interface{}
+	IncludeArchived bool
+	// LabelIDs matches boards carrying any of the labels
+	LabelIDs []uuid.UUID
+}
+
+// applyBoardFilters applies the project/custom field filter shared by list and count queries
+func applyBoardFilters(db *gorm.DB, projectID uuid.UUID, filters interface{}) *gorm.DB {
+	query := db.Model(&domain.Board{}).
 		Where("project_id = ?", projectID)
 
-	// Apply filters if provided
-	if filters != nil {
-		// Type assertion to get customFields map
-		if customFields, ok := filters.(map[string]interface{}); ok {
-			// Apply JSONB filtering for each custom field
-			for key, value := range customFields {
-				// Use JSONB operator ->> to extract text value and compare
-				query = query.Where("custom_fields->>? = ?", key, value)
-			}
+	var listFilter BoardListFilter
+	switch f := filters.(type) {
+	case map[string]interface{}:
+		listFilter.CustomFields = f
+	case BoardListFilter:
+		listFilter = f
+	}
+
+	if !listFilter.IncludeArchived {
+		query = query.Where("archived_at IS NULL")
+	}
+
+	// Apply JSONB filtering for each custom field
+	for key, value := range listFilter.CustomFields {
+		// Use JSONB operator ->> to extract text value and compare
+		query = query.Where("custom_fields->>? = ?", key, value)
+	}
+
+	if len(listFilter.LabelIDs) > 0 {
+		query = query.Where("EXISTS (SELECT 1 FROM board_labels WHERE board_labels.board_id = boards.id AND board_labels.label_id IN ?)", listFilter.LabelIDs)
+	}
+
+	return query
+}
+
+// BoardSearchQuery combines a text query with structured board filters
+type BoardSearchQuery struct {
+	// Text is matched case-insensitively against title and content
+	Text string
+	// CustomFields holds option IDs keyed by field type, as stored on boards
+	CustomFields map[string]interface{}
+	// IncludeArchived searches archived boards as well
+	IncludeArchived bool
+	// DueFrom and DueTo bound the due date (inclusive); boards without a due date never match a bound
+	DueFrom *time.Time
+	DueTo   *time.Time
+	Offset  int
+	Limit   int
+}
+
+// searchRankExpr ranks an exact title match above a partial title match above a content-only match
+const searchRankExpr = "CASE WHEN LOWER(title) = ? THEN 3 WHEN LOWER(title) LIKE ? ESCAPE '\\' THEN 2 ELSE 1 END"
+
+// SearchByProjectID returns one page of the project's active boards matching both the text and the filters,
+// most relevant first, together with the total number of matches
+func (r *boardRepositoryImpl) SearchByProjectID(ctx context.Context, projectID uuid.UUID, query BoardSearchQuery) ([]*domain.Board, int64, error) {
+	text := strings.ToLower(strings.TrimSpace(query.Text))
+	pattern := "%" + escapeLike(text) + "%"
+
+	db := applyBoardFilters(r.db.WithContext(ctx), projectID, BoardListFilter{CustomFields: query.CustomFields, IncludeArchived: query.IncludeArchived}).
+		Where("deleted_at IS NULL").
+		Where("(LOWER(title) LIKE ? ESCAPE '\\' OR LOWER(content) LIKE ? ESCAPE '\\')", pattern, pattern)
+	if query.DueFrom != nil {
+		db = db.Where("due_date >= ?", query.DueFrom.UTC())
+	}
+	if query.DueTo != nil {
+		db = db.Where("due_date <= ?", query.DueTo.UTC())
+	}
+
+	var total int64
+	if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var boards []*domain.Board
+	if err := db.
+		Select("boards.*, "+overdueExpr+" AS is_overdue", r.overdueReference()).
+		Preload("Participants").
+		Preload("Labels").
+		Preload("ChecklistItems", orderChecklistItems).
+		Order(clause.Expr{SQL: searchRankExpr + " DESC", Vars: []interface{}{text, pattern}}).
+		Order(boardListOrder).
+		Offset(query.Offset).
+		Limit(query.Limit).
+		Find(&boards).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return boards, total, nil
+}
+
+// Sort columns accepted by BoardPageQuery
+const (
+	BoardSortCreatedAt = "created_at"
+	BoardSortTitle     = "title"
+	BoardSortStartDate = "start_date"
+	BoardSortDueDate   = "due_date"
+)
+
+// BoardSortField is one key of a multi-column board sort
+type BoardSortField struct {
+	// Column is one of the BoardSort columns
+	Column string
+	Desc   bool
+}
+
+// BoardPageQuery filters, sorts and pages the boards of a project
+type BoardPageQuery struct {
+	BoardListFilter
+	AssigneeID *uuid.UUID
+	// ParticipantID matches boards the user participates in
+	ParticipantID *uuid.UUID
+	// DueFrom and DueTo bound the due date (inclusive); boards without a due date never match a bound
+	DueFrom *time.Time
+	DueTo   *time.Time
+	// SortBy is one of the BoardSort columns; BoardSortCreatedAt is the default
+	SortBy    string
+	Ascending bool
+	// Sort orders by several columns in turn and takes precedence over SortBy and Ascending
+	Sort []BoardSortField
+	// After is the last board of the previous page; rows are returned strictly after its sort key
+	After *domain.Board
+	Limit int
+}
+
+// ListByProjectID returns one page of the project's boards matching the query
+// Boards without a start or due date sort after all dated boards in either direction
+// The cursor row is compared on every sort key in turn, so pages stay stable under multi-column sorts
+func (r *boardRepositoryImpl) ListByProjectID(ctx context.Context, projectID uuid.UUID, query BoardPageQuery) ([]*domain.Board, error) {
+	db := applyBoardFilters(r.db.WithContext(ctx), projectID, query.BoardListFilter).
+		Where("deleted_at IS NULL")
+
+	if query.AssigneeID != nil {
+		db = db.Where("assignee_id = ?", *query.AssigneeID)
+	}
+	if query.ParticipantID != nil {
+		db = db.Where("EXISTS (SELECT 1 FROM participants WHERE participants.board_id = boards.id AND participants.user_id = ? AND participants.deleted_at IS NULL)", *query.ParticipantID)
+	}
+	if query.DueFrom != nil {
+		db = db.Where("due_date >= ?", query.DueFrom.UTC())
+	}
+	if query.DueTo != nil {
+		db = db.Where("due_date <= ?", query.DueTo.UTC())
+	}
+
+	sortFields := query.Sort
+	if len(sortFields) == 0 {
+		sortFields = []BoardSortField{{Column: query.SortBy, Desc: !query.Ascending}}
+	}
+
+	var order, afterConds, equalConds []string
+	var afterVars, equalVars []interface{}
+	for _, field := range sortFields {
+		column := BoardSortCreatedAt
+		switch field.Column {
+		case BoardSortTitle, BoardSortStartDate, BoardSortDueDate:
+			column = field.Column
+		}
+		nullable := column == BoardSortStartDate || column == BoardSortDueDate
+		direction, cmp := "ASC", ">"
+		if field.Desc {
+			direction, cmp = "DESC", "<"
+		}
+
+		if nullable {
+			order = append(order, column+" IS NULL")
 		}
+		order = append(order, column+" "+direction)
+
+		if query.After == nil {
+			continue
+		}
+		// A row sorts after the cursor if it ties on every earlier key and sorts after it on this one
+		afterValue := boardSortValue(query.After, column)
+		switch {
+		case afterValue == nil:
+			// Undated boards sort last, so nothing sorts after an undated cursor on this key
+			equalConds = append(equalConds, column+" IS NULL")
+			continue
+		case nullable:
+			afterConds = append(afterConds, "("+strings.Join(append(append([]string{}, equalConds...), fmt.Sprintf("(%[1]s %[2]s ? OR %[1]s IS NULL)", column, cmp)), " AND ")+")")
+		default:
+			afterConds = append(afterConds, "("+strings.Join(append(append([]string{}, equalConds...), fmt.Sprintf("%s %s ?", column, cmp)), " AND ")+")")
+		}
+		afterVars = append(afterVars, equalVars...)
+		afterVars = append(afterVars, afterValue)
+		equalConds = append(equalConds, column+" = ?")
+		equalVars = append(equalVars, afterValue)
 	}
 
-	// Execute the query
-	if err := query.Find(&boards).Error; err != nil {
-		return nil, err
+	// Ties on every key are broken by id in the direction of the last key
+	idDirection, idCmp := "ASC", ">"
+	if sortFields[len(sortFields)-1].Desc {
+		idDirection, idCmp = "DESC", "<"
+	}
+	order = append(order, "id "+idDirection)
+	if query.After != nil {
+		afterConds = append(afterConds, "("+strings.Join(append(equalConds, "id "+idCmp+" ?"), " AND ")+")")
+		afterVars = append(afterVars, equalVars...)
+		afterVars = append(afterVars, query.After.ID)
+		db = db.Where(strings.Join(afterConds, " OR "), afterVars...)
+	}
+
+	if query.Limit > 0 {
+		db = db.Limit(query.Limit)
 	}
 
+	var boards []*domain.Board
+	if err := db.
+		Select("boards.*, "+overdueExpr+" AS is_overdue", r.overdueReference()).
+		Preload("Participants").
+		Preload("Labels").
+		Preload("ChecklistItems", orderChecklistItems).
+		Order(strings.Join(order, ", ")).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
 	return boards, nil
 }
 
+// boardSortValue returns the sort key of board for column, or nil for an unset date
+func boardSortValue(board *domain.Board, column string) interface{} {
+	switch column {
+	case BoardSortTitle:
+		return board.Title
+	case BoardSortStartDate:
+		if board.StartDate == nil {
+			return nil
+		}
+		return board.StartDate.UTC()
+	case BoardSortDueDate:
+		if board.DueDate == nil {
+			return nil
+		}
+		return board.DueDate.UTC()
+	default:
+		return board.CreatedAt
+	}
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
+// CountByProjectID returns the exact number of boards matching the same filters as FindByProjectID
+func (r *boardRepositoryImpl) CountByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error) {
+	var count int64
+	if err := applyBoardFilters(r.db.WithContext(ctx), projectID, filters).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// EstimateCountByProjectID returns the planner's row estimate for the filtered board query
+// It relies on PostgreSQL table statistics and falls back to an exact count on other dialects
+func (r *boardRepositoryImpl) EstimateCountByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error) {
+	if r.db.Dialector.Name() != "postgres" {
+		return r.CountByProjectID(ctx, projectID, filters)
+	}
+
+	// Build the filtered statement without executing it
+	dryRun := r.db.WithContext(ctx).Session(&gorm.Session{DryRun: true})
+	stmt := applyBoardFilters(dryRun, projectID, filters).Select("id").Find(&[]domain.Board{}).Statement
+
+	var plan string
+	if err := r.db.WithContext(ctx).Raw("EXPLAIN (FORMAT JSON) "+stmt.SQL.String(), stmt.Vars...).Row().Scan(&plan); err != nil {
+		return 0, err
+	}
+
+	var explained []struct {
+		Plan struct {
+			PlanRows float64 `json:"Plan Rows"`
+		} `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(plan), &explained); err != nil || len(explained) == 0 {
+		return 0, fmt.Errorf("failed to parse query plan: %v", err)
+	}
+
+	return int64(explained[0].Plan.PlanRows), nil
+}
+
+// SumEffortByProjectID totals estimated and actual hours of a project's active boards in a single query
+func (r *boardRepositoryImpl) SumEffortByProjectID(ctx context.Context, projectID uuid.UUID) (float64, float64, error) {
+	var totals struct {
+		EstimateHours float64
+		ActualHours   float64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&domain.Board{}).
+		Select("COALESCE(SUM(estimate_hours), 0) AS estimate_hours, COALESCE(SUM(actual_hours), 0) AS actual_hours").
+		Where("project_id = ? AND deleted_at IS NULL AND archived_at IS NULL", projectID).
+		Scan(&totals).Error; err != nil {
+		return 0, 0, err
+	}
+	return totals.EstimateHours, totals.ActualHours, nil
+}
+
+// CountByCustomFieldOption counts a project's active boards per value of one custom field, in a single grouped query
+// Boards without the field are counted under the empty key
+func (r *boardRepositoryImpl) CountByCustomFieldOption(ctx context.Context, projectID uuid.UUID, fieldKey string) (map[string]int64, error) {
+	var rows []struct {
+		OptionID string
+		Count    int64
+	}
+	if err := r.db.WithContext(ctx).
+		Model(&domain.Board{}).
+		Select("COALESCE(custom_fields->>?, '') AS option_id, COUNT(*) AS count", fieldKey).
+		Where("project_id = ? AND deleted_at IS NULL AND archived_at IS NULL", projectID).
+		Group("option_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		counts[row.OptionID] = row.Count
+	}
+	return counts, nil
+}
+
 // Update updates a board
+// The UPDATE only matches the version the board was loaded with and increments it in the same statement,
+// so when two saves race, the later one writes nothing and gets ErrVersionConflict
+// It joins the transaction carried by ctx, if any
 func (r *boardRepositoryImpl) Update(ctx context.Context, board *domain.Board) error {
-	if err := r.db.WithContext(ctx).Save(board).Error; err != nil {
+	normalizeBoardDates(board)
+	loadedVersion := board.Version
+	board.Version = loadedVersion + 1
+
+	result := dbFromContext(ctx, r.db).
+		Model(board).
+		Where("version = ?", loadedVersion).
+		Select("*").
+		Updates(board)
+	if result.Error != nil {
+		board.Version = loadedVersion
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		board.Version = loadedVersion
+		return ErrVersionConflict
+	}
+	return nil
+}
+
+// AddActivities records the field changes of a board update and moves the boards' last_activity_at forward
+// It joins the transaction carried by ctx, if any
+func (r *boardRepositoryImpl) AddActivities(ctx context.Context, activities []*domain.BoardActivity) error {
+	if len(activities) == 0 {
+		return nil
+	}
+	db := dbFromContext(ctx, r.db)
+	if err := db.Create(activities).Error; err != nil {
 		return err
 	}
+
+	latest := make(map[uuid.UUID]time.Time, 1)
+	for _, activity := range activities {
+		if activity.CreatedAt.After(latest[activity.BoardID]) {
+			latest[activity.BoardID] = activity.CreatedAt
+		}
+	}
+	for boardID, at := range latest {
+		// UpdateColumn leaves updated_at and version alone; activity is not an edit
+		if err := db.Model(&domain.Board{}).
+			Where("id = ? AND (last_activity_at IS NULL OR last_activity_at < ?)", boardID, at).
+			UpdateColumn("last_activity_at", at).Error; err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-// Delete soft deletes a board
+// FindActivitiesByBoardID returns one page of a board's change history, newest first, with the total entry count
+func (r *boardRepositoryImpl) FindActivitiesByBoardID(ctx context.Context, boardID uuid.UUID, offset, limit int) ([]*domain.BoardActivity, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.BoardActivity{}).Where("board_id = ?", boardID)
+
+	var total int64
+	if err := query.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var activities []*domain.BoardActivity
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&activities).Error; err != nil {
+		return nil, 0, err
+	}
+	return activities, total, nil
+}
+
+// FindActivitiesSince returns every change made to a board after since, newest first
+func (r *boardRepositoryImpl) FindActivitiesSince(ctx context.Context, boardID uuid.UUID, since time.Time) ([]*domain.BoardActivity, error) {
+	var activities []*domain.BoardActivity
+	if err := r.db.WithContext(ctx).
+		Where("board_id = ? AND created_at > ?", boardID, since).
+		Order("created_at DESC, id DESC").
+		Find(&activities).Error; err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// Touch bumps a board's updated_at without changing any other column
+// It returns gorm.ErrRecordNotFound if the board does not exist or is soft-deleted
+func (r *boardRepositoryImpl) Touch(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).
+		Model(&domain.Board{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		UpdateColumn("updated_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// Delete permanently removes a board; participants and comments go with it through their foreign keys
+// It joins the transaction carried by ctx, if any
 func (r *boardRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Board{}, id).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Delete(&domain.Board{}, id).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+// Archive marks an active board as archived at archivedAt, recording reason (nil = no reason given)
+// The version is bumped so an update loaded before the archive fails with ErrVersionConflict
+// It returns gorm.ErrRecordNotFound if the board does not exist or is already archived
+// It joins the transaction carried by ctx, if any
+func (r *boardRepositoryImpl) Archive(ctx context.Context, id uuid.UUID, archivedAt time.Time, reason *string) error {
+	return r.setArchivedAt(ctx, "id = ? AND deleted_at IS NULL AND archived_at IS NULL", id, archivedAt.UTC(), reason)
+}
+
+// Restore clears the archived mark and archive reason of a board
+// It returns gorm.ErrRecordNotFound if the board does not exist or is not archived
+// It joins the transaction carried by ctx, if any
+func (r *boardRepositoryImpl) Restore(ctx context.Context, id uuid.UUID) error {
+	return r.setArchivedAt(ctx, "id = ? AND deleted_at IS NULL AND archived_at IS NOT NULL", id, nil, nil)
+}
+
+func (r *boardRepositoryImpl) setArchivedAt(ctx context.Context, condition string, id uuid.UUID, archivedAt interface{}, reason *string) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&domain.Board{}).
+		Where(condition, id).
+		Updates(map[string]interface{}{
+			"archived_at":    archivedAt,
+			"archive_reason": reason,
+			"version":        gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CountActiveByProjectID counts the active boards of a project in a single query
+// Archived boards do not count; restoring one counts it again
+func (r *boardRepositoryImpl) CountActiveByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	var count int64
+	if err := dbFromContext(ctx, r.db).
+		Model(&domain.Board{}).
+		Where("project_id = ? AND deleted_at IS NULL AND archived_at IS NULL", projectID).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// orphanedAssigneeCondition matches boards whose assignee is no longer a member of the board's project
+const orphanedAssigneeCondition = "assignee_id IS NOT NULL AND assignee_id NOT IN (SELECT user_id FROM project_members WHERE project_id = ?)"
+
+// FindOrphanedAssignees finds active boards whose assignee is not a member of the project
+func (r *boardRepositoryImpl) FindOrphanedAssignees(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error) {
+	boards := make([]*domain.Board, 0)
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ? AND deleted_at IS NULL", projectID).
+		Where(orphanedAssigneeCondition, projectID).
+		Order(boardListOrder).
+		Find(&boards).Error; err != nil {
+		return nil, err
+	}
+	return boards, nil
+}
+
+// ClearOrphanedAssignees unsets the assignee of the given boards
+// The membership check is repeated so an assignee re-added to the project in the meantime is kept
+func (r *boardRepositoryImpl) ClearOrphanedAssignees(ctx context.Context, projectID uuid.UUID, boardIDs []uuid.UUID) (int64, error) {
+	if len(boardIDs) == 0 {
+		return 0, nil
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&domain.Board{}).
+		Where("id IN ? AND project_id = ?", boardIDs, projectID).
+		Where(orphanedAssigneeCondition, projectID).
+		Update("assignee_id", nil)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
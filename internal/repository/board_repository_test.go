@@ -2,7 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/driver/sqlite"
@@ -11,7 +13,7 @@ import (
 	"project-board-api/internal/domain"
 )
 
-func setupBoardTestDB(t *testing.T) *gorm.DB {
+func setupBoardTestDB(t testing.TB) *gorm.DB {
 	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{
 		DisableForeignKeyConstraintWhenMigrating: true,
 	})
@@ -32,7 +34,8 @@ func setupBoardTestDB(t *testing.T) *gorm.DB {
 		start_date DATETIME,
 		due_date DATETIME,
 		is_default INTEGER DEFAULT 0,
-		is_public INTEGER DEFAULT 0
+		is_public INTEGER DEFAULT 0,
+		enforce_unique_titles INTEGER NOT NULL DEFAULT 0
 	)`)
 
 	db.Exec(`CREATE TABLE boards (
@@ -46,8 +49,26 @@ func setupBoardTestDB(t *testing.T) *gorm.DB {
 		title TEXT NOT NULL,
 		content TEXT,
 		custom_fields TEXT,
+		external_id TEXT,
 		start_date DATETIME,
-		due_date DATETIME
+		due_date DATETIME,
+		estimate_hours REAL,
+		actual_hours REAL,
+		version INTEGER NOT NULL DEFAULT 1,
+		title_unique INTEGER NOT NULL DEFAULT 0,
+		archived_at DATETIME,
+		archive_reason VARCHAR(100),
+		last_activity_at DATETIME
+	)`)
+	db.Exec(`CREATE UNIQUE INDEX idx_boards_project_unique_title ON boards (project_id, title) WHERE title_unique AND deleted_at IS NULL`)
+
+	db.Exec(`CREATE TABLE project_members (
+		id TEXT PRIMARY KEY,
+		project_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		role_name TEXT NOT NULL,
+		joined_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		UNIQUE(project_id, user_id)
 	)`)
 
 	db.Exec(`CREATE TABLE participants (
@@ -57,21 +78,113 @@ func setupBoardTestDB(t *testing.T) *gorm.DB {
 		deleted_at DATETIME,
 		board_id TEXT NOT NULL,
 		user_id TEXT NOT NULL,
+		last_seen_at DATETIME,
 		UNIQUE(board_id, user_id)
 	)`)
 
+	db.Exec(`CREATE TABLE labels (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		color TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE checklist_items (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		board_id TEXT NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+		text TEXT NOT NULL,
+		done INTEGER NOT NULL DEFAULT 0,
+		position INTEGER NOT NULL DEFAULT 0
+	)`)
+
+	db.Exec(`CREATE TABLE board_templates (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		title_pattern TEXT NOT NULL,
+		content_pattern TEXT,
+		custom_fields TEXT,
+		assignee_id TEXT,
+		participant_ids TEXT,
+		created_by TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE threshold_rules (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		project_id TEXT NOT NULL,
+		field TEXT NOT NULL,
+		operator TEXT NOT NULL,
+		threshold REAL NOT NULL,
+		recipients TEXT NOT NULL,
+		created_by TEXT NOT NULL
+	)`)
+
+	db.Exec(`CREATE TABLE threshold_rule_triggers (
+		rule_id TEXT NOT NULL,
+		board_id TEXT NOT NULL,
+		triggered_at DATETIME NOT NULL,
+		PRIMARY KEY (rule_id, board_id)
+	)`)
+
+	db.Exec(`CREATE TABLE board_labels (
+		board_id TEXT NOT NULL,
+		label_id TEXT NOT NULL,
+		PRIMARY KEY (board_id, label_id)
+	)`)
+
+	db.Exec(`CREATE TABLE comments (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		board_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		content TEXT NOT NULL,
+		pinned_at DATETIME
+	)`)
+
 	db.Exec(`CREATE TABLE attachments (
 		id TEXT PRIMARY KEY,
 		created_at DATETIME NOT NULL,
 		updated_at DATETIME NOT NULL,
 		deleted_at DATETIME,
 		entity_type TEXT NOT NULL,
-		entity_id TEXT NOT NULL,
+		entity_id TEXT,
+		status TEXT NOT NULL DEFAULT 'TEMP',
 		file_name TEXT NOT NULL,
 		file_url TEXT NOT NULL,
 		file_size INTEGER NOT NULL,
 		content_type TEXT NOT NULL,
-		uploaded_by TEXT NOT NULL
+		uploaded_by TEXT NOT NULL,
+		expires_at DATETIME,
+		checksum_sha256 TEXT,
+		download_count INTEGER NOT NULL DEFAULT 0,
+		visibility TEXT NOT NULL DEFAULT 'BOARD',
+		project_id TEXT
+	)`)
+
+	db.Exec(`CREATE TABLE board_activities (
+		id TEXT PRIMARY KEY,
+		created_at DATETIME NOT NULL,
+		updated_at DATETIME NOT NULL,
+		deleted_at DATETIME,
+		board_id TEXT NOT NULL,
+		actor_id TEXT NOT NULL,
+		field TEXT NOT NULL,
+		old_value TEXT,
+		new_value TEXT
 	)`)
 
 	return db
@@ -302,3 +415,1058 @@ func TestBoardRepository_FindByProjectID_WithFilters(t *testing.T) {
 		t.Errorf("expected 1 participant, got %d", len(boards[0].Participants))
 	}
 }
+
+func TestBoardRepository_CountActiveByProjectID(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	otherProjectID := uuid.New()
+
+	for _, pid := range []uuid.UUID{projectID, projectID, otherProjectID} {
+		db.Create(&domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: pid,
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+		})
+	}
+
+	count, err := repo.CountActiveByProjectID(ctx, projectID)
+	if err != nil {
+		t.Fatalf("CountActiveByProjectID failed: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 boards, got %d", count)
+	}
+}
+
+func TestBoardRepository_FindProjectIDs(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	deletedAt := time.Now()
+	active := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), AuthorID: uuid.New(), Title: "Active"}
+	trashed := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New(), DeletedAt: &deletedAt}, ProjectID: uuid.New(), AuthorID: uuid.New(), Title: "Trashed"}
+	for _, board := range []*domain.Board{active, trashed} {
+		if err := db.Create(board).Error; err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+	}
+
+	projectIDs, err := repo.FindProjectIDs(ctx, []uuid.UUID{active.ID, trashed.ID, uuid.New()})
+	if err != nil {
+		t.Fatalf("FindProjectIDs() error = %v", err)
+	}
+	// Deleted and unknown boards are left out
+	if len(projectIDs) != 1 || projectIDs[active.ID] != active.ProjectID {
+		t.Errorf("FindProjectIDs() = %v, want only %v -> %v", projectIDs, active.ID, active.ProjectID)
+	}
+}
+
+func TestBoardRepository_FindByID_SoftDeleted(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	deletedAt := time.Now()
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New(), DeletedAt: &deletedAt},
+		ProjectID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Title:     "Trashed Board",
+	}
+	if err := db.Create(board).Error; err != nil {
+		t.Fatalf("failed to create board: %v", err)
+	}
+
+	// Default lookup excludes the soft-deleted board
+	if _, err := repo.FindByID(ctx, board.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("FindByID() error = %v, want ErrRecordNotFound", err)
+	}
+
+	// The restore variant still returns it
+	found, err := repo.FindByIDIncludingDeleted(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("FindByIDIncludingDeleted() error = %v", err)
+	}
+	if found.ID != board.ID || found.DeletedAt == nil {
+		t.Errorf("FindByIDIncludingDeleted() = %v (deleted_at %v), want soft-deleted board %v", found.ID, found.DeletedAt, board.ID)
+	}
+}
+
+func TestBoardRepository_CountByProjectID_MatchesFilteredRows(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+
+	stages := []string{"in_progress", "in_progress", "done"}
+	for _, stage := range stages {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+		}
+		db.Create(board)
+		db.Exec("UPDATE boards SET custom_fields = ? WHERE id = ?", `{"stage":"`+stage+`"}`, board.ID.String())
+	}
+	// Board in another project must not be counted
+	db.Create(&domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Title:     "Other",
+	})
+
+	for _, filters := range []interface{}{nil, map[string]interface{}{"stage": "in_progress"}, map[string]interface{}{"stage": "done"}} {
+		boards, err := repo.FindByProjectID(ctx, projectID, filters)
+		if err != nil {
+			t.Fatalf("FindByProjectID() error = %v", err)
+		}
+
+		count, err := repo.CountByProjectID(ctx, projectID, filters)
+		if err != nil {
+			t.Fatalf("CountByProjectID() error = %v", err)
+		}
+
+		if count != int64(len(boards)) {
+			t.Errorf("filters %v: count = %d, want %d", filters, count, len(boards))
+		}
+	}
+
+	// Approximate mode falls back to an exact count outside PostgreSQL
+	estimate, err := repo.EstimateCountByProjectID(ctx, projectID, nil)
+	if err != nil {
+		t.Fatalf("EstimateCountByProjectID() error = %v", err)
+	}
+	if estimate != int64(len(stages)) {
+		t.Errorf("estimate = %d, want %d", estimate, len(stages))
+	}
+}
+
+func TestBoardRepository_SumEffortByProjectID(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	hours := func(v float64) *float64 { return &v }
+
+	boards := []*domain.Board{
+		{EstimateHours: hours(8), ActualHours: hours(10)},
+		{EstimateHours: hours(4)},
+		{ActualHours: hours(1.5)},
+	}
+	for _, board := range boards {
+		board.ID = uuid.New()
+		board.ProjectID = projectID
+		board.AuthorID = uuid.New()
+		board.Title = "Board"
+		db.Create(board)
+	}
+	// Another project's effort must not be included
+	db.Create(&domain.Board{
+		BaseModel:     domain.BaseModel{ID: uuid.New()},
+		ProjectID:     uuid.New(),
+		AuthorID:      uuid.New(),
+		Title:         "Other",
+		EstimateHours: hours(100),
+	})
+
+	estimate, actual, err := repo.SumEffortByProjectID(ctx, projectID)
+	if err != nil {
+		t.Fatalf("SumEffortByProjectID() error = %v", err)
+	}
+	if estimate != 12 {
+		t.Errorf("estimate = %v, want 12", estimate)
+	}
+	if actual != 11.5 {
+		t.Errorf("actual = %v, want 11.5", actual)
+	}
+}
+
+func TestBoardRepository_FindByProjectID_EqualTimestampsOrderedByID(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	for i := 0; i < 5; i++ {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: createdAt, UpdatedAt: createdAt},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+		}
+		if err := db.Create(board).Error; err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+	}
+
+	boards, err := repo.FindByProjectID(ctx, projectID, nil)
+	if err != nil {
+		t.Fatalf("FindByProjectID() error = %v", err)
+	}
+	if len(boards) != 5 {
+		t.Fatalf("expected 5 boards, got %d", len(boards))
+	}
+	for i := 1; i < len(boards); i++ {
+		if boards[i-1].ID.String() <= boards[i].ID.String() {
+			t.Errorf("boards sharing a timestamp not ordered by id DESC: %s before %s", boards[i-1].ID, boards[i].ID)
+		}
+	}
+}
+
+func TestBoardRepository_OrphanedAssignees(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	memberID := uuid.New()
+	removedID := uuid.New()
+
+	for _, userID := range []uuid.UUID{memberID, removedID} {
+		db.Create(&domain.ProjectMember{ID: uuid.New(), ProjectID: projectID, UserID: userID, RoleName: domain.ProjectRoleMember, JoinedAt: time.Now()})
+	}
+
+	newBoard := func(assigneeID *uuid.UUID) *domain.Board {
+		board := &domain.Board{
+			BaseModel:  domain.BaseModel{ID: uuid.New()},
+			ProjectID:  projectID,
+			AuthorID:   memberID,
+			AssigneeID: assigneeID,
+			Title:      "Board",
+		}
+		db.Create(board)
+		return board
+	}
+	memberBoard := newBoard(&memberID)
+	orphanBoard := newBoard(&removedID)
+	newBoard(nil)
+
+	// Remove the assignee from the project
+	if err := db.Where("project_id = ? AND user_id = ?", projectID, removedID).Delete(&domain.ProjectMember{}).Error; err != nil {
+		t.Fatalf("failed to remove member: %v", err)
+	}
+
+	orphans, err := repo.FindOrphanedAssignees(ctx, projectID)
+	if err != nil {
+		t.Fatalf("FindOrphanedAssignees() error = %v", err)
+	}
+	if len(orphans) != 1 || orphans[0].ID != orphanBoard.ID {
+		t.Fatalf("FindOrphanedAssignees() = %v, want only board %s", orphans, orphanBoard.ID)
+	}
+
+	cleared, err := repo.ClearOrphanedAssignees(ctx, projectID, []uuid.UUID{orphanBoard.ID, memberBoard.ID})
+	if err != nil {
+		t.Fatalf("ClearOrphanedAssignees() error = %v", err)
+	}
+	if cleared != 1 {
+		t.Errorf("ClearOrphanedAssignees() cleared = %d, want 1", cleared)
+	}
+
+	var reloaded domain.Board
+	db.First(&reloaded, "id = ?", orphanBoard.ID)
+	if reloaded.AssigneeID != nil {
+		t.Errorf("orphaned assignee not cleared: %v", reloaded.AssigneeID)
+	}
+	reloaded = domain.Board{}
+	db.First(&reloaded, "id = ?", memberBoard.ID)
+	if reloaded.AssigneeID == nil || *reloaded.AssigneeID != memberID {
+		t.Errorf("member assignee was cleared")
+	}
+
+	orphans, _ = repo.FindOrphanedAssignees(ctx, projectID)
+	if len(orphans) != 0 {
+		t.Errorf("expected no orphaned assignees after cleanup, got %d", len(orphans))
+	}
+}
+
+func TestBoardRepository_FindByProjectID_OverdueMatchesGo(t *testing.T) {
+	db := setupBoardTestDB(t)
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	db.Config.NowFunc = func() time.Time { return now }
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	at := func(t time.Time) *time.Time { return &t }
+	kst := time.FixedZone("KST", 9*60*60)
+	est := time.FixedZone("EST", -5*60*60)
+
+	cases := []struct {
+		name    string
+		dueDate *time.Time
+	}{
+		{name: "no due date", dueDate: nil},
+		{name: "due exactly now", dueDate: at(now)},
+		{name: "due one second ago", dueDate: at(now.Add(-time.Second))},
+		{name: "due in one second", dueDate: at(now.Add(time.Second))},
+		// Local wall clocks point the other way; the instant decides
+		{name: "due a minute ago in KST", dueDate: at(now.Add(-time.Minute).In(kst))},
+		{name: "due in a minute in EST", dueDate: at(now.Add(time.Minute).In(est))},
+	}
+
+	projectID := uuid.New()
+	want := make(map[uuid.UUID]bool)
+	names := make(map[uuid.UUID]string)
+	for _, tc := range cases {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     tc.name,
+			DueDate:   tc.dueDate,
+		}
+		want[board.ID] = board.IsOverdue(now)
+		names[board.ID] = tc.name
+		if err := repo.Create(ctx, board); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	boards, err := repo.FindByProjectID(ctx, projectID, nil)
+	if err != nil {
+		t.Fatalf("FindByProjectID() error = %v", err)
+	}
+	if len(boards) != len(cases) {
+		t.Fatalf("expected %d boards, got %d", len(cases), len(boards))
+	}
+	for _, board := range boards {
+		if board.Overdue == nil {
+			t.Errorf("%s: overdue flag not computed", names[board.ID])
+			continue
+		}
+		if *board.Overdue != want[board.ID] {
+			t.Errorf("%s: SQL overdue = %v, Go overdue = %v", names[board.ID], *board.Overdue, want[board.ID])
+		}
+	}
+}
+
+func seedOverdueBenchmarkBoards(b *testing.B) (*gorm.DB, uuid.UUID) {
+	db := setupBoardTestDB(b)
+	projectID := uuid.New()
+	now := time.Now().UTC()
+
+	boards := make([]*domain.Board, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		dueDate := now.Add(time.Duration(i-500) * time.Hour)
+		boards = append(boards, &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+			DueDate:   &dueDate,
+		})
+	}
+	if err := db.CreateInBatches(boards, 200).Error; err != nil {
+		b.Fatalf("failed to seed boards: %v", err)
+	}
+	return db, projectID
+}
+
+func BenchmarkBoardOverdue_SQL(b *testing.B) {
+	db, projectID := seedOverdueBenchmarkBoards(b)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		boards, err := repo.FindByProjectID(ctx, projectID, nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+		overdue := 0
+		for _, board := range boards {
+			if *board.Overdue {
+				overdue++
+			}
+		}
+	}
+}
+
+func BenchmarkBoardOverdue_Go(b *testing.B) {
+	db, projectID := seedOverdueBenchmarkBoards(b)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var boards []*domain.Board
+		if err := db.WithContext(ctx).Where("project_id = ?", projectID).Preload("Participants").Order(boardListOrder).Find(&boards).Error; err != nil {
+			b.Fatal(err)
+		}
+		now := time.Now()
+		overdue := 0
+		for _, board := range boards {
+			if board.IsOverdue(now) {
+				overdue++
+			}
+		}
+	}
+}
+
+func TestBoardRepository_SearchByProjectID_CombinesTextAndFilters(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	createBoard := func(title, content, stage string) *domain.Board {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     title,
+			Content:   content,
+		}
+		if err := db.Create(board).Error; err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+		db.Exec("UPDATE boards SET custom_fields = ? WHERE id = ?", `{"stage":"`+stage+`"}`, board.ID.String())
+		return board
+	}
+
+	contentMatch := createBoard("Session handling", "Fix the login redirect", "in_progress")
+	titleMatch := createBoard("Login page", "Rework layout", "in_progress")
+	createBoard("Login API", "Token refresh", "done")
+	createBoard("Unrelated", "Nothing here", "in_progress")
+
+	boards, total, err := repo.SearchByProjectID(ctx, projectID, BoardSearchQuery{
+		Text:         "LOGIN",
+		CustomFields: map[string]interface{}{"stage": "in_progress"},
+		Limit:        10,
+	})
+	if err != nil {
+		t.Fatalf("SearchByProjectID() error = %v", err)
+	}
+
+	if total != 2 {
+		t.Errorf("expected total 2, got %d", total)
+	}
+	if len(boards) != 2 {
+		t.Fatalf("expected 2 boards, got %d", len(boards))
+	}
+	// Title matches rank above content-only matches
+	if boards[0].ID != titleMatch.ID || boards[1].ID != contentMatch.ID {
+		t.Errorf("expected title match before content match, got %q then %q", boards[0].Title, boards[1].Title)
+	}
+
+	// Offset and limit page through the ranked results without changing the total
+	page, total, err := repo.SearchByProjectID(ctx, projectID, BoardSearchQuery{
+		Text:         "login",
+		CustomFields: map[string]interface{}{"stage": "in_progress"},
+		Offset:       1,
+		Limit:        1,
+	})
+	if err != nil {
+		t.Fatalf("SearchByProjectID() error = %v", err)
+	}
+	if total != 2 || len(page) != 1 || page[0].ID != contentMatch.ID {
+		t.Errorf("expected second page to hold the content match with total 2, got %d boards, total %d", len(page), total)
+	}
+}
+
+func TestBoardRepository_Touch(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	lastWeek := time.Now().Add(-7 * 24 * time.Hour)
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: lastWeek, UpdatedAt: lastWeek},
+		ProjectID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Title:     "Quiet Board",
+		Content:   "Unchanged",
+	}
+	if err := db.Create(board).Error; err != nil {
+		t.Fatalf("failed to create board: %v", err)
+	}
+	db.Exec("UPDATE boards SET updated_at = ? WHERE id = ?", lastWeek, board.ID.String())
+
+	if err := repo.Touch(ctx, board.ID); err != nil {
+		t.Fatalf("Touch() error = %v", err)
+	}
+
+	touched, err := repo.FindByID(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !touched.UpdatedAt.After(lastWeek.Add(time.Hour)) {
+		t.Errorf("expected updated_at to be bumped, got %v", touched.UpdatedAt)
+	}
+	if touched.Title != board.Title || touched.Content != board.Content {
+		t.Errorf("expected other columns unchanged, got title %q content %q", touched.Title, touched.Content)
+	}
+
+	// Soft-deleted and unknown boards are not found
+	deletedAt := time.Now()
+	deleted := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New(), DeletedAt: &deletedAt},
+		ProjectID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Title:     "Trashed Board",
+	}
+	if err := db.Create(deleted).Error; err != nil {
+		t.Fatalf("failed to create board: %v", err)
+	}
+	for _, id := range []uuid.UUID{deleted.ID, uuid.New()} {
+		if err := repo.Touch(ctx, id); !errors.Is(err, gorm.ErrRecordNotFound) {
+			t.Errorf("Touch(%v) error = %v, want ErrRecordNotFound", id, err)
+		}
+	}
+}
+
+func TestBoardRepository_CountByCustomFieldOption(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	createBoard := func(customFields string, deleted bool) {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+		}
+		if deleted {
+			now := time.Now()
+			board.DeletedAt = &now
+		}
+		if err := db.Create(board).Error; err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+		if customFields != "" {
+			db.Exec("UPDATE boards SET custom_fields = ? WHERE id = ?", customFields, board.ID.String())
+		}
+	}
+
+	createBoard(`{"stage":"opt-todo"}`, false)
+	createBoard(`{"stage":"opt-todo","importance":"opt-high"}`, false)
+	createBoard(`{"stage":"opt-done"}`, false)
+	createBoard(`{"importance":"opt-high"}`, false)
+	createBoard("", false)
+	createBoard(`{"stage":"opt-done"}`, true)
+
+	counts, err := repo.CountByCustomFieldOption(ctx, projectID, "stage")
+	if err != nil {
+		t.Fatalf("CountByCustomFieldOption() error = %v", err)
+	}
+
+	want := map[string]int64{"opt-todo": 2, "opt-done": 1, "": 2}
+	if len(counts) != len(want) {
+		t.Fatalf("expected %d groups, got %v", len(want), counts)
+	}
+	for key, count := range want {
+		if counts[key] != count {
+			t.Errorf("counts[%q] = %d, want %d", key, counts[key], count)
+		}
+	}
+}
+
+func TestBoardRepository_FindActivitiesByBoardID_NewestFirst(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	boardID := uuid.New()
+	actorID := uuid.New()
+	start := time.Now().Add(-time.Hour)
+
+	var activities []*domain.BoardActivity
+	for i, title := range []string{"v1", "v2", "v3"} {
+		activities = append(activities, &domain.BoardActivity{
+			BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: start.Add(time.Duration(i) * time.Minute)},
+			BoardID:   boardID,
+			ActorID:   actorID,
+			Field:     "title",
+			NewValue:  title,
+		})
+	}
+	if err := repo.AddActivities(ctx, activities); err != nil {
+		t.Fatalf("AddActivities() error = %v", err)
+	}
+	// Another board's history is not included
+	other := &domain.BoardActivity{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: start}, BoardID: uuid.New(), ActorID: actorID, Field: "title"}
+	if err := repo.AddActivities(ctx, []*domain.BoardActivity{other}); err != nil {
+		t.Fatalf("AddActivities() error = %v", err)
+	}
+
+	page, total, err := repo.FindActivitiesByBoardID(ctx, boardID, 0, 2)
+	if err != nil {
+		t.Fatalf("FindActivitiesByBoardID() error = %v", err)
+	}
+	if total != 3 {
+		t.Errorf("expected total 3, got %d", total)
+	}
+	if len(page) != 2 || page[0].NewValue != "v3" || page[1].NewValue != "v2" {
+		t.Fatalf("expected v3, v2 on the first page, got %d entries", len(page))
+	}
+
+	page, _, err = repo.FindActivitiesByBoardID(ctx, boardID, 2, 2)
+	if err != nil {
+		t.Fatalf("FindActivitiesByBoardID() error = %v", err)
+	}
+	if len(page) != 1 || page[0].NewValue != "v1" {
+		t.Errorf("expected v1 alone on the second page, got %d entries", len(page))
+	}
+
+	// Changes made exactly at since are excluded
+	since, err := repo.FindActivitiesSince(ctx, boardID, start)
+	if err != nil {
+		t.Fatalf("FindActivitiesSince() error = %v", err)
+	}
+	if len(since) != 2 || since[0].NewValue != "v3" || since[1].NewValue != "v2" {
+		t.Errorf("expected v3, v2 after the first change, got %d entries", len(since))
+	}
+}
+
+func TestBoardRepository_Update_RejectsStaleVersion(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Title:     "Original",
+		Version:   1,
+	}
+	if err := db.Create(board).Error; err != nil {
+		t.Fatalf("failed to create board: %v", err)
+	}
+
+	// Two tabs load the same version
+	first, err := repo.FindByID(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	second, err := repo.FindByID(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+
+	first.Title = "First save"
+	if err := repo.Update(ctx, first); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if first.Version != 2 {
+		t.Errorf("expected version 2 after update, got %d", first.Version)
+	}
+
+	second.Title = "Second save"
+	if err := repo.Update(ctx, second); !errors.Is(err, ErrVersionConflict) {
+		t.Fatalf("expected ErrVersionConflict, got %v", err)
+	}
+	if second.Version != 1 {
+		t.Errorf("expected the rejected board to keep version 1, got %d", second.Version)
+	}
+
+	stored, err := repo.FindByID(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if stored.Title != "First save" || stored.Version != 2 {
+		t.Errorf("expected the first save to win, got title %q version %d", stored.Title, stored.Version)
+	}
+}
+
+func TestBoardRepository_UniqueTitles(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	projectRepo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), OwnerID: uuid.New(), Name: "Project"}
+	if err := db.Create(project).Error; err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	newBoard := func(title string, unique bool) *domain.Board {
+		return &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: project.ID, AuthorID: uuid.New(), Title: title, TitleUnique: unique}
+	}
+
+	first, second := newBoard("Launch", false), newBoard("Launch", false)
+	for _, board := range []*domain.Board{first, second} {
+		if err := repo.Create(ctx, board); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	// Existing duplicates block turning the setting on
+	if err := projectRepo.SetEnforceUniqueTitles(ctx, project.ID, true); !errors.Is(err, ErrDuplicateBoardTitles) {
+		t.Fatalf("expected ErrDuplicateBoardTitles, got %v", err)
+	}
+
+	if err := repo.Delete(ctx, second.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := projectRepo.SetEnforceUniqueTitles(ctx, project.ID, true); err != nil {
+		t.Fatalf("SetEnforceUniqueTitles() error = %v", err)
+	}
+	stored, err := projectRepo.FindByID(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if !stored.EnforceUniqueTitles {
+		t.Error("expected the project to enforce unique titles")
+	}
+
+	holder, err := repo.FindByUniqueTitle(ctx, project.ID, "Launch")
+	if err != nil || holder.ID != first.ID {
+		t.Fatalf("FindByUniqueTitle() = %v, %v, want board %s", holder, err, first.ID)
+	}
+
+	// The partial unique index rejects a duplicate that slips past the service check
+	if err := repo.Create(ctx, newBoard("Launch", true)); err == nil {
+		t.Fatal("expected the unique index to reject a duplicate title")
+	}
+
+	// Removing the holder frees the title
+	if err := repo.Delete(ctx, first.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if err := repo.Create(ctx, newBoard("Launch", true)); err != nil {
+		t.Errorf("Create() after freeing the title error = %v", err)
+	}
+}
+
+func TestBoardRepository_ArchiveAndRestore(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	active := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Active"}
+	archived := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Archived"}
+	for _, board := range []*domain.Board{active, archived} {
+		if err := repo.Create(ctx, board); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	reason := "duplicate"
+	if err := repo.Archive(ctx, archived.ID, time.Now(), &reason); err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+	if err := repo.Archive(ctx, archived.ID, time.Now(), nil); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Archive() of an archived board error = %v, want gorm.ErrRecordNotFound", err)
+	}
+
+	// Archived boards are left out of lists and counts unless asked for
+	boards, err := repo.FindByProjectID(ctx, projectID, nil)
+	if err != nil {
+		t.Fatalf("FindByProjectID() error = %v", err)
+	}
+	if len(boards) != 1 || boards[0].ID != active.ID {
+		t.Errorf("FindByProjectID() returned %d boards, want only the active one", len(boards))
+	}
+	boards, err = repo.FindByProjectID(ctx, projectID, BoardListFilter{IncludeArchived: true})
+	if err != nil {
+		t.Fatalf("FindByProjectID() error = %v", err)
+	}
+	if len(boards) != 2 {
+		t.Errorf("FindByProjectID(IncludeArchived) returned %d boards, want 2", len(boards))
+	}
+	if count, _ := repo.CountActiveByProjectID(ctx, projectID); count != 1 {
+		t.Errorf("CountActiveByProjectID() = %d, want 1", count)
+	}
+	if _, total, _ := repo.SearchByProjectID(ctx, projectID, BoardSearchQuery{Text: "archived", Limit: 10}); total != 0 {
+		t.Errorf("SearchByProjectID() total = %d, want 0", total)
+	}
+
+	// The board itself stays reachable, and the archive bumped its version
+	found, err := repo.FindByID(ctx, archived.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if found.ArchivedAt == nil {
+		t.Error("expected ArchivedAt to be set")
+	}
+	if found.ArchiveReason == nil || *found.ArchiveReason != reason {
+		t.Errorf("ArchiveReason = %v, want %q", found.ArchiveReason, reason)
+	}
+	if found.Version != archived.Version+1 {
+		t.Errorf("Version = %d, want %d", found.Version, archived.Version+1)
+	}
+
+	if err := repo.Restore(ctx, archived.ID); err != nil {
+		t.Fatalf("Restore() error = %v", err)
+	}
+	if err := repo.Restore(ctx, archived.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("Restore() of an active board error = %v, want gorm.ErrRecordNotFound", err)
+	}
+	if count, _ := repo.CountActiveByProjectID(ctx, projectID); count != 2 {
+		t.Errorf("CountActiveByProjectID() after restore = %d, want 2", count)
+	}
+	if found, err := repo.FindByID(ctx, archived.ID); err != nil || found.ArchiveReason != nil {
+		t.Errorf("ArchiveReason after restore = %v (error %v), want nil", found.ArchiveReason, err)
+	}
+}
+
+func TestBoardRepository_ListByProjectID_KeysetPagination(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	createBoard := func(title string, dueDate *time.Time) *domain.Board {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     title,
+			DueDate:   dueDate,
+		}
+		if err := repo.Create(ctx, board); err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+		return board
+	}
+
+	early := createBoard("early", &day)
+	later := day.Add(24 * time.Hour)
+	// Two boards share a due date so id has to break the tie
+	tiedA := createBoard("tied a", &later)
+	tiedB := createBoard("tied b", &later)
+	undatedA := createBoard("undated a", nil)
+	undatedB := createBoard("undated b", nil)
+	if tiedB.ID.String() < tiedA.ID.String() {
+		tiedA, tiedB = tiedB, tiedA
+	}
+	if undatedB.ID.String() < undatedA.ID.String() {
+		undatedA, undatedB = undatedB, undatedA
+	}
+
+	collect := func(ascending bool) []uuid.UUID {
+		var ids []uuid.UUID
+		var after *domain.Board
+		for {
+			page, err := repo.ListByProjectID(ctx, projectID, BoardPageQuery{
+				SortBy:    BoardSortDueDate,
+				Ascending: ascending,
+				After:     after,
+				Limit:     2,
+			})
+			if err != nil {
+				t.Fatalf("ListByProjectID() error = %v", err)
+			}
+			if len(page) == 0 {
+				return ids
+			}
+			for _, board := range page {
+				ids = append(ids, board.ID)
+			}
+			after = page[len(page)-1]
+		}
+	}
+
+	// Undated boards come last in both directions
+	for _, tc := range []struct {
+		ascending bool
+		want      []*domain.Board
+	}{
+		{true, []*domain.Board{early, tiedA, tiedB, undatedA, undatedB}},
+		{false, []*domain.Board{tiedB, tiedA, early, undatedB, undatedA}},
+	} {
+		got := collect(tc.ascending)
+		if len(got) != len(tc.want) {
+			t.Fatalf("ascending=%v: expected %d boards, got %d", tc.ascending, len(tc.want), len(got))
+		}
+		for i, board := range tc.want {
+			if got[i] != board.ID {
+				t.Errorf("ascending=%v: position %d expected %q", tc.ascending, i, board.Title)
+			}
+		}
+	}
+}
+
+func TestBoardRepository_ListByProjectID_MultiKeySort(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	later := day.Add(24 * time.Hour)
+	createBoard := func(title string, dueDate *time.Time) *domain.Board {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     title,
+			DueDate:   dueDate,
+		}
+		if err := repo.Create(ctx, board); err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+		return board
+	}
+
+	// Boards sharing a due date are ordered by the second key, title descending
+	earlyA := createBoard("a", &day)
+	earlyC := createBoard("c", &day)
+	laterB := createBoard("b", &later)
+	laterD := createBoard("d", &later)
+	undatedA := createBoard("a", nil)
+	undatedE := createBoard("e", nil)
+	want := []*domain.Board{earlyC, earlyA, laterD, laterB, undatedE, undatedA}
+
+	sort := []BoardSortField{{Column: BoardSortDueDate}, {Column: BoardSortTitle, Desc: true}}
+	// Page sizes that split a tie on the first key and the undated boards
+	for _, limit := range []int{1, 2, 3, 10} {
+		var got []uuid.UUID
+		var after *domain.Board
+		for {
+			page, err := repo.ListByProjectID(ctx, projectID, BoardPageQuery{Sort: sort, After: after, Limit: limit})
+			if err != nil {
+				t.Fatalf("ListByProjectID() error = %v", err)
+			}
+			if len(page) == 0 {
+				break
+			}
+			for _, board := range page {
+				got = append(got, board.ID)
+			}
+			after = page[len(page)-1]
+		}
+
+		if len(got) != len(want) {
+			t.Fatalf("limit=%d: expected %d boards, got %d", limit, len(want), len(got))
+		}
+		for i, board := range want {
+			if got[i] != board.ID {
+				t.Errorf("limit=%d: position %d expected %q due %v", limit, i, board.Title, board.DueDate)
+			}
+		}
+	}
+}
+
+func TestBoardRepository_ListByProjectID_Filters(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	assigneeID := uuid.New()
+	participantID := uuid.New()
+	createBoard := func(title string, assignee *uuid.UUID) *domain.Board {
+		board := &domain.Board{
+			BaseModel:  domain.BaseModel{ID: uuid.New()},
+			ProjectID:  projectID,
+			AuthorID:   uuid.New(),
+			Title:      title,
+			AssigneeID: assignee,
+		}
+		if err := repo.Create(ctx, board); err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+		return board
+	}
+
+	assigned := createBoard("assigned", &assigneeID)
+	both := createBoard("assigned and participating", &assigneeID)
+	createBoard("unassigned", nil)
+	participant := &domain.Participant{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: both.ID, UserID: participantID}
+	if err := db.Create(participant).Error; err != nil {
+		t.Fatalf("failed to create participant: %v", err)
+	}
+
+	boards, err := repo.ListByProjectID(ctx, projectID, BoardPageQuery{AssigneeID: &assigneeID, SortBy: BoardSortTitle, Ascending: true})
+	if err != nil {
+		t.Fatalf("ListByProjectID() error = %v", err)
+	}
+	if len(boards) != 2 || boards[0].ID != assigned.ID || boards[1].ID != both.ID {
+		t.Fatalf("expected the two assigned boards by title, got %d boards", len(boards))
+	}
+
+	boards, err = repo.ListByProjectID(ctx, projectID, BoardPageQuery{AssigneeID: &assigneeID, ParticipantID: &participantID})
+	if err != nil {
+		t.Fatalf("ListByProjectID() error = %v", err)
+	}
+	if len(boards) != 1 || boards[0].ID != both.ID {
+		t.Errorf("expected only the board the participant is on, got %d boards", len(boards))
+	}
+}
+
+func TestBoardRepository_Pagination_EqualTimestampsVisitEachBoardOnce(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	const boardCount = 7
+
+	for i := 0; i < boardCount; i++ {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: createdAt, UpdatedAt: createdAt},
+			ProjectID: projectID,
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+		}
+		if err := db.Create(board).Error; err != nil {
+			t.Fatalf("failed to create board: %v", err)
+		}
+	}
+
+	// checkEachOnce fails unless every board was visited exactly once
+	checkEachOnce := func(name string, visited []uuid.UUID) {
+		seen := make(map[uuid.UUID]int)
+		for _, id := range visited {
+			seen[id]++
+		}
+		if len(visited) != boardCount || len(seen) != boardCount {
+			t.Errorf("%s: visited %d boards (%d distinct), want each of %d once", name, len(visited), len(seen), boardCount)
+		}
+		for id, count := range seen {
+			if count != 1 {
+				t.Errorf("%s: board %s visited %d times", name, id, count)
+			}
+		}
+	}
+
+	// Offset pages of the search
+	var searched []uuid.UUID
+	for offset := 0; ; offset += 2 {
+		page, _, err := repo.SearchByProjectID(ctx, projectID, BoardSearchQuery{Text: "board", Offset: offset, Limit: 2})
+		if err != nil {
+			t.Fatalf("SearchByProjectID() error = %v", err)
+		}
+		// A page that repeats rows would otherwise never run dry
+		if len(page) == 0 || len(searched) > boardCount {
+			break
+		}
+		for _, board := range page {
+			searched = append(searched, board.ID)
+		}
+	}
+	checkEachOnce("SearchByProjectID", searched)
+
+	// Keyset pages of the listing
+	var listed []uuid.UUID
+	var after *domain.Board
+	for {
+		page, err := repo.ListByProjectID(ctx, projectID, BoardPageQuery{After: after, Limit: 2})
+		if err != nil {
+			t.Fatalf("ListByProjectID() error = %v", err)
+		}
+		if len(page) == 0 || len(listed) > boardCount {
+			break
+		}
+		for _, board := range page {
+			listed = append(listed, board.ID)
+		}
+		after = page[len(page)-1]
+	}
+	checkEachOnce("ListByProjectID", listed)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// BoardTemplateRepository defines the interface for board template data access
+type BoardTemplateRepository interface {
+	Create(ctx context.Context, template *domain.BoardTemplate) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error)
+}
+
+// boardTemplateRepositoryImpl is the GORM implementation of BoardTemplateRepository
+type boardTemplateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewBoardTemplateRepository creates a new instance of BoardTemplateRepository
+func NewBoardTemplateRepository(db *gorm.DB) BoardTemplateRepository {
+	return &boardTemplateRepositoryImpl{db: db}
+}
+
+// Create creates a new board template
+func (r *boardTemplateRepositoryImpl) Create(ctx context.Context, template *domain.BoardTemplate) error {
+	if err := r.db.WithContext(ctx).Create(template).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindByID finds a board template by ID
+func (r *boardTemplateRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error) {
+	var template domain.BoardTemplate
+	if err := r.db.WithContext(ctx).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// FindByProjectID finds all templates of a project, newest first
+func (r *boardTemplateRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error) {
+	var templates []*domain.BoardTemplate
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ? AND deleted_at IS NULL", projectID).
+		Order("created_at DESC, id DESC").
+		Find(&templates).Error; err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+)
+
+func TestBoardTemplateRepository_FindByProjectID(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewBoardTemplateRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	participant := uuid.New()
+	older := &domain.BoardTemplate{
+		BaseModel:      domain.BaseModel{ID: uuid.New(), CreatedAt: time.Now().Add(-time.Hour)},
+		ProjectID:      projectID,
+		Name:           "Older",
+		TitlePattern:   "Older",
+		CustomFields:   datatypes.JSON(`{"stage":"in_progress"}`),
+		ParticipantIDs: []uuid.UUID{participant},
+		CreatedBy:      uuid.New(),
+	}
+	newer := &domain.BoardTemplate{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		ProjectID:    projectID,
+		Name:         "Newer",
+		TitlePattern: "Newer",
+		CreatedBy:    uuid.New(),
+	}
+	other := &domain.BoardTemplate{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Name: "Other", TitlePattern: "Other", CreatedBy: uuid.New()}
+	for _, template := range []*domain.BoardTemplate{older, newer, other} {
+		if err := repo.Create(ctx, template); err != nil {
+			t.Fatalf("failed to create template: %v", err)
+		}
+	}
+
+	templates, err := repo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		t.Fatalf("FindByProjectID() error = %v", err)
+	}
+	if len(templates) != 2 || templates[0].ID != newer.ID || templates[1].ID != older.ID {
+		t.Fatalf("FindByProjectID() returned %d templates, want newer then older", len(templates))
+	}
+
+	found, err := repo.FindByID(ctx, older.ID)
+	if err != nil {
+		t.Fatalf("FindByID() error = %v", err)
+	}
+	if len(found.ParticipantIDs) != 1 || found.ParticipantIDs[0] != participant {
+		t.Errorf("participantIds = %v, want [%s]", found.ParticipantIDs, participant)
+	}
+	if string(found.CustomFields) != `{"stage":"in_progress"}` {
+		t.Errorf("customFields = %s, want the stored values", found.CustomFields)
+	}
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"project-board-api/internal/domain"
+)
+
+// ErrChecklistOrderMismatch is returned by Reorder when the IDs are not exactly the board's items
+var ErrChecklistOrderMismatch = errors.New("checklist order must list every item of the board exactly once")
+
+// ChecklistItemRepository defines the interface for checklist item data access
+type ChecklistItemRepository interface {
+	Create(ctx context.Context, item *domain.ChecklistItem) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.ChecklistItem, error)
+	FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.ChecklistItem, error)
+	Update(ctx context.Context, item *domain.ChecklistItem) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	Reorder(ctx context.Context, boardID uuid.UUID, itemIDs []uuid.UUID) error
+}
+
+// checklistItemRepositoryImpl is the GORM implementation of ChecklistItemRepository
+type checklistItemRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewChecklistItemRepository creates a new instance of ChecklistItemRepository
+func NewChecklistItemRepository(db *gorm.DB) ChecklistItemRepository {
+	return &checklistItemRepositoryImpl{db: db}
+}
+
+// orderChecklistItems sorts checklist items by position; id breaks ties left by concurrent appends
+func orderChecklistItems(db *gorm.DB) *gorm.DB {
+	return db.Order("position ASC, id ASC")
+}
+
+// lockBoardRow locks the board row so checklist writes of the same board run one at a time
+func lockBoardRow(tx *gorm.DB, boardID uuid.UUID) error {
+	var board domain.Board
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ? AND deleted_at IS NULL", boardID).
+		First(&board).Error
+}
+
+// Create appends an item after the last item of its board
+func (r *checklistItemRepositoryImpl) Create(ctx context.Context, item *domain.ChecklistItem) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockBoardRow(tx, item.BoardID); err != nil {
+			return err
+		}
+
+		var next int
+		if err := tx.Model(&domain.ChecklistItem{}).
+			Where("board_id = ?", item.BoardID).
+			Select("COALESCE(MAX(position) + 1, 0)").
+			Scan(&next).Error; err != nil {
+			return err
+		}
+		item.Position = next
+
+		return tx.Create(item).Error
+	})
+}
+
+// FindByID finds a checklist item by ID
+func (r *checklistItemRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.ChecklistItem, error) {
+	var item domain.ChecklistItem
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&item).Error; err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// FindByBoardID finds the items of a board in checklist order
+func (r *checklistItemRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.ChecklistItem, error) {
+	var items []*domain.ChecklistItem
+	if err := orderChecklistItems(r.db.WithContext(ctx)).
+		Where("board_id = ?", boardID).
+		Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// Update saves the text and done state of an item; its position only changes through Reorder
+func (r *checklistItemRepositoryImpl) Update(ctx context.Context, item *domain.ChecklistItem) error {
+	return r.db.WithContext(ctx).
+		Model(item).
+		Select("text", "done", "updated_at").
+		Updates(item).Error
+}
+
+// Delete deletes a checklist item; the remaining items keep their relative order
+func (r *checklistItemRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&domain.ChecklistItem{}, id).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// Reorder assigns positions 0..n-1 to the board's items in the order of itemIDs
+// itemIDs must list every item of the board exactly once, otherwise ErrChecklistOrderMismatch is returned
+// The board row is locked for the whole rewrite, so concurrent reorders apply one after the other
+func (r *checklistItemRepositoryImpl) Reorder(ctx context.Context, boardID uuid.UUID, itemIDs []uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockBoardRow(tx, boardID); err != nil {
+			return err
+		}
+
+		var existing []uuid.UUID
+		if err := tx.Model(&domain.ChecklistItem{}).
+			Where("board_id = ?", boardID).
+			Pluck("id", &existing).Error; err != nil {
+			return err
+		}
+		if !sameIDSet(existing, itemIDs) {
+			return ErrChecklistOrderMismatch
+		}
+
+		for position, id := range itemIDs {
+			if err := tx.Model(&domain.ChecklistItem{}).
+				Where("id = ? AND board_id = ?", id, boardID).
+				Update("position", position).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// sameIDSet reports whether ordered lists every ID of existing exactly once and nothing else
+func sameIDSet(existing, ordered []uuid.UUID) bool {
+	if len(existing) != len(ordered) {
+		return false
+	}
+	remaining := make(map[uuid.UUID]bool, len(existing))
+	for _, id := range existing {
+		remaining[id] = true
+	}
+	for _, id := range ordered {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
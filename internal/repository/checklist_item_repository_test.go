@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestChecklistItemRepository_CreateAndReorder(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewChecklistItemRepository(db)
+	ctx := context.Background()
+
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), AuthorID: uuid.New(), Title: "Release"}
+	db.Create(board)
+
+	var items []*domain.ChecklistItem
+	for _, text := range []string{"build", "test", "ship"} {
+		item := &domain.ChecklistItem{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: board.ID, Text: text}
+		if err := repo.Create(ctx, item); err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		items = append(items, item)
+	}
+	// New items are appended after the last one
+	for i, item := range items {
+		if item.Position != i {
+			t.Errorf("%s position = %d, want %d", item.Text, item.Position, i)
+		}
+	}
+
+	if err := repo.Reorder(ctx, board.ID, []uuid.UUID{items[2].ID, items[0].ID, items[1].ID}); err != nil {
+		t.Fatalf("Reorder() error = %v", err)
+	}
+	got, err := repo.FindByBoardID(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("FindByBoardID() error = %v", err)
+	}
+	want := []string{"ship", "build", "test"}
+	for i, item := range got {
+		if item.Text != want[i] || item.Position != i {
+			t.Errorf("item %d = %s at %d, want %s at %d", i, item.Text, item.Position, want[i], i)
+		}
+	}
+
+	// Missing, repeated or foreign IDs leave the order untouched
+	for name, ids := range map[string][]uuid.UUID{
+		"missing item":  {items[0].ID, items[1].ID},
+		"repeated item": {items[0].ID, items[0].ID, items[1].ID},
+		"foreign item":  {items[0].ID, items[1].ID, uuid.New()},
+	} {
+		if err := repo.Reorder(ctx, board.ID, ids); !errors.Is(err, ErrChecklistOrderMismatch) {
+			t.Errorf("%s: Reorder() error = %v, want ErrChecklistOrderMismatch", name, err)
+		}
+	}
+	got, _ = repo.FindByBoardID(ctx, board.ID)
+	if got[0].Text != "ship" {
+		t.Errorf("first item = %s after rejected reorders, want ship", got[0].Text)
+	}
+}
+
+func TestChecklistItemRepository_DeletedWithBoard(t *testing.T) {
+	db := setupBoardTestDB(t)
+	// SQLite only enforces the ON DELETE CASCADE of the schema with foreign keys enabled
+	db.Exec("PRAGMA foreign_keys = ON")
+	repo := NewChecklistItemRepository(db)
+	boardRepo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), AuthorID: uuid.New(), Title: "Release"}
+	db.Create(board)
+	if err := repo.Create(ctx, &domain.ChecklistItem{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: board.ID, Text: "ship"}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	if err := boardRepo.Delete(ctx, board.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	items, err := repo.FindByBoardID(ctx, board.ID)
+	if err != nil {
+		t.Fatalf("FindByBoardID() error = %v", err)
+	}
+	if len(items) != 0 {
+		t.Errorf("got %d checklist items after deleting the board, want 0", len(items))
+	}
+}
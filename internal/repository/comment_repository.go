@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -17,6 +18,10 @@ type CommentRepository interface {
 	FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Comment, error)
 	Update(ctx context.Context, comment *domain.Comment) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	Pin(ctx context.Context, comment *domain.Comment, unpinOthers bool) error
+	Unpin(ctx context.Context, comment *domain.Comment) error
+	AddRevision(ctx context.Context, revision *domain.CommentRevision) error
+	FindRevisionsByCommentID(ctx context.Context, commentID uuid.UUID) ([]*domain.CommentRevision, error)
 }
 
 // commentRepositoryImpl is the GORM implementation of CommentRepository
@@ -53,14 +58,14 @@ func (r *commentRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*do
 	return &comment, nil
 }
 
-// FindByBoardID finds all comments by board ID, ordered by creation time
+// FindByBoardID finds all comments by board ID, pinned comments first (in pin order), then by creation time
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *commentRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Comment, error) {
 	var comments []*domain.Comment
 	if err := r.db.WithContext(ctx).
 		// Preload("Attachments"). // ✅ 제거
 		Where("board_id = ?", boardID).
-		Order("created_at ASC").
+		Order("CASE WHEN pinned_at IS NULL THEN 1 ELSE 0 END, pinned_at ASC, created_at ASC, id ASC").
 		Find(&comments).Error; err != nil {
 		return nil, err
 	}
@@ -68,8 +73,9 @@ func (r *commentRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.
 }
 
 // Update updates a comment
+// It joins the transaction carried by ctx, if any
 func (r *commentRepositoryImpl) Update(ctx context.Context, comment *domain.Comment) error {
-	if err := r.db.WithContext(ctx).Save(comment).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Save(comment).Error; err != nil {
 		return err
 	}
 	return nil
@@ -77,8 +83,64 @@ func (r *commentRepositoryImpl) Update(ctx context.Context, comment *domain.Comm
 
 // Delete soft deletes a comment
 func (r *commentRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Comment{}, id).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Delete(&domain.Comment{}, id).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+// Pin pins a comment to the top of its board
+// With unpinOthers the board's other pinned comments are unpinned in the same transaction
+func (r *commentRepositoryImpl) Pin(ctx context.Context, comment *domain.Comment, unpinOthers bool) error {
+	pinnedAt := time.Now()
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if unpinOthers {
+			if err := tx.Model(&domain.Comment{}).
+				Where("board_id = ? AND id <> ? AND pinned_at IS NOT NULL", comment.BoardID, comment.ID).
+				Update("pinned_at", nil).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Model(&domain.Comment{}).
+			Where("id = ?", comment.ID).
+			Update("pinned_at", pinnedAt).Error
+	})
+	if err != nil {
+		return err
+	}
+	comment.PinnedAt = &pinnedAt
+	return nil
+}
+
+// Unpin removes a comment's pin
+func (r *commentRepositoryImpl) Unpin(ctx context.Context, comment *domain.Comment) error {
+	if err := r.db.WithContext(ctx).
+		Model(&domain.Comment{}).
+		Where("id = ?", comment.ID).
+		Update("pinned_at", nil).Error; err != nil {
+		return err
+	}
+	comment.PinnedAt = nil
+	return nil
+}
+
+// AddRevision records a previous body of a comment
+// It joins the transaction carried by ctx, if any
+func (r *commentRepositoryImpl) AddRevision(ctx context.Context, revision *domain.CommentRevision) error {
+	if err := dbFromContext(ctx, r.db).Create(revision).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindRevisionsByCommentID finds the previous bodies of a comment, oldest first
+func (r *commentRepositoryImpl) FindRevisionsByCommentID(ctx context.Context, commentID uuid.UUID) ([]*domain.CommentRevision, error) {
+	var revisions []*domain.CommentRevision
+	if err := r.db.WithContext(ctx).
+		Where("comment_id = ?", commentID).
+		Order("created_at ASC, id ASC").
+		Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	return revisions, nil
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestCommentRepository_Pin(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (CommentRepository, []*domain.Comment) {
+		db := setupBoardTestDB(t)
+		boardID := uuid.New()
+		base := time.Now().Add(-time.Hour)
+		comments := make([]*domain.Comment, 3)
+		for i := range comments {
+			comments[i] = &domain.Comment{
+				BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: base.Add(time.Duration(i) * time.Minute)},
+				BoardID:   boardID,
+				UserID:    uuid.New(),
+				Content:   "comment",
+			}
+			if err := db.Create(comments[i]).Error; err != nil {
+				t.Fatalf("failed to create comment: %v", err)
+			}
+		}
+		return NewCommentRepository(db), comments
+	}
+
+	pinnedIDs := func(t *testing.T, repo CommentRepository, boardID uuid.UUID) []uuid.UUID {
+		t.Helper()
+		listed, err := repo.FindByBoardID(ctx, boardID)
+		if err != nil {
+			t.Fatalf("FindByBoardID() error = %v", err)
+		}
+		var ids []uuid.UUID
+		for _, comment := range listed {
+			if comment.PinnedAt != nil {
+				ids = append(ids, comment.ID)
+			}
+		}
+		return ids
+	}
+
+	t.Run("pinning a second comment unpins the first in single-pin mode", func(t *testing.T) {
+		repo, comments := setup(t)
+
+		if err := repo.Pin(ctx, comments[1], true); err != nil {
+			t.Fatalf("Pin() error = %v", err)
+		}
+		if err := repo.Pin(ctx, comments[2], true); err != nil {
+			t.Fatalf("Pin() error = %v", err)
+		}
+
+		pinned := pinnedIDs(t, repo, comments[0].BoardID)
+		if len(pinned) != 1 || pinned[0] != comments[2].ID {
+			t.Errorf("pinned comments = %v, want only %v", pinned, comments[2].ID)
+		}
+	})
+
+	t.Run("pinned comments are listed first", func(t *testing.T) {
+		repo, comments := setup(t)
+
+		if err := repo.Pin(ctx, comments[2], false); err != nil {
+			t.Fatalf("Pin() error = %v", err)
+		}
+		if err := repo.Pin(ctx, comments[1], false); err != nil {
+			t.Fatalf("Pin() error = %v", err)
+		}
+
+		listed, err := repo.FindByBoardID(ctx, comments[0].BoardID)
+		if err != nil {
+			t.Fatalf("FindByBoardID() error = %v", err)
+		}
+		want := []uuid.UUID{comments[2].ID, comments[1].ID, comments[0].ID}
+		for i, comment := range listed {
+			if comment.ID != want[i] {
+				t.Errorf("comment[%d] = %v, want %v", i, comment.ID, want[i])
+			}
+		}
+	})
+}
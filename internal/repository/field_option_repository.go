@@ -23,6 +23,7 @@ type FieldOptionRepository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.FieldOption, error)
 	FindByProjectAndFieldTypeAndValue(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType, value string) (*domain.FieldOption, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error)
 }
 
 // fieldOptionRepositoryImpl is the GORM implementation of FieldOptionRepository
@@ -162,3 +163,15 @@ func (r *fieldOptionRepositoryImpl) FindByProjectAndFieldTypeAndValue(ctx contex
 	}
 	return &fieldOption, nil
 }
+
+// FindByProjectID finds every field option of a project, archived ones included
+func (r *fieldOptionRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error) {
+	var fieldOptions []*domain.FieldOption
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("field_type ASC, display_order ASC").
+		Find(&fieldOptions).Error; err != nil {
+		return nil, err
+	}
+	return fieldOptions, nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"project-board-api/internal/domain"
+)
+
+// boardLabelsTable is the join table behind domain.Board.Labels
+const boardLabelsTable = "board_labels"
+
+// LabelRepository defines the interface for label data access
+type LabelRepository interface {
+	Create(ctx context.Context, label *domain.Label) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.Label, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error)
+	FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	AddToBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+	RemoveFromBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+	ReplaceForBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+}
+
+// labelRepositoryImpl is the GORM implementation of LabelRepository
+type labelRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewLabelRepository creates a new instance of LabelRepository
+func NewLabelRepository(db *gorm.DB) LabelRepository {
+	return &labelRepositoryImpl{db: db}
+}
+
+// Create creates a new label
+func (r *labelRepositoryImpl) Create(ctx context.Context, label *domain.Label) error {
+	if err := r.db.WithContext(ctx).Create(label).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindByID finds a label by ID
+func (r *labelRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+	var label domain.Label
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&label).Error; err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// FindByIDs finds the labels with the given IDs; unknown IDs are left out
+// It joins the transaction carried by ctx, if any
+func (r *labelRepositoryImpl) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error) {
+	var labels []*domain.Label
+	if len(ids) == 0 {
+		return labels, nil
+	}
+	if err := dbFromContext(ctx, r.db).
+		Where("id IN ?", ids).
+		Find(&labels).Error; err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// FindByProjectID finds all labels of a project, ordered by name
+func (r *labelRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error) {
+	var labels []*domain.Label
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("name ASC").
+		Find(&labels).Error; err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// FindByProjectAndName finds the label of a project with the given name
+func (r *labelRepositoryImpl) FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error) {
+	var label domain.Label
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ? AND name = ?", projectID, name).
+		First(&label).Error; err != nil {
+		return nil, err
+	}
+	return &label, nil
+}
+
+// Delete deletes a label and detaches it from all boards
+func (r *labelRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM "+boardLabelsTable+" WHERE label_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&domain.Label{}, "id = ?", id).Error
+	})
+}
+
+// AddToBoard attaches labels to a board; labels already attached are skipped
+// It joins the transaction carried by ctx, if any
+func (r *labelRepositoryImpl) AddToBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	if len(labelIDs) == 0 {
+		return nil
+	}
+	rows := make([]map[string]interface{}, 0, len(labelIDs))
+	for _, labelID := range labelIDs {
+		rows = append(rows, map[string]interface{}{"board_id": boardID, "label_id": labelID})
+	}
+	return dbFromContext(ctx, r.db).
+		Table(boardLabelsTable).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(rows).Error
+}
+
+// RemoveFromBoard detaches labels from a board; labels not attached are ignored
+// It joins the transaction carried by ctx, if any
+func (r *labelRepositoryImpl) RemoveFromBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	if len(labelIDs) == 0 {
+		return nil
+	}
+	return dbFromContext(ctx, r.db).
+		Exec("DELETE FROM "+boardLabelsTable+" WHERE board_id = ? AND label_id IN ?", boardID, labelIDs).Error
+}
+
+// ReplaceForBoard makes labelIDs the exact label set of a board
+// It joins the transaction carried by ctx, if any
+func (r *labelRepositoryImpl) ReplaceForBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	db := dbFromContext(ctx, r.db)
+	var err error
+	if len(labelIDs) > 0 {
+		err = db.Exec("DELETE FROM "+boardLabelsTable+" WHERE board_id = ? AND label_id NOT IN ?", boardID, labelIDs).Error
+	} else {
+		err = db.Exec("DELETE FROM "+boardLabelsTable+" WHERE board_id = ?", boardID).Error
+	}
+	if err != nil {
+		return err
+	}
+	return r.AddToBoard(ctx, boardID, labelIDs)
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestLabelRepository_BoardLabels(t *testing.T) {
+	db := setupBoardTestDB(t)
+	labelRepo := NewLabelRepository(db)
+	boardRepo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	db.Create(&domain.Project{
+		BaseModel:   domain.BaseModel{ID: projectID},
+		WorkspaceID: uuid.New(),
+		OwnerID:     uuid.New(),
+		Name:        "Test Project",
+	})
+
+	bug := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "bug", Color: "#E5484D"}
+	urgent := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "urgent", Color: "#FFB224"}
+	for _, label := range []*domain.Label{bug, urgent} {
+		if err := labelRepo.Create(ctx, label); err != nil {
+			t.Fatalf("failed to create label: %v", err)
+		}
+	}
+
+	labeled := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Labeled"}
+	plain := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Plain"}
+	db.Create(labeled)
+	db.Create(plain)
+
+	// Adding a label twice keeps a single association
+	if err := labelRepo.AddToBoard(ctx, labeled.ID, []uuid.UUID{bug.ID, urgent.ID}); err != nil {
+		t.Fatalf("AddToBoard failed: %v", err)
+	}
+	if err := labelRepo.AddToBoard(ctx, labeled.ID, []uuid.UUID{bug.ID}); err != nil {
+		t.Fatalf("AddToBoard of an attached label failed: %v", err)
+	}
+
+	board, err := boardRepo.FindByID(ctx, labeled.ID)
+	if err != nil {
+		t.Fatalf("FindByID failed: %v", err)
+	}
+	if len(board.Labels) != 2 {
+		t.Errorf("expected 2 labels, got %d", len(board.Labels))
+	}
+
+	boards, err := boardRepo.ListByProjectID(ctx, projectID, BoardPageQuery{BoardListFilter: BoardListFilter{LabelIDs: []uuid.UUID{urgent.ID}}})
+	if err != nil {
+		t.Fatalf("ListByProjectID failed: %v", err)
+	}
+	if len(boards) != 1 || boards[0].ID != labeled.ID {
+		t.Errorf("expected only the labeled board, got %d boards", len(boards))
+	}
+
+	if err := labelRepo.ReplaceForBoard(ctx, labeled.ID, []uuid.UUID{urgent.ID}); err != nil {
+		t.Fatalf("ReplaceForBoard failed: %v", err)
+	}
+	board, _ = boardRepo.FindByID(ctx, labeled.ID)
+	if len(board.Labels) != 1 || board.Labels[0].ID != urgent.ID {
+		t.Errorf("expected only the urgent label after replace, got %v", board.Labels)
+	}
+
+	if err := labelRepo.RemoveFromBoard(ctx, labeled.ID, []uuid.UUID{urgent.ID}); err != nil {
+		t.Fatalf("RemoveFromBoard failed: %v", err)
+	}
+	boards, _ = boardRepo.ListByProjectID(ctx, projectID, BoardPageQuery{BoardListFilter: BoardListFilter{LabelIDs: []uuid.UUID{bug.ID, urgent.ID}}})
+	if len(boards) != 0 {
+		t.Errorf("expected no labeled boards after removal, got %d", len(boards))
+	}
+}
+
+func TestLabelRepository_Delete_DetachesFromBoards(t *testing.T) {
+	db := setupBoardTestDB(t)
+	labelRepo := NewLabelRepository(db)
+	boardRepo := NewBoardRepository(db)
+	ctx := context.Background()
+
+	projectID := uuid.New()
+	label := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "bug", Color: "#E5484D"}
+	if err := labelRepo.Create(ctx, label); err != nil {
+		t.Fatalf("failed to create label: %v", err)
+	}
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AuthorID: uuid.New(), Title: "Labeled"}
+	db.Create(board)
+	if err := labelRepo.AddToBoard(ctx, board.ID, []uuid.UUID{label.ID}); err != nil {
+		t.Fatalf("AddToBoard failed: %v", err)
+	}
+
+	if err := labelRepo.Delete(ctx, label.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	var associations int64
+	db.Table(boardLabelsTable).Where("label_id = ?", label.ID).Count(&associations)
+	if associations != 0 {
+		t.Errorf("expected the label to be detached, found %d associations", associations)
+	}
+	if _, err := labelRepo.FindByProjectAndName(ctx, projectID, "bug"); err == nil {
+		t.Error("expected the deleted label not to be found")
+	}
+	reloaded, _ := boardRepo.FindByID(ctx, board.ID)
+	if len(reloaded.Labels) != 0 {
+		t.Errorf("expected no labels on the board, got %d", len(reloaded.Labels))
+	}
+}
@@ -3,9 +3,11 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"project-board-api/internal/domain"
 )
@@ -16,6 +18,8 @@ type ParticipantRepository interface {
 	FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Participant, error)
 	FindByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) (*domain.Participant, error)
 	Delete(ctx context.Context, boardID, userID uuid.UUID) error
+	Upsert(ctx context.Context, participant *domain.Participant) error
+	UpdateLastSeen(ctx context.Context, boardID, userID uuid.UUID, seenAt time.Time) error
 }
 
 // participantRepositoryImpl is the GORM implementation of ParticipantRepository
@@ -29,17 +33,19 @@ func NewParticipantRepository(db *gorm.DB) ParticipantRepository {
 }
 
 // Create creates a new participant
+// It joins the transaction carried by ctx, if any
 func (r *participantRepositoryImpl) Create(ctx context.Context, participant *domain.Participant) error {
-	if err := r.db.WithContext(ctx).Create(participant).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(participant).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
 // FindByBoardID finds all participants by board ID
+// It joins the transaction carried by ctx, if any
 func (r *participantRepositoryImpl) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.Participant, error) {
 	var participants []*domain.Participant
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Where("board_id = ?", boardID).
 		Find(&participants).Error; err != nil {
 		return nil, err
@@ -50,7 +56,7 @@ func (r *participantRepositoryImpl) FindByBoardID(ctx context.Context, boardID u
 // FindByBoardAndUser finds a participant by board ID and user ID
 func (r *participantRepositoryImpl) FindByBoardAndUser(ctx context.Context, boardID, userID uuid.UUID) (*domain.Participant, error) {
 	var participant domain.Participant
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		First(&participant).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -62,11 +68,59 @@ func (r *participantRepositoryImpl) FindByBoardAndUser(ctx context.Context, boar
 }
 
 // Delete soft deletes a participant by board ID and user ID
+// It joins the transaction carried by ctx, if any
 func (r *participantRepositoryImpl) Delete(ctx context.Context, boardID, userID uuid.UUID) error {
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
 		Where("board_id = ? AND user_id = ?", boardID, userID).
 		Delete(&domain.Participant{}).Error; err != nil {
 		return err
 	}
 	return nil
 }
+
+// Upsert adds a participant unless the user already participates in the board
+// An existing membership is loaded into participant instead of failing on the unique (board_id, user_id) index
+func (r *participantRepositoryImpl) Upsert(ctx context.Context, participant *domain.Participant) error {
+	if participant.ID == uuid.Nil {
+		participant.ID = uuid.New()
+	}
+
+	result := dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "board_id"}, {Name: "user_id"}},
+			DoNothing: true,
+		}).
+		Create(participant)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+
+	// The user was already a participant; return the existing membership
+	var existing domain.Participant
+	if err := dbFromContext(ctx, r.db).
+		Where("board_id = ? AND user_id = ?", participant.BoardID, participant.UserID).
+		First(&existing).Error; err != nil {
+		return err
+	}
+	*participant = existing
+	return nil
+}
+
+// UpdateLastSeen records when a participant last saw the board
+// It returns gorm.ErrRecordNotFound if the user does not participate in the board
+func (r *participantRepositoryImpl) UpdateLastSeen(ctx context.Context, boardID, userID uuid.UUID, seenAt time.Time) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&domain.Participant{}).
+		Where("board_id = ? AND user_id = ?", boardID, userID).
+		UpdateColumn("last_seen_at", seenAt)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+func TestParticipantRepository_Upsert_ReturnsExistingMembership(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewParticipantRepository(db)
+	ctx := context.Background()
+
+	boardID := uuid.New()
+	existing := &domain.Participant{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		BoardID:   boardID,
+		UserID:    uuid.New(),
+	}
+	if err := db.Create(existing).Error; err != nil {
+		t.Fatalf("failed to create participant: %v", err)
+	}
+
+	// Mix of an existing participant, a new one and a repeat of the new one
+	newUserID := uuid.New()
+	var got []*domain.Participant
+	for _, userID := range []uuid.UUID{existing.UserID, newUserID, newUserID} {
+		participant := &domain.Participant{BoardID: boardID, UserID: userID}
+		if err := repo.Upsert(ctx, participant); err != nil {
+			t.Fatalf("Upsert(%v) error = %v", userID, err)
+		}
+		got = append(got, participant)
+	}
+
+	if got[0].ID != existing.ID {
+		t.Errorf("existing participant ID = %v, want %v", got[0].ID, existing.ID)
+	}
+	if got[1].ID == uuid.Nil || got[1].ID != got[2].ID {
+		t.Errorf("repeated upsert IDs = %v and %v, want the same membership", got[1].ID, got[2].ID)
+	}
+
+	var count int64
+	db.Model(&domain.Participant{}).Where("board_id = ?", boardID).Count(&count)
+	if count != 2 {
+		t.Errorf("participant rows = %d, want 2", count)
+	}
+}
+
+func TestParticipantRepository_Upsert_JoinsTransaction(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewParticipantRepository(db)
+	ctx := context.Background()
+
+	// A rolled back transaction takes the upserted participant with it
+	participant := &domain.Participant{BoardID: uuid.New(), UserID: uuid.New()}
+	err := NewTransactor(db).WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := repo.Upsert(txCtx, participant); err != nil {
+			return err
+		}
+		return errors.New("rollback")
+	})
+	if err == nil || err.Error() != "rollback" {
+		t.Fatalf("WithinTransaction() error = %v, want the rollback error", err)
+	}
+
+	var count int64
+	db.Model(&domain.Participant{}).Where("board_id = ?", participant.BoardID).Count(&count)
+	if count != 0 {
+		t.Errorf("participant rows = %d, want 0 after the rollback", count)
+	}
+}
+
+func TestParticipantRepository_UpdateLastSeen_ClearsUnread(t *testing.T) {
+	db := setupBoardTestDB(t)
+	boardRepo := NewBoardRepository(db)
+	repo := NewParticipantRepository(db)
+	ctx := context.Background()
+
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		AuthorID:  uuid.New(),
+		Title:     "Unread Board",
+	}
+	if err := db.Create(board).Error; err != nil {
+		t.Fatalf("failed to create board: %v", err)
+	}
+	participant := &domain.Participant{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		BoardID:   board.ID,
+		UserID:    uuid.New(),
+	}
+	if err := db.Create(participant).Error; err != nil {
+		t.Fatalf("failed to create participant: %v", err)
+	}
+
+	reload := func() (*domain.Board, *domain.Participant) {
+		t.Helper()
+		got, err := boardRepo.FindByID(ctx, board.ID)
+		if err != nil {
+			t.Fatalf("FindByID() error = %v", err)
+		}
+		if len(got.Participants) != 1 {
+			t.Fatalf("expected 1 participant, got %d", len(got.Participants))
+		}
+		return got, &got.Participants[0]
+	}
+
+	activity := &domain.BoardActivity{
+		BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: time.Now().Add(-time.Minute)},
+		BoardID:   board.ID,
+		ActorID:   board.AuthorID,
+		Field:     "title",
+		NewValue:  "Unread Board",
+	}
+	if err := boardRepo.AddActivities(ctx, []*domain.BoardActivity{activity}); err != nil {
+		t.Fatalf("AddActivities() error = %v", err)
+	}
+
+	got, seen := reload()
+	if got.LastActivityAt == nil {
+		t.Fatal("expected last_activity_at to be set by AddActivities")
+	}
+	if !seen.HasUnread(got.LastActivityAt) {
+		t.Error("expected unread activity before the board is marked seen")
+	}
+
+	if err := repo.UpdateLastSeen(ctx, board.ID, participant.UserID, time.Now()); err != nil {
+		t.Fatalf("UpdateLastSeen() error = %v", err)
+	}
+	got, seen = reload()
+	if seen.HasUnread(got.LastActivityAt) {
+		t.Error("expected no unread activity after the board is marked seen")
+	}
+
+	// Later activity makes the board unread again
+	later := &domain.BoardActivity{
+		BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: time.Now().Add(time.Minute)},
+		BoardID:   board.ID,
+		ActorID:   board.AuthorID,
+		Field:     "content",
+	}
+	if err := boardRepo.AddActivities(ctx, []*domain.BoardActivity{later}); err != nil {
+		t.Fatalf("AddActivities() error = %v", err)
+	}
+	got, seen = reload()
+	if !seen.HasUnread(got.LastActivityAt) {
+		t.Error("expected unread activity after a later change")
+	}
+
+	if err := repo.UpdateLastSeen(ctx, board.ID, uuid.New(), time.Now()); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("UpdateLastSeen() for a non-participant error = %v, want gorm.ErrRecordNotFound", err)
+	}
+}
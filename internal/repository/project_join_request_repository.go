@@ -57,7 +57,7 @@ func (r *projectJoinRequestRepositoryImpl) FindByProjectID(ctx context.Context,
 		query = query.Where("status = ?", *status)
 	}
 
-	if err := query.Order("requested_at DESC").Find(&requests).Error; err != nil {
+	if err := query.Order("requested_at DESC, id DESC").Find(&requests).Error; err != nil {
 		return nil, err
 	}
 	return requests, nil
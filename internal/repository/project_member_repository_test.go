@@ -32,7 +32,8 @@ func setupTestDB(t *testing.T) *gorm.DB {
 		start_date DATETIME,
 		due_date DATETIME,
 		is_default INTEGER DEFAULT 0,
-		is_public INTEGER DEFAULT 0
+		is_public INTEGER DEFAULT 0,
+		enforce_unique_titles INTEGER NOT NULL DEFAULT 0
 	)`)
 
 	db.Exec(`CREATE TABLE project_members (
@@ -3,21 +3,28 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"project-board-api/internal/domain"
 )
 
+// ErrDuplicateBoardTitles is returned when unique titles are enforced on a project whose boards already share a title
+var ErrDuplicateBoardTitles = errors.New("project has boards with duplicate titles")
+
 // ProjectRepository defines the interface for project data access
 type ProjectRepository interface {
 	Create(ctx context.Context, project *domain.Project) error
 	FindByID(ctx context.Context, id uuid.UUID) (*domain.Project, error)
+	LockByID(ctx context.Context, id uuid.UUID) error
 	FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error)
 	FindDefaultByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) (*domain.Project, error)
 	Search(ctx context.Context, workspaceID uuid.UUID, query string, page, limit int) ([]*domain.Project, int64, error)
 	Update(ctx context.Context, project *domain.Project) error
+	SetEnforceUniqueTitles(ctx context.Context, projectID uuid.UUID, enforce bool) error
 	Delete(ctx context.Context, id uuid.UUID) error
 
 	// Member management
@@ -70,6 +77,17 @@ func (r *projectRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*do
 	return &project, nil
 }
 
+// LockByID locks the project row until the surrounding transaction ends
+// Writers that count a project's boards before adding to them take it first, so they run one at a time
+func (r *projectRepositoryImpl) LockByID(ctx context.Context, id uuid.UUID) error {
+	var project domain.Project
+	return dbFromContext(ctx, r.db).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").
+		Where("id = ?", id).
+		First(&project).Error
+}
+
 // FindByWorkspaceID finds all projects by workspace ID
 // ✅ 수정: Preload("Attachments") 제거 - service에서 별도 로드
 func (r *projectRepositoryImpl) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error) {
@@ -100,15 +118,46 @@ func (r *projectRepositoryImpl) FindDefaultByWorkspaceID(ctx context.Context, wo
 
 // Update updates a project
 func (r *projectRepositoryImpl) Update(ctx context.Context, project *domain.Project) error {
-	if err := r.db.WithContext(ctx).Save(project).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Save(project).Error; err != nil {
 		return err
 	}
 	return nil
 }
 
+// SetEnforceUniqueTitles turns the unique board title setting of a project on or off
+// The flag is copied onto the project's boards, where a partial unique index enforces it
+// Turning it on fails with ErrDuplicateBoardTitles if active boards already share a title
+func (r *projectRepositoryImpl) SetEnforceUniqueTitles(ctx context.Context, projectID uuid.UUID, enforce bool) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if enforce {
+			var duplicates []string
+			if err := tx.Model(&domain.Board{}).
+				Where("project_id = ? AND deleted_at IS NULL", projectID).
+				Group("title").
+				Having("COUNT(*) > 1").
+				Limit(1).
+				Pluck("title", &duplicates).Error; err != nil {
+				return err
+			}
+			if len(duplicates) > 0 {
+				return fmt.Errorf("%w: %q", ErrDuplicateBoardTitles, duplicates[0])
+			}
+		}
+
+		if err := tx.Model(&domain.Project{}).
+			Where("id = ?", projectID).
+			Update("enforce_unique_titles", enforce).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.Board{}).
+			Where("project_id = ? AND deleted_at IS NULL", projectID).
+			Update("title_unique", enforce).Error
+	})
+}
+
 // Delete soft deletes a project
 func (r *projectRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
-	if err := r.db.WithContext(ctx).Delete(&domain.Project{}, id).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Delete(&domain.Project{}, id).Error; err != nil {
 		return err
 	}
 	return nil
@@ -132,8 +181,9 @@ func (r *projectRepositoryImpl) Search(ctx context.Context, workspaceID uuid.UUI
 	}
 
 	// Get paginated results
+	// id breaks ties between equal created_at values so pages never overlap or skip rows
 	offset := (page - 1) * limit
-	if err := db.Offset(offset).Limit(limit).Find(&projects).Error; err != nil {
+	if err := db.Order("created_at DESC, id DESC").Offset(offset).Limit(limit).Find(&projects).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -324,3 +325,43 @@ func TestProjectRepository_UpdateJoinRequestStatus(t *testing.T) {
 		t.Errorf("expected status APPROVED, got %s", updated.Status)
 	}
 }
+
+func TestProjectRepository_Search_EqualTimestampsPaginateStably(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewProjectRepository(db)
+	ctx := context.Background()
+
+	workspaceID := uuid.New()
+	createdAt := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+
+	const projectCount = 7
+	for i := 0; i < projectCount; i++ {
+		project := &domain.Project{
+			BaseModel:   domain.BaseModel{ID: uuid.New(), CreatedAt: createdAt, UpdatedAt: createdAt},
+			WorkspaceID: workspaceID,
+			OwnerID:     uuid.New(),
+			Name:        "Project",
+		}
+		if err := db.Create(project).Error; err != nil {
+			t.Fatalf("failed to create project: %v", err)
+		}
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	for page := 1; page <= 4; page++ {
+		projects, _, err := repo.Search(ctx, workspaceID, "", page, 2)
+		if err != nil {
+			t.Fatalf("Search() page %d error = %v", page, err)
+		}
+		for _, p := range projects {
+			if seen[p.ID] {
+				t.Errorf("project %s returned on more than one page", p.ID)
+			}
+			seen[p.ID] = true
+		}
+	}
+
+	if len(seen) != projectCount {
+		t.Errorf("expected %d distinct projects across pages, got %d", projectCount, len(seen))
+	}
+}
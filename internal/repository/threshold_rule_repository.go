@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"project-board-api/internal/domain"
+)
+
+// ThresholdRuleRepository defines the interface for threshold rule data access
+type ThresholdRuleRepository interface {
+	Create(ctx context.Context, rule *domain.ThresholdRule) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.ThresholdRule, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ThresholdRule, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindTriggeredRuleIDs(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID]bool, error)
+	MarkTriggered(ctx context.Context, ruleID, boardID uuid.UUID, at time.Time) (bool, error)
+	ClearTriggered(ctx context.Context, ruleID, boardID uuid.UUID) error
+}
+
+// thresholdRuleRepositoryImpl is the GORM implementation of ThresholdRuleRepository
+type thresholdRuleRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewThresholdRuleRepository creates a new instance of ThresholdRuleRepository
+func NewThresholdRuleRepository(db *gorm.DB) ThresholdRuleRepository {
+	return &thresholdRuleRepositoryImpl{db: db}
+}
+
+// Create creates a new threshold rule
+func (r *thresholdRuleRepositoryImpl) Create(ctx context.Context, rule *domain.ThresholdRule) error {
+	if err := r.db.WithContext(ctx).Create(rule).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindByID finds a threshold rule by ID
+func (r *thresholdRuleRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.ThresholdRule, error) {
+	var rule domain.ThresholdRule
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&rule).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// FindByProjectID finds all threshold rules of a project, ordered by creation time
+func (r *thresholdRuleRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ThresholdRule, error) {
+	var rules []*domain.ThresholdRule
+	if err := dbFromContext(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC, id ASC").
+		Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Delete permanently removes a threshold rule; its triggers go with it through their foreign key
+func (r *thresholdRuleRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&domain.ThresholdRule{}, id).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindTriggeredRuleIDs returns the rules whose condition currently holds for a board
+// It joins the transaction carried by ctx, if any
+func (r *thresholdRuleRepositoryImpl) FindTriggeredRuleIDs(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID]bool, error) {
+	var ruleIDs []uuid.UUID
+	if err := dbFromContext(ctx, r.db).
+		Model(&domain.ThresholdRuleTrigger{}).
+		Where("board_id = ?", boardID).
+		Pluck("rule_id", &ruleIDs).Error; err != nil {
+		return nil, err
+	}
+	triggered := make(map[uuid.UUID]bool, len(ruleIDs))
+	for _, ruleID := range ruleIDs {
+		triggered[ruleID] = true
+	}
+	return triggered, nil
+}
+
+// MarkTriggered records that a rule's condition holds for a board
+// It reports whether the mark is new; of two concurrent updates crossing the threshold only one gets true
+// It joins the transaction carried by ctx, if any
+func (r *thresholdRuleRepositoryImpl) MarkTriggered(ctx context.Context, ruleID, boardID uuid.UUID, at time.Time) (bool, error) {
+	result := dbFromContext(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.ThresholdRuleTrigger{RuleID: ruleID, BoardID: boardID, TriggeredAt: at.UTC()})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// ClearTriggered removes the mark of a rule whose condition no longer holds for a board
+// It joins the transaction carried by ctx, if any
+func (r *thresholdRuleRepositoryImpl) ClearTriggered(ctx context.Context, ruleID, boardID uuid.UUID) error {
+	return dbFromContext(ctx, r.db).
+		Where("rule_id = ? AND board_id = ?", ruleID, boardID).
+		Delete(&domain.ThresholdRuleTrigger{}).Error
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func TestThresholdRuleRepository_Triggers(t *testing.T) {
+	db := setupBoardTestDB(t)
+	repo := NewThresholdRuleRepository(db)
+	ctx := context.Background()
+
+	recipient := uuid.New()
+	rule := &domain.ThresholdRule{
+		BaseModel:  domain.BaseModel{ID: uuid.New()},
+		ProjectID:  uuid.New(),
+		Field:      domain.ThresholdFieldActualHours,
+		Operator:   domain.ThresholdOperatorGreater,
+		Threshold:  40,
+		Recipients: []uuid.UUID{recipient},
+		CreatedBy:  uuid.New(),
+	}
+	if err := repo.Create(ctx, rule); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	rules, err := repo.FindByProjectID(ctx, rule.ProjectID)
+	if err != nil {
+		t.Fatalf("FindByProjectID() error = %v", err)
+	}
+	if len(rules) != 1 || len(rules[0].Recipients) != 1 || rules[0].Recipients[0] != recipient {
+		t.Fatalf("FindByProjectID() = %+v, want the rule with its recipient", rules)
+	}
+
+	// Only the first mark of a board is new
+	boardID := uuid.New()
+	for i, want := range []bool{true, false} {
+		created, err := repo.MarkTriggered(ctx, rule.ID, boardID, time.Now())
+		if err != nil {
+			t.Fatalf("MarkTriggered() error = %v", err)
+		}
+		if created != want {
+			t.Errorf("MarkTriggered() call %d = %v, want %v", i+1, created, want)
+		}
+	}
+	triggered, err := repo.FindTriggeredRuleIDs(ctx, boardID)
+	if err != nil || !triggered[rule.ID] {
+		t.Fatalf("FindTriggeredRuleIDs() = %v (error %v), want the marked rule", triggered, err)
+	}
+
+	// Once cleared, the board can trigger the rule again
+	if err := repo.ClearTriggered(ctx, rule.ID, boardID); err != nil {
+		t.Fatalf("ClearTriggered() error = %v", err)
+	}
+	if triggered, _ := repo.FindTriggeredRuleIDs(ctx, boardID); len(triggered) != 0 {
+		t.Errorf("FindTriggeredRuleIDs() after clear = %v, want none", triggered)
+	}
+	if created, _ := repo.MarkTriggered(ctx, rule.ID, boardID, time.Now()); !created {
+		t.Error("MarkTriggered() after clear = false, want true")
+	}
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txContextKey carries the active transaction through a request context
+type txContextKey struct{}
+
+// Transactor runs a unit of work inside a database transaction
+// Repository calls made with the context passed to fn join the transaction
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error
+}
+
+// gormTransactor is the GORM implementation of Transactor
+type gormTransactor struct {
+	db *gorm.DB
+}
+
+// NewTransactor creates a new Transactor backed by db
+func NewTransactor(db *gorm.DB) Transactor {
+	return &gormTransactor{db: db}
+}
+
+// WithinTransaction commits when fn returns nil and rolls back otherwise
+func (t *gormTransactor) WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	// Join an outer transaction instead of opening a nested one
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// dbFromContext returns the transaction bound to ctx, or db scoped to ctx when there is none
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+)
+
+func createTempBoardAttachment(t *testing.T, repo AttachmentRepository) *domain.Attachment {
+	expiresAt := time.Now().Add(time.Hour)
+	attachment := &domain.Attachment{
+		BaseModel:   domain.BaseModel{ID: uuid.New()},
+		EntityType:  domain.EntityTypeBoard,
+		Status:      domain.AttachmentStatusTemp,
+		FileName:    "file.jpg",
+		FileURL:     "boards/file.jpg",
+		FileSize:    1024,
+		ContentType: "image/jpeg",
+		UploadedBy:  uuid.New(),
+		ExpiresAt:   &expiresAt,
+	}
+	if err := repo.Create(context.Background(), attachment); err != nil {
+		t.Fatalf("failed to create attachment: %v", err)
+	}
+	return attachment
+}
+
+func TestTransactor_CreateBoardWithAttachments(t *testing.T) {
+	db := setupBoardTestDB(t)
+	boardRepo := NewBoardRepository(db)
+	attachmentRepo := NewAttachmentRepository(db)
+	transactor := NewTransactor(db)
+	ctx := context.Background()
+
+	createBoard := func(attachmentIDs []uuid.UUID) (*domain.Board, error) {
+		board := &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New()},
+			ProjectID: uuid.New(),
+			AuthorID:  uuid.New(),
+			Title:     "Board",
+		}
+		err := transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+			if err := boardRepo.Create(txCtx, board); err != nil {
+				return err
+			}
+			return attachmentRepo.ConfirmAttachments(txCtx, attachmentIDs, board.ID)
+		})
+		return board, err
+	}
+
+	t.Run("valid pending attachments are confirmed with the board", func(t *testing.T) {
+		attachment := createTempBoardAttachment(t, attachmentRepo)
+
+		board, err := createBoard([]uuid.UUID{attachment.ID})
+		if err != nil {
+			t.Fatalf("WithinTransaction() error = %v", err)
+		}
+
+		var boardCount int64
+		db.Model(&domain.Board{}).Where("id = ?", board.ID).Count(&boardCount)
+		if boardCount != 1 {
+			t.Errorf("expected board to be committed")
+		}
+
+		confirmed, _ := attachmentRepo.FindByID(ctx, attachment.ID)
+		if confirmed.Status != domain.AttachmentStatusConfirmed || confirmed.EntityID == nil || *confirmed.EntityID != board.ID {
+			t.Errorf("attachment = %+v, want confirmed to board %s", confirmed, board.ID)
+		}
+	})
+
+	t.Run("a bad attachment rolls back the whole creation", func(t *testing.T) {
+		valid := createTempBoardAttachment(t, attachmentRepo)
+
+		// The valid attachment is confirmed before the missing one fails the batch
+		board, err := createBoard([]uuid.UUID{valid.ID, uuid.New()})
+		if err == nil {
+			t.Fatal("WithinTransaction() expected error for missing attachment")
+		}
+
+		var boardCount int64
+		db.Model(&domain.Board{}).Where("id = ?", board.ID).Count(&boardCount)
+		if boardCount != 0 {
+			t.Errorf("board was persisted despite attachment confirmation failure")
+		}
+
+		stillTemp, _ := attachmentRepo.FindByID(ctx, valid.ID)
+		if stillTemp.Status != domain.AttachmentStatusTemp || stillTemp.EntityID != nil {
+			t.Errorf("attachment = %+v, want untouched TEMP attachment", stillTemp)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+)
+
+// WebhookSubscriptionRepository defines the interface for webhook subscription data access
+type WebhookSubscriptionRepository interface {
+	Create(ctx context.Context, subscription *domain.WebhookSubscription) error
+	FindByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)
+	FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// webhookSubscriptionRepositoryImpl is the GORM implementation of WebhookSubscriptionRepository
+type webhookSubscriptionRepositoryImpl struct {
+	db *gorm.DB
+}
+
+// NewWebhookSubscriptionRepository creates a new instance of WebhookSubscriptionRepository
+func NewWebhookSubscriptionRepository(db *gorm.DB) WebhookSubscriptionRepository {
+	return &webhookSubscriptionRepositoryImpl{db: db}
+}
+
+// Create creates a new webhook subscription
+func (r *webhookSubscriptionRepositoryImpl) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	if err := r.db.WithContext(ctx).Create(subscription).Error; err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindByID finds a webhook subscription by ID
+func (r *webhookSubscriptionRepositoryImpl) FindByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	var subscription domain.WebhookSubscription
+	if err := r.db.WithContext(ctx).
+		Where("id = ?", id).
+		First(&subscription).Error; err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// FindByProjectID finds all webhook subscriptions of a project, ordered by creation time
+func (r *webhookSubscriptionRepositoryImpl) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	var subscriptions []*domain.WebhookSubscription
+	if err := r.db.WithContext(ctx).
+		Where("project_id = ?", projectID).
+		Order("created_at ASC, id ASC").
+		Find(&subscriptions).Error; err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+// Delete deletes a webhook subscription by ID
+func (r *webhookSubscriptionRepositoryImpl) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.db.WithContext(ctx).Delete(&domain.WebhookSubscription{}, id).Error; err != nil {
+		return err
+	}
+	return nil
+}
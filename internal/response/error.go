@@ -9,6 +9,9 @@ const (
 	ErrCodeInternal      = "INTERNAL_ERROR"
 	ErrCodeUnauthorized  = "UNAUTHORIZED"
 	ErrCodeForbidden     = "FORBIDDEN"
+	ErrCodeQuotaExceeded = "QUOTA_EXCEEDED"
+	ErrCodeTooLarge      = "PAYLOAD_TOO_LARGE"
+	ErrCodeConflict      = "CONFLICT"
 )
 
 // AppError represents a custom application error
@@ -16,6 +19,8 @@ type AppError struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
 	Details string `json:"details,omitempty"`
+	// Fields maps each invalid input field to its message, for validation errors that cover several fields
+	Fields map[string]string `json:"fields,omitempty"`
 }
 
 // Error implements the error interface
@@ -50,6 +55,15 @@ func NewValidationError(message string, details string) *AppError {
 	}
 }
 
+// NewFieldValidationError creates a new validation error carrying a message per invalid field
+func NewFieldValidationError(message string, fields map[string]string) *AppError {
+	return &AppError{
+		Code:    ErrCodeValidation,
+		Message: message,
+		Fields:  fields,
+	}
+}
+
 // NewInternalError creates a new internal error
 func NewInternalError(message string, details string) *AppError {
 	return &AppError{
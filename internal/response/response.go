@@ -77,3 +77,17 @@ func SendErrorWithDetails(c *gin.Context, statusCode int, code string, message s
 		RequestID: getRequestID(c),
 	})
 }
+
+// SendFieldErrors sends an error response with a message per invalid field
+func SendFieldErrors(c *gin.Context, statusCode int, code string, message string, fields map[string]string) {
+	errorData := map[string]interface{}{
+		"code":    code,
+		"message": message,
+		"fields":  fields,
+	}
+
+	c.JSON(statusCode, ErrorResponse{
+		Error:     errorData,
+		RequestID: getRequestID(c),
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"project-board-api/internal/converter"
 	"project-board-api/internal/database"
 	"project-board-api/internal/handler"
+	"project-board-api/internal/job"
 	"project-board-api/internal/metrics"
 	"project-board-api/internal/middleware"
 	"project-board-api/internal/repository"
@@ -31,6 +32,22 @@ type Config struct {
 	UserServiceBaseURL string
 	Metrics            *metrics.Metrics
 	S3Client           *client.S3Client
+	// MaxBoardsPerProject limits active boards per project (0 = unlimited)
+	MaxBoardsPerProject int
+	// MaxCustomFieldsBytes limits the serialized size of board custom fields (0 = default)
+	MaxCustomFieldsBytes int
+	// BulkUpdateInterval is the minimum delay between bulk board update items (0 = no throttling)
+	BulkUpdateInterval time.Duration
+	// StrictDecoding rejects unknown JSON fields in board create/update requests
+	StrictDecoding bool
+	// AllowMultiplePinnedComments allows several pinned comments per board
+	AllowMultiplePinnedComments bool
+	// ArchiveReasons restricts board archive reasons (empty = any reason is accepted)
+	ArchiveReasons []string
+	// DownloadCounter batches attachment download counts (nil = downloads are not counted)
+	DownloadCounter *job.DownloadCounter
+	// WebhookDispatcher delivers board events to webhook subscribers (nil = no webhooks are sent)
+	WebhookDispatcher *job.WebhookDispatcher
 }
 
 // Setup initializes the router with all dependencies and routes.
@@ -59,28 +76,74 @@ func Setup(cfg Config) *gin.Engine {
 	commentRepo := repository.NewCommentRepository(cfg.DB)
 	fieldOptionRepo := repository.NewFieldOptionRepository(cfg.DB)
 	attachmentRepo := repository.NewAttachmentRepository(cfg.DB)
+	annotationRepo := repository.NewAttachmentAnnotationRepository(cfg.DB)
+	attachmentDeleteJobRepo := repository.NewAttachmentDeleteJobRepository(cfg.DB)
+	webhookRepo := repository.NewWebhookSubscriptionRepository(cfg.DB)
+	labelRepo := repository.NewLabelRepository(cfg.DB)
+	checklistRepo := repository.NewChecklistItemRepository(cfg.DB)
+	boardTemplateRepo := repository.NewBoardTemplateRepository(cfg.DB)
+	thresholdRuleRepo := repository.NewThresholdRuleRepository(cfg.DB)
 
 	// Initialize converters
 	fieldOptionConverter := converter.NewFieldOptionConverter(fieldOptionRepo)
 
 	// Initialize services with repository dependencies
-	projectService := service.NewProjectService(projectRepo, fieldOptionRepo, attachmentRepo, cfg.S3Client, cfg.UserClient, cfg.Metrics, cfg.Logger)
-	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, cfg.S3Client, fieldOptionConverter, cfg.Metrics, cfg.Logger)
+	projectService := service.NewProjectService(projectRepo, fieldOptionRepo, attachmentRepo, cfg.S3Client, cfg.UserClient, cfg.Metrics, cfg.Logger,
+		service.WithProjectAttachmentDeleteQueue(attachmentDeleteJobRepo),
+		service.WithProjectTransactor(repository.NewTransactor(cfg.DB)),
+	)
+	boardOptions := []service.BoardServiceOption{
+		service.WithMaxBoardsPerProject(cfg.MaxBoardsPerProject),
+		service.WithMaxCustomFieldsBytes(cfg.MaxCustomFieldsBytes),
+		service.WithBulkUpdateInterval(cfg.BulkUpdateInterval),
+		service.WithArchiveReasons(cfg.ArchiveReasons),
+		service.WithTransactor(repository.NewTransactor(cfg.DB)),
+		service.WithAttachmentDeleteQueue(attachmentDeleteJobRepo),
+		service.WithLabelRepository(labelRepo),
+		service.WithBoardTemplateRepository(boardTemplateRepo),
+		service.WithUserClient(cfg.UserClient),
+		service.WithThresholdRules(thresholdRuleRepo),
+	}
+	if cfg.WebhookDispatcher != nil {
+		boardOptions = append(boardOptions, service.WithWebhookPublisher(cfg.WebhookDispatcher))
+	}
+	boardService := service.NewBoardService(boardRepo, projectRepo, fieldOptionRepo, participantRepo, attachmentRepo, cfg.S3Client, fieldOptionConverter, cfg.Metrics, cfg.Logger, boardOptions...)
 	participantService := service.NewParticipantService(participantRepo, boardRepo)
-	commentService := service.NewCommentService(commentRepo, boardRepo, attachmentRepo, cfg.S3Client, cfg.Logger)
+	commentService := service.NewCommentService(commentRepo, boardRepo, attachmentRepo, cfg.S3Client, cfg.Logger,
+		service.WithMultiplePinnedComments(cfg.AllowMultiplePinnedComments),
+		service.WithCommentTransactor(repository.NewTransactor(cfg.DB)),
+		service.WithCommentAttachmentDeleteQueue(attachmentDeleteJobRepo),
+	)
 	fieldOptionService := service.NewFieldOptionService(fieldOptionRepo)
 	projectMemberService := service.NewProjectMemberService(projectRepo, cfg.UserClient)
 	projectJoinRequestService := service.NewProjectJoinRequestService(projectRepo, cfg.UserClient)
+	annotationService := service.NewAnnotationService(annotationRepo, attachmentRepo)
+	webhookService := service.NewWebhookService(webhookRepo, projectRepo)
+	labelService := service.NewLabelService(labelRepo, projectRepo, boardRepo)
+	checklistService := service.NewChecklistService(checklistRepo, boardRepo)
+	thresholdRuleService := service.NewThresholdRuleService(thresholdRuleRepo, projectRepo)
 
 	// Initialize handlers with service dependencies
 	projectHandler := handler.NewProjectHandler(projectService)
-	boardHandler := handler.NewBoardHandler(boardService)
+	boardHandler := handler.NewBoardHandler(boardService, handler.WithStrictDecoding(cfg.StrictDecoding))
 	participantHandler := handler.NewParticipantHandler(participantService)
 	commentHandler := handler.NewCommentHandler(commentService)
 	fieldOptionHandler := handler.NewFieldOptionHandler(fieldOptionService)
 	projectMemberHandler := handler.NewProjectMemberHandler(projectMemberService)
 	projectJoinRequestHandler := handler.NewProjectJoinRequestHandler(projectJoinRequestService)
-	attachmentHandler := handler.NewAttachmentHandler(cfg.S3Client, attachmentRepo)
+	var downloads handler.DownloadRecorder
+	if cfg.DownloadCounter != nil {
+		downloads = cfg.DownloadCounter
+	}
+	attachmentHandler := handler.NewAttachmentHandler(cfg.S3Client, attachmentRepo, annotationRepo, downloads,
+		handler.WithAttachmentAccess(boardRepo, projectRepo),
+		handler.WithAttachmentDeleteJobs(attachmentDeleteJobRepo),
+	)
+	annotationHandler := handler.NewAnnotationHandler(annotationService)
+	webhookHandler := handler.NewWebhookHandler(webhookService)
+	labelHandler := handler.NewLabelHandler(labelService)
+	checklistHandler := handler.NewChecklistHandler(checklistService)
+	thresholdRuleHandler := handler.NewThresholdRuleHandler(thresholdRuleService)
 
 	// 💡 WebSocket Handler 초기화
 	wsHandler := handler.NewWSHandler(cfg.Logger, cfg.UserClient)
@@ -121,7 +184,7 @@ func Setup(cfg Config) *gin.Engine {
 	}
 
 	// Setup API routes
-	setupRoutes(baseGroup, cfg.JWTSecret, projectHandler, boardHandler, participantHandler, commentHandler, fieldOptionHandler, projectMemberHandler, projectJoinRequestHandler, attachmentHandler)
+	setupRoutes(baseGroup, cfg.JWTSecret, projectHandler, boardHandler, participantHandler, commentHandler, fieldOptionHandler, projectMemberHandler, projectJoinRequestHandler, attachmentHandler, annotationHandler, webhookHandler, labelHandler, checklistHandler, thresholdRuleHandler)
 
 	// 🔥 [중요] WebSocket은 baseGroup을 사용하되 인증 미들웨어 없이 직접 등록
 	// basePath가 /api/boards일 때: /api/boards/api/ws/project/:projectId
@@ -213,6 +276,11 @@ func setupRoutes(
 	projectMemberHandler *handler.ProjectMemberHandler,
 	projectJoinRequestHandler *handler.ProjectJoinRequestHandler,
 	attachmentHandler *handler.AttachmentHandler,
+	annotationHandler *handler.AnnotationHandler,
+	webhookHandler *handler.WebhookHandler,
+	labelHandler *handler.LabelHandler,
+	checklistHandler *handler.ChecklistHandler,
+	thresholdRuleHandler *handler.ThresholdRuleHandler,
 ) {
 	// API group with authentication
 	api := baseGroup.Group("/api")
@@ -246,6 +314,16 @@ func setupRoutes(
 
 			// Attachment routes for projects
 			projects.GET("/:projectId/attachments", attachmentHandler.GetProjectAttachments)
+
+			// Webhook subscriptions for board events
+			projects.POST("/:projectId/webhooks", webhookHandler.CreateWebhook)
+			projects.GET("/:projectId/webhooks", webhookHandler.ListWebhooks)
+			projects.DELETE("/:projectId/webhooks/:webhookId", webhookHandler.DeleteWebhook)
+
+			// Threshold alert rules for board fields
+			projects.POST("/:projectId/threshold-rules", thresholdRuleHandler.CreateThresholdRule)
+			projects.GET("/:projectId/threshold-rules", thresholdRuleHandler.ListThresholdRules)
+			projects.DELETE("/:projectId/threshold-rules/:ruleId", thresholdRuleHandler.DeleteThresholdRule)
 		}
 
 		// Join request routes (not nested under project)
@@ -262,14 +340,42 @@ func setupRoutes(
 			boards.GET("", boardHandler.GetBoardsByProjectQuery)
 
 			boards.POST("", boardHandler.CreateBoard)
+			boards.POST("/bulk-create", boardHandler.BulkCreateBoards)
+			boards.POST("/bulk-update", boardHandler.BulkUpdateBoards)
+			boards.POST("/batch-update", boardHandler.BatchUpdateBoards)
+			boards.POST("/bulk-move", boardHandler.BulkMoveBoards)
+			boards.POST("/import", boardHandler.ImportBoards)
 			boards.GET("/:boardId", boardHandler.GetBoard)
 			boards.GET("/project/:projectId", boardHandler.GetBoardsByProject)
+			boards.GET("/project/:projectId/count", boardHandler.CountBoards)
+			boards.GET("/project/:projectId/search", boardHandler.SearchBoards)
+			boards.GET("/project/:projectId/page", boardHandler.ListBoards)
+			boards.GET("/project/:projectId/export", boardHandler.ExportProjectBoards)
+			boards.GET("/project/:projectId/backup", boardHandler.ExportProjectBackup)
+			boards.POST("/project/:projectId/backup", boardHandler.ImportProjectBackup)
+			boards.GET("/project/:projectId/effort", boardHandler.GetProjectEffort)
+			boards.GET("/project/:projectId/templates", boardHandler.GetBoardTemplates)
+			boards.GET("/project/:projectId/custom-fields/:fieldKey/aggregate", boardHandler.AggregateCustomField)
+			boards.GET("/project/:projectId/orphaned-assignees", boardHandler.GetOrphanedAssignees)
+			boards.POST("/project/:projectId/orphaned-assignees/clean", boardHandler.CleanOrphanedAssignees)
 			boards.PUT("/:boardId", boardHandler.UpdateBoard)
+			boards.PATCH("/:boardId", boardHandler.PatchBoard)
 			boards.DELETE("/:boardId", boardHandler.DeleteBoard)
 			boards.PUT("/:boardId/move", boardHandler.MoveBoard) // ✅ 이 라인 추가
+			boards.POST("/:boardId/move-project", boardHandler.MoveBoardToProject)
+			boards.POST("/:boardId/clone", boardHandler.CloneBoard)
+			boards.POST("/:boardId/template", boardHandler.SaveBoardAsTemplate)
+			boards.POST("/templates/:templateId/boards", boardHandler.CreateBoardFromTemplate)
+			boards.POST("/:boardId/touch", boardHandler.TouchBoard)
+			boards.POST("/:boardId/archive", boardHandler.ArchiveBoard)
+			boards.POST("/:boardId/restore", boardHandler.RestoreBoard)
+			boards.GET("/:boardId/activity", boardHandler.GetBoardActivity)
+			boards.GET("/:boardId/state", boardHandler.GetBoardAtTime)
+			boards.GET("/:boardId/export", boardHandler.ExportBoard)
 
 			// Attachment routes for boards
 			boards.GET("/:boardId/attachments", attachmentHandler.GetBoardAttachments)
+			boards.GET("/:boardId/attachments/page", boardHandler.ListBoardAttachments)
 		}
 
 		// Participant routes
@@ -278,6 +384,27 @@ func setupRoutes(
 			participants.POST("", participantHandler.AddParticipants)
 			participants.GET("/board/:boardId", participantHandler.GetParticipants)
 			participants.DELETE("/board/:boardId/user/:userId", participantHandler.RemoveParticipant)
+			participants.POST("/board/:boardId/sync-assignees", participantHandler.SyncParticipantsFromAssignees)
+			participants.POST("/board/:boardId/seen", participantHandler.MarkBoardSeen)
+		}
+
+		// Label routes
+		labels := api.Group("/labels")
+		{
+			labels.POST("", labelHandler.CreateLabel)
+			labels.GET("/project/:projectId", labelHandler.GetLabels)
+			labels.DELETE("/:labelId", labelHandler.DeleteLabel)
+			labels.POST("/board/:boardId", labelHandler.AddBoardLabels)
+			labels.DELETE("/board/:boardId", labelHandler.RemoveBoardLabels)
+		}
+
+		// Checklist routes
+		checklist := api.Group("/checklist")
+		{
+			checklist.POST("/board/:boardId", checklistHandler.AddChecklistItem)
+			checklist.PUT("/board/:boardId/order", checklistHandler.ReorderChecklistItems)
+			checklist.PUT("/:itemId", checklistHandler.UpdateChecklistItem)
+			checklist.DELETE("/:itemId", checklistHandler.DeleteChecklistItem)
 		}
 
 		// Comment routes
@@ -290,6 +417,9 @@ func setupRoutes(
 			comments.GET("/board/:boardId", commentHandler.GetComments)
 			comments.PUT("/:commentId", commentHandler.UpdateComment)
 			comments.DELETE("/:commentId", commentHandler.DeleteComment)
+			comments.POST("/:commentId/pin", commentHandler.PinComment)
+			comments.DELETE("/:commentId/pin", commentHandler.UnpinComment)
+			comments.GET("/:commentId/history", commentHandler.GetCommentHistory)
 
 			// Attachment routes for comments
 			comments.GET("/:commentId/attachments", attachmentHandler.GetCommentAttachments)
@@ -313,6 +443,14 @@ func setupRoutes(
 			attachments.POST("", attachmentHandler.SaveAttachmentMetadata)
 			// Delete attachment
 			attachments.DELETE("/:attachmentId", attachmentHandler.DeleteAttachment)
+			// Issue a download URL and count the download
+			attachments.GET("/:attachmentId/download-url", attachmentHandler.GetDownloadURL)
+			// Positional annotations on image attachments
+			attachments.POST("/:attachmentId/annotations", annotationHandler.AddAnnotation)
+			attachments.GET("/:attachmentId/annotations", annotationHandler.ListAnnotations)
+			attachments.DELETE("/:attachmentId/annotations/:annotationId", annotationHandler.DeleteAnnotation)
+			// Queued deletions the background worker gave up on
+			attachments.GET("/delete-jobs/failed", attachmentHandler.ListFailedDeleteJobs)
 		}
 	}
 }
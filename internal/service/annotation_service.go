@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// AnnotationService defines the interface for attachment annotation business logic
+type AnnotationService interface {
+	AddAnnotation(ctx context.Context, attachmentID, authorID uuid.UUID, req *dto.CreateAnnotationRequest) (*dto.AnnotationResponse, error)
+	ListAnnotations(ctx context.Context, attachmentID uuid.UUID) ([]*dto.AnnotationResponse, error)
+	DeleteAnnotation(ctx context.Context, attachmentID, annotationID, userID uuid.UUID) error
+}
+
+// annotationServiceImpl is the implementation of AnnotationService
+type annotationServiceImpl struct {
+	annotationRepo repository.AttachmentAnnotationRepository
+	attachmentRepo repository.AttachmentRepository
+}
+
+// NewAnnotationService creates a new instance of AnnotationService
+func NewAnnotationService(annotationRepo repository.AttachmentAnnotationRepository, attachmentRepo repository.AttachmentRepository) AnnotationService {
+	return &annotationServiceImpl{
+		annotationRepo: annotationRepo,
+		attachmentRepo: attachmentRepo,
+	}
+}
+
+// AddAnnotation adds a positional annotation to an image attachment
+func (s *annotationServiceImpl) AddAnnotation(ctx context.Context, attachmentID, authorID uuid.UUID, req *dto.CreateAnnotationRequest) (*dto.AnnotationResponse, error) {
+	if req.X == nil || req.Y == nil {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Annotation coordinates are required", "")
+	}
+	if !isNormalizedCoordinate(*req.X) || !isNormalizedCoordinate(*req.Y) {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Annotation coordinates must be between 0 and 1", "")
+	}
+
+	attachment, err := s.attachmentRepo.FindByID(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Attachment not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachment", err.Error())
+	}
+	if !strings.HasPrefix(attachment.ContentType, "image/") {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Annotations are only supported on image attachments", "")
+	}
+
+	annotation := &domain.AttachmentAnnotation{
+		AttachmentID: attachmentID,
+		AuthorID:     authorID,
+		X:            *req.X,
+		Y:            *req.Y,
+		Content:      req.Content,
+	}
+	if err := s.annotationRepo.Create(ctx, annotation); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create annotation", err.Error())
+	}
+
+	return toAnnotationResponse(annotation), nil
+}
+
+// ListAnnotations retrieves all annotations of an attachment
+func (s *annotationServiceImpl) ListAnnotations(ctx context.Context, attachmentID uuid.UUID) ([]*dto.AnnotationResponse, error) {
+	if _, err := s.attachmentRepo.FindByID(ctx, attachmentID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Attachment not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachment", err.Error())
+	}
+
+	annotations, err := s.annotationRepo.FindByAttachmentID(ctx, attachmentID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch annotations", err.Error())
+	}
+
+	responses := make([]*dto.AnnotationResponse, len(annotations))
+	for i, annotation := range annotations {
+		responses[i] = toAnnotationResponse(annotation)
+	}
+	return responses, nil
+}
+
+// DeleteAnnotation deletes an annotation of the attachment (only its author may delete it)
+func (s *annotationServiceImpl) DeleteAnnotation(ctx context.Context, attachmentID, annotationID, userID uuid.UUID) error {
+	annotation, err := s.annotationRepo.FindByID(ctx, annotationID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Annotation not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch annotation", err.Error())
+	}
+	// An annotation is only reachable through the attachment it belongs to
+	if annotation.AttachmentID != attachmentID {
+		return response.NewAppError(response.ErrCodeNotFound, "Annotation not found", "")
+	}
+	if annotation.AuthorID != userID {
+		return response.NewAppError(response.ErrCodeForbidden, "Only the author can delete this annotation", "")
+	}
+
+	if err := s.annotationRepo.Delete(ctx, annotationID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete annotation", err.Error())
+	}
+	return nil
+}
+
+// isNormalizedCoordinate checks that a coordinate lies within the 0..1 normalized bounds
+func isNormalizedCoordinate(v float64) bool {
+	return v >= 0 && v <= 1
+}
+
+// toAnnotationResponse converts domain.AttachmentAnnotation to dto.AnnotationResponse
+func toAnnotationResponse(annotation *domain.AttachmentAnnotation) *dto.AnnotationResponse {
+	return &dto.AnnotationResponse{
+		ID:           annotation.ID,
+		AttachmentID: annotation.AttachmentID,
+		AuthorID:     annotation.AuthorID,
+		X:            annotation.X,
+		Y:            annotation.Y,
+		Content:      annotation.Content,
+		CreatedAt:    annotation.CreatedAt,
+	}
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func TestAnnotationService_AddAnnotation(t *testing.T) {
+	attachmentID := uuid.New()
+
+	imageAttachment := func(m *MockAttachmentRepository) {
+		m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+			return &domain.Attachment{BaseModel: domain.BaseModel{ID: id}, ContentType: "image/png"}, nil
+		}
+	}
+
+	tests := []struct {
+		name           string
+		req            *dto.CreateAnnotationRequest
+		mockAttachment func(*MockAttachmentRepository)
+		wantErr        bool
+		wantErrCode    string
+		wantCreated    bool
+	}{
+		{
+			name:           "성공: 정규화된 좌표로 Annotation 생성",
+			req:            &dto.CreateAnnotationRequest{X: floatPtr(0.25), Y: floatPtr(1), Content: "여기 확인"},
+			mockAttachment: imageAttachment,
+			wantCreated:    true,
+		},
+		{
+			name:           "실패: X 좌표가 1보다 큼",
+			req:            &dto.CreateAnnotationRequest{X: floatPtr(1.2), Y: floatPtr(0.5), Content: "out"},
+			mockAttachment: imageAttachment,
+			wantErr:        true,
+			wantErrCode:    response.ErrCodeValidation,
+		},
+		{
+			name:           "실패: Y 좌표가 음수",
+			req:            &dto.CreateAnnotationRequest{X: floatPtr(0.5), Y: floatPtr(-0.1), Content: "out"},
+			mockAttachment: imageAttachment,
+			wantErr:        true,
+			wantErrCode:    response.ErrCodeValidation,
+		},
+		{
+			name: "실패: 이미지가 아닌 첨부파일",
+			req:  &dto.CreateAnnotationRequest{X: floatPtr(0.5), Y: floatPtr(0.5), Content: "pdf"},
+			mockAttachment: func(m *MockAttachmentRepository) {
+				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+					return &domain.Attachment{BaseModel: domain.BaseModel{ID: id}, ContentType: "application/pdf"}, nil
+				}
+			},
+			wantErr:     true,
+			wantErrCode: response.ErrCodeValidation,
+		},
+		{
+			name: "실패: Attachment가 존재하지 않음",
+			req:  &dto.CreateAnnotationRequest{X: floatPtr(0.5), Y: floatPtr(0.5), Content: "missing"},
+			mockAttachment: func(m *MockAttachmentRepository) {
+				m.FindByIDFunc = func(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+					return nil, gorm.ErrRecordNotFound
+				}
+			},
+			wantErr:     true,
+			wantErrCode: response.ErrCodeNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			mockAttachmentRepo := &MockAttachmentRepository{}
+			tt.mockAttachment(mockAttachmentRepo)
+			created := false
+			mockAnnotationRepo := &MockAttachmentAnnotationRepository{
+				CreateFunc: func(ctx context.Context, annotation *domain.AttachmentAnnotation) error {
+					created = true
+					annotation.ID = uuid.New()
+					return nil
+				},
+			}
+			service := NewAnnotationService(mockAnnotationRepo, mockAttachmentRepo)
+
+			// When
+			got, err := service.AddAnnotation(context.Background(), attachmentID, uuid.New(), tt.req)
+
+			// Then
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("AddAnnotation() error = nil, wantErr %v", tt.wantErr)
+				}
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != tt.wantErrCode {
+					t.Errorf("AddAnnotation() error = %v, want code %v", err, tt.wantErrCode)
+				}
+				if created {
+					t.Error("AddAnnotation() persisted an invalid annotation")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("AddAnnotation() unexpected error = %v", err)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("AddAnnotation() created = %v, want %v", created, tt.wantCreated)
+			}
+			if got.X != *tt.req.X || got.Y != *tt.req.Y || got.AttachmentID != attachmentID {
+				t.Errorf("AddAnnotation() = %+v, unexpected values", got)
+			}
+		})
+	}
+}
+
+func TestAnnotationService_DeleteAnnotation_OnlyAuthor(t *testing.T) {
+	authorID := uuid.New()
+	attachmentID := uuid.New()
+	annotationID := uuid.New()
+
+	deleted := false
+	mockAnnotationRepo := &MockAttachmentAnnotationRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.AttachmentAnnotation, error) {
+			return &domain.AttachmentAnnotation{BaseModel: domain.BaseModel{ID: id}, AttachmentID: attachmentID, AuthorID: authorID}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			deleted = true
+			return nil
+		},
+	}
+	service := NewAnnotationService(mockAnnotationRepo, &MockAttachmentRepository{})
+
+	err := service.DeleteAnnotation(context.Background(), attachmentID, annotationID, uuid.New())
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeForbidden {
+		t.Errorf("DeleteAnnotation() by non-author error = %v, want %v", err, response.ErrCodeForbidden)
+	}
+
+	// The author cannot reach the annotation through another attachment
+	err = service.DeleteAnnotation(context.Background(), uuid.New(), annotationID, authorID)
+	appErr, ok = err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeNotFound || deleted {
+		t.Errorf("DeleteAnnotation() through another attachment error = %v (deleted %v), want %v", err, deleted, response.ErrCodeNotFound)
+	}
+
+	if err := service.DeleteAnnotation(context.Background(), attachmentID, annotationID, authorID); err != nil {
+		t.Errorf("DeleteAnnotation() by author unexpected error = %v", err)
+	}
+}
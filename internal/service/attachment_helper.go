@@ -2,12 +2,16 @@
 package service
 
 import (
+	"context"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
 )
 
@@ -27,6 +31,41 @@ func toDomainAttachments(attachments []*domain.Attachment) []domain.Attachment {
 	return result
 }
 
+// recordAttachmentChecksums computes and stores the SHA-256 of freshly confirmed attachments
+// Clients upload directly to S3 through presigned URLs, so the hash is taken from the stored object.
+// Failures are only logged: a missing checksum must not fail the confirmation itself.
+func recordAttachmentChecksums(ctx context.Context, s3Client S3Client, attachmentRepo repository.AttachmentRepository, attachmentIDs []uuid.UUID, logger *zap.Logger) {
+	if len(attachmentIDs) == 0 {
+		return
+	}
+
+	attachments, err := attachmentRepo.FindByIDs(ctx, attachmentIDs)
+	if err != nil {
+		logger.Warn("Failed to load attachments for checksum", zap.Error(err))
+		return
+	}
+
+	for _, attachment := range attachments {
+		if attachment.ChecksumSHA256 != "" {
+			continue
+		}
+
+		checksum, err := s3Client.ComputeSHA256(ctx, attachment.FileURL)
+		if err != nil || checksum == "" {
+			logger.Warn("Failed to compute attachment checksum",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		if err := attachmentRepo.UpdateChecksum(ctx, attachment.ID, checksum); err != nil {
+			logger.Warn("Failed to store attachment checksum",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.Error(err))
+		}
+	}
+}
+
 // removeDuplicateUUIDs removes duplicate UUIDs from a slice
 func removeDuplicateUUIDs(uuids []uuid.UUID) []uuid.UUID {
 	seen := make(map[uuid.UUID]bool)
@@ -43,15 +82,72 @@ func removeDuplicateUUIDs(uuids []uuid.UUID) []uuid.UUID {
 }
 
 // validateDateRange validates that startDate is not after dueDate
+// Both dates are compared in UTC; when either is an all-day date only the calendar days are compared,
+// so an all-day start and a due time on the same day are a valid range
 func validateDateRange(startDate, dueDate *time.Time) error {
-	if startDate != nil && dueDate != nil {
-		if startDate.After(*dueDate) {
-			return response.NewAppError(response.ErrCodeValidation, "Start date cannot be after due date", "")
-		}
+	if startDate == nil || dueDate == nil {
+		return nil
+	}
+	start, due := *normalizeBoardDate(startDate), *normalizeBoardDate(dueDate)
+	if isAllDayDate(start) || isAllDayDate(due) {
+		start, due = start.Truncate(24*time.Hour), due.Truncate(24*time.Hour)
+	}
+	if start.After(due) {
+		return response.NewAppError(response.ErrCodeValidation, "Start date cannot be after due date", "")
+	}
+	return nil
+}
+
+// normalizeBoardDate converts an incoming start or due date to UTC
+// A date at midnight in the offset it was sent with is an all-day date: it keeps its calendar day
+// and becomes midnight UTC, so it reads as the same day for users in every time zone
+func normalizeBoardDate(date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+	normalized := date.UTC()
+	if isAllDayDate(*date) {
+		year, month, day := date.Date()
+		normalized = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	return &normalized
+}
+
+// isAllDayDate reports whether date has no time-of-day component in its own offset
+func isAllDayDate(date time.Time) bool {
+	hour, minute, second := date.Clock()
+	return hour == 0 && minute == 0 && second == 0 && date.Nanosecond() == 0
+}
+
+// utcTime returns t in UTC, keeping nil as nil
+func utcTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
+// validateEffortHours validates that effort values are not negative
+func validateEffortHours(estimateHours, actualHours *float64) error {
+	if estimateHours != nil && *estimateHours < 0 {
+		return response.NewAppError(response.ErrCodeValidation, "Estimate hours cannot be negative", "")
+	}
+	if actualHours != nil && *actualHours < 0 {
+		return response.NewAppError(response.ErrCodeValidation, "Actual hours cannot be negative", "")
 	}
 	return nil
 }
 
+// effortVariance returns actual minus estimated hours, or nil unless both are set
+func effortVariance(estimateHours, actualHours *float64) *float64 {
+	if estimateHours == nil || actualHours == nil {
+		return nil
+	}
+	variance := *actualHours - *estimateHours
+	return &variance
+}
+
 // extractS3KeyFromURL extracts the S3 key from a full S3 URL
 // Example: https://bucket.s3.region.amazonaws.com/board/boards/workspace/2024/01/file.jpg -> board/boards/workspace/2024/01/file.jpg
 func extractS3KeyFromURL(fileURL string) string {
@@ -73,3 +169,116 @@ func extractS3KeyFromURL(fileURL string) string {
 	key := fileURL[start+len(".amazonaws.com/"):]
 	return key
 }
+
+// newAttachmentDeleteJobs builds outbox entries for attachments whose S3 key can be resolved
+// FileURL holds the bare S3 key, though older rows may still store a full URL
+// Attachments without a usable key are logged and skipped, as inline deletion does
+func newAttachmentDeleteJobs(attachments []*domain.Attachment, logger *zap.Logger) []*domain.AttachmentDeleteJob {
+	jobs := make([]*domain.AttachmentDeleteJob, 0, len(attachments))
+	for _, attachment := range attachments {
+		fileKey := attachment.FileURL
+		if strings.Contains(fileKey, "://") {
+			fileKey = extractS3KeyFromURL(fileKey)
+		}
+		if fileKey == "" {
+			logger.Warn("Failed to extract S3 key from URL",
+				zap.String("attachment_id", attachment.ID.String()),
+				zap.String("file_url", attachment.FileURL))
+			continue
+		}
+		jobs = append(jobs, &domain.AttachmentDeleteJob{
+			AttachmentID: attachment.ID,
+			FileKey:      fileKey,
+		})
+	}
+	return jobs
+}
+
+// maxConcurrentObjectChecks bounds the S3 HEAD requests made while validating one confirmation
+const maxConcurrentObjectChecks = 8
+
+// validateAttachmentsForConfirmation checks that attachments can be confirmed for an entity of entityType
+// in projectID; uuid.Nil skips the project check for entities confirmed before their project is known
+// The records are loaded with one query and their files are checked in S3 with bounded concurrency;
+// unknown attachments and files that were never uploaded are reported together in one validation error
+func validateAttachmentsForConfirmation(ctx context.Context, attachmentRepo repository.AttachmentRepository, s3Client S3Client, attachmentIDs []uuid.UUID, entityType domain.EntityType, projectID uuid.UUID) error {
+	if len(attachmentIDs) == 0 {
+		return nil
+	}
+
+	attachments, err := attachmentRepo.FindByIDs(ctx, attachmentIDs)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachments", err.Error())
+	}
+
+	for _, attachment := range attachments {
+		if attachment.Status != domain.AttachmentStatusTemp {
+			return response.NewAppError(response.ErrCodeValidation, "Attachment is not in temporary status and cannot be reused", "")
+		}
+		if attachment.EntityType != entityType {
+			return response.NewAppError(response.ErrCodeValidation, "Attachment entity type does not match", "")
+		}
+		if projectID != uuid.Nil && attachment.BelongsToOtherProject(projectID) {
+			return response.NewAppError(response.ErrCodeValidation, "Attachment was uploaded for a different project", attachment.ID.String())
+		}
+	}
+
+	missingFiles, err := findMissingObjects(ctx, s3Client, attachments)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to verify attachment files", err.Error())
+	}
+
+	if len(attachments) == len(attachmentIDs) && len(missingFiles) == 0 {
+		return nil
+	}
+
+	found := make(map[uuid.UUID]bool, len(attachments))
+	for _, attachment := range attachments {
+		found[attachment.ID] = true
+	}
+	var details []string
+	if missing := uuidStrings(attachmentIDs, func(id uuid.UUID) bool { return !found[id] }); len(missing) > 0 {
+		details = append(details, "unknown attachments: "+strings.Join(missing, ", "))
+	}
+	if len(missingFiles) > 0 {
+		details = append(details, "files not uploaded: "+strings.Join(uuidStrings(missingFiles, nil), ", "))
+	}
+	return response.NewAppError(response.ErrCodeValidation, "One or more attachments not found", strings.Join(details, "; "))
+}
+
+// findMissingObjects returns the IDs of attachments whose file is not stored in S3
+func findMissingObjects(ctx context.Context, s3Client S3Client, attachments []*domain.Attachment) ([]uuid.UUID, error) {
+	exists := make([]bool, len(attachments))
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(maxConcurrentObjectChecks)
+	for i, attachment := range attachments {
+		g.Go(func() error {
+			ok, err := s3Client.ObjectExists(gctx, attachment.FileURL)
+			exists[i] = ok
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	var missing []uuid.UUID
+	for i, attachment := range attachments {
+		if !exists[i] {
+			missing = append(missing, attachment.ID)
+		}
+	}
+	return missing, nil
+}
+
+// uuidStrings formats the IDs accepted by keep, or all of them when keep is nil
+func uuidStrings(ids []uuid.UUID, keep func(uuid.UUID) bool) []string {
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if keep == nil || keep(id) {
+			result = append(result, id.String())
+		}
+	}
+	return result
+}
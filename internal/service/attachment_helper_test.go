@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+func TestValidateAttachmentsForConfirmation_ReportsMissingFile(t *testing.T) {
+	// Given: ten temporary attachments, one of which was never uploaded to S3
+	attachments := make([]*domain.Attachment, 10)
+	ids := make([]uuid.UUID, len(attachments))
+	for i := range attachments {
+		ids[i] = uuid.New()
+		attachments[i] = &domain.Attachment{
+			BaseModel:  domain.BaseModel{ID: ids[i]},
+			EntityType: domain.EntityTypeBoard,
+			Status:     domain.AttachmentStatusTemp,
+			FileURL:    "board/boards/ws/" + ids[i].String(),
+		}
+	}
+	notUploaded := attachments[7]
+
+	queries := 0
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+			queries++
+			return attachments, nil
+		},
+	}
+
+	var mu sync.Mutex
+	inFlight, maxInFlight, calls := 0, 0, 0
+	mockS3 := &MockS3Client{
+		ObjectExistsFunc: func(ctx context.Context, key string) (bool, error) {
+			mu.Lock()
+			calls++
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return key != notUploaded.FileURL, nil
+		},
+	}
+
+	// When
+	err := validateAttachmentsForConfirmation(context.Background(), mockAttachmentRepo, mockS3, ids, domain.EntityTypeBoard, uuid.Nil)
+
+	// Then
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+		t.Fatalf("error = %v, want validation error", err)
+	}
+	if !strings.Contains(appErr.Details, notUploaded.ID.String()) {
+		t.Errorf("details = %q, want the missing attachment %v", appErr.Details, notUploaded.ID)
+	}
+	if queries != 1 {
+		t.Errorf("FindByIDs calls = %d, want 1", queries)
+	}
+	if calls != len(attachments) {
+		t.Errorf("S3 checks = %d, want %d", calls, len(attachments))
+	}
+	if maxInFlight > maxConcurrentObjectChecks {
+		t.Errorf("concurrent S3 checks = %d, want at most %d", maxInFlight, maxConcurrentObjectChecks)
+	}
+}
@@ -273,6 +273,74 @@ func TestCreateBoardWithAttachments(t *testing.T) {
 			t.Errorf("Expected validation error, got %s", appErr.Code)
 		}
 	})
+
+	t.Run("Error - Attachment uploaded for another project", func(t *testing.T) {
+		projectID := uuid.New()
+		otherProjectID := uuid.New()
+		attachmentID := uuid.New()
+
+		mockBoardRepo := &MockBoardRepository{
+			CreateFunc: func(ctx context.Context, board *domain.Board) error {
+				t.Error("board must not be created with another project's attachment")
+				return nil
+			},
+		}
+		mockProjectRepo := &MockProjectRepository{
+			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+				return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}}, nil
+			},
+		}
+
+		mockAttachmentRepo := &MockAttachmentRepository{
+			FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+				return []*domain.Attachment{
+					{
+						BaseModel:  domain.BaseModel{ID: attachmentID},
+						EntityType: domain.EntityTypeBoard,
+						Status:     domain.AttachmentStatusTemp,
+						ProjectID:  &otherProjectID, // Uploaded for a board of another project
+					},
+				}, nil
+			},
+			ConfirmAttachmentsFunc: func(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error {
+				t.Error("attachment must not be confirmed across projects")
+				return nil
+			},
+		}
+
+		service := NewBoardService(
+			mockBoardRepo,
+			mockProjectRepo,
+			&MockFieldOptionRepository{},
+			&MockParticipantRepository{},
+			mockAttachmentRepo,
+			&MockS3Client{},
+			&MockFieldOptionConverter{},
+			nil, // metrics
+			logger,
+		)
+
+		req := &dto.CreateBoardRequest{
+			ProjectID:     projectID,
+			Title:         "Test Board",
+			Content:       "Test Content",
+			AttachmentIDs: []uuid.UUID{attachmentID},
+		}
+
+		_, err := service.CreateBoard(ctx, req)
+		if err == nil {
+			t.Fatal("Expected error for cross-project attachment, got nil")
+		}
+
+		appErr, ok := err.(*response.AppError)
+		if !ok {
+			t.Fatalf("Expected AppError, got %T", err)
+		}
+
+		if appErr.Code != response.ErrCodeValidation {
+			t.Errorf("Expected validation error, got %s", appErr.Code)
+		}
+	})
 }
 
 // TestCreateCommentWithAttachments tests creating a comment with attachments
@@ -2,14 +2,20 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"gorm.io/datatypes"
 	"gorm.io/gorm"
 
+	"project-board-api/internal/client"
+	"project-board-api/internal/converter"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/metrics"
@@ -22,8 +28,36 @@ type BoardService interface {
 	CreateBoard(ctx context.Context, req *dto.CreateBoardRequest) (*dto.BoardResponse, error)
 	GetBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error)
 	GetBoardsByProject(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters) ([]*dto.BoardResponse, error)
+	SearchBoards(ctx context.Context, projectID uuid.UUID, req *dto.SearchBoardsRequest) (*dto.PaginatedBoardsResponse, error)
+	ListBoards(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery) (*dto.BoardListPageResponse, error)
+	CountBoards(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters, approximate bool) (*dto.BoardCountResponse, error)
+	GetProjectEffort(ctx context.Context, projectID uuid.UUID) (*dto.ProjectEffortResponse, error)
+	AggregateCustomField(ctx context.Context, projectID uuid.UUID, fieldKey string) (*dto.CustomFieldAggregationResponse, error)
 	UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error)
+	PatchBoard(ctx context.Context, boardID uuid.UUID, ops []dto.PatchOp) (*dto.BoardResponse, error)
+	FindOrphanedAssignees(ctx context.Context, projectID uuid.UUID) (*dto.OrphanedAssigneesResponse, error)
+	CleanOrphanedAssignees(ctx context.Context, projectID uuid.UUID) (*dto.CleanOrphanedAssigneesResponse, error)
+	BulkUpdateBoardsStream(ctx context.Context, items []dto.BulkBoardUpdateItem, onResult func(dto.BulkBoardUpdateResult)) error
+	BatchUpdateBoards(ctx context.Context, items []dto.BatchBoardUpdateItem) (*dto.BatchUpdateBoardsResponse, error)
+	MoveBoard(ctx context.Context, boardID, targetProjectID uuid.UUID, strict bool) (*dto.MoveBoardToProjectResponse, error)
+	BulkMoveBoards(ctx context.Context, req *dto.BulkMoveBoardsRequest) (*dto.BulkMoveBoardsResponse, error)
 	DeleteBoard(ctx context.Context, boardID uuid.UUID) error
+	ArchiveBoard(ctx context.Context, boardID uuid.UUID, reason string) error
+	RestoreBoard(ctx context.Context, boardID uuid.UUID) error
+	TouchBoard(ctx context.Context, boardID uuid.UUID) error
+	GetBoardActivity(ctx context.Context, boardID uuid.UUID, page, limit int) (*dto.BoardActivityPageResponse, error)
+	GetBoardAtTime(ctx context.Context, boardID uuid.UUID, at time.Time) (*dto.BoardStateResponse, error)
+	CloneBoard(ctx context.Context, boardID uuid.UUID, req *dto.CloneBoardRequest) (*dto.BoardResponse, error)
+	ImportBoards(ctx context.Context, req *dto.ImportBoardsRequest) (*dto.ImportBoardsResponse, error)
+	BulkCreateBoards(ctx context.Context, req *dto.BulkCreateBoardsRequest) (*dto.BulkCreateBoardsResponse, error)
+	ListAttachments(ctx context.Context, boardID uuid.UUID, filter *dto.AttachmentFilter, pagination *dto.AttachmentPagination) (*dto.AttachmentPageResponse, error)
+	SaveBoardAsTemplate(ctx context.Context, boardID uuid.UUID) (*dto.BoardTemplateResponse, error)
+	GetBoardTemplates(ctx context.Context, projectID uuid.UUID) ([]*dto.BoardTemplateResponse, error)
+	CreateBoardFromTemplate(ctx context.Context, templateID uuid.UUID, overrides *dto.CreateBoardFromTemplateRequest) (*dto.BoardResponse, error)
+	ExportBoard(ctx context.Context, boardID uuid.UUID, format string) (*BoardExport, error)
+	ExportProjectBoards(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery, format string) (*BoardExport, error)
+	ExportProjectBackup(ctx context.Context, projectID uuid.UUID, w io.Writer) error
+	ImportProjectBackup(ctx context.Context, projectID uuid.UUID, r io.Reader) (*dto.ImportBoardBackupResponse, error)
 }
 
 // boardServiceImpl is the implementation of BoardService
@@ -37,15 +71,121 @@ type boardServiceImpl struct {
 	fieldOptionConverter FieldOptionConverter
 	metrics              *metrics.Metrics
 	logger               *zap.Logger
+
+	// maxBoardsPerProject limits active boards per project (0 = unlimited)
+	maxBoardsPerProject int
+	// maxCustomFieldsBytes limits the serialized size of a board's custom fields
+	maxCustomFieldsBytes int
+	// transactor makes board creation and attachment confirmation atomic
+	transactor repository.Transactor
+	// bulkUpdateInterval is the minimum delay between items of a bulk update (0 = no throttling)
+	bulkUpdateInterval time.Duration
+	// tracer creates spans around board operations
+	tracer trace.Tracer
+	// deleteQueue schedules attachment deletions durably; when nil they run inline
+	deleteQueue repository.AttachmentDeleteJobRepository
+	// webhooks notifies subscribers of board changes (nil = no notifications)
+	webhooks WebhookPublisher
+	// labelRepo reconciles board labels on update (nil = labelIds are rejected)
+	labelRepo repository.LabelRepository
+	// templateRepo stores board templates (nil = templates are rejected)
+	templateRepo repository.BoardTemplateRepository
+	// userClient resolves user display names in exports (nil = names are left empty)
+	userClient client.UserClient
+	// archiveReasons are the accepted archive reasons (empty = any reason is accepted)
+	archiveReasons map[string]bool
+	// thresholdRuleRepo holds the threshold rules evaluated on update (nil = rules are not evaluated)
+	thresholdRuleRepo repository.ThresholdRuleRepository
+}
+
+// DefaultMaxCustomFieldsBytes is the serialized custom fields limit used when none is configured
+const DefaultMaxCustomFieldsBytes = 64 * 1024
+
+// BoardServiceOption configures optional BoardService behaviour
+type BoardServiceOption func(*boardServiceImpl)
+
+// WithMaxBoardsPerProject sets the per-project board creation quota (0 = unlimited)
+func WithMaxBoardsPerProject(limit int) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.maxBoardsPerProject = limit
+	}
 }
 
 // FieldOptionConverter handles conversion between field option values and IDs
 type FieldOptionConverter interface {
 	ConvertValuesToIDs(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}) (map[string]interface{}, error)
+	ConvertValuesToIDsBatch(ctx context.Context, projectID uuid.UUID, customFields []map[string]interface{}) ([]converter.ConvertedFields, error)
 	ConvertIDsToValues(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error)
 	ConvertIDsToValuesBatch(ctx context.Context, boards []*domain.Board) error
 }
 
+// WithMaxCustomFieldsBytes sets the maximum serialized size of custom fields (<= 0 keeps the default)
+func WithMaxCustomFieldsBytes(limit int) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		if limit > 0 {
+			s.maxCustomFieldsBytes = limit
+		}
+	}
+}
+
+// WithBulkUpdateInterval throttles bulk updates to at most one item per interval
+func WithBulkUpdateInterval(interval time.Duration) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.bulkUpdateInterval = interval
+	}
+}
+
+// WithTransactor runs board creation and attachment confirmation in one database transaction
+func WithTransactor(transactor repository.Transactor) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		if transactor != nil {
+			s.transactor = transactor
+		}
+	}
+}
+
+// WithAttachmentDeleteQueue deletes attachments through the durable outbox instead of inline
+// The jobs are enqueued in the same transaction as the board change and drained by a background worker
+func WithAttachmentDeleteQueue(queue repository.AttachmentDeleteJobRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.deleteQueue = queue
+	}
+}
+
+// WithLabelRepository lets board updates set labels and moves drop labels of the source project
+func WithLabelRepository(labelRepo repository.LabelRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.labelRepo = labelRepo
+	}
+}
+
+// WithBoardTemplateRepository enables saving boards as templates and creating boards from them
+func WithBoardTemplateRepository(templateRepo repository.BoardTemplateRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.templateRepo = templateRepo
+	}
+}
+
+// WithArchiveReasons restricts archive reasons to the given values (empty = any reason is accepted)
+func WithArchiveReasons(reasons []string) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		if len(reasons) == 0 {
+			return
+		}
+		s.archiveReasons = make(map[string]bool, len(reasons))
+		for _, reason := range reasons {
+			s.archiveReasons[reason] = true
+		}
+	}
+}
+
+// noTransaction runs work directly when no Transactor is configured (unit tests with mock repositories)
+type noTransaction struct{}
+
+func (noTransaction) WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	return fn(ctx)
+}
+
 // NewBoardService creates a new instance of BoardService
 func NewBoardService(
 	boardRepo repository.BoardRepository,
@@ -57,8 +197,9 @@ func NewBoardService(
 	fieldOptionConverter FieldOptionConverter,
 	m *metrics.Metrics,
 	logger *zap.Logger,
+	opts ...BoardServiceOption,
 ) BoardService {
-	return &boardServiceImpl{
+	s := &boardServiceImpl{
 		boardRepo:            boardRepo,
 		projectRepo:          projectRepo,
 		fieldOptionRepo:      fieldOptionRepo,
@@ -68,24 +209,50 @@ func NewBoardService(
 		fieldOptionConverter: fieldOptionConverter,
 		metrics:              m,
 		logger:               logger,
+		maxCustomFieldsBytes: DefaultMaxCustomFieldsBytes,
+		transactor:           noTransaction{},
+		tracer:               defaultTracer(),
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // CreateBoard creates a new board
-func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoardRequest) (*dto.BoardResponse, error) {
+func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoardRequest) (resp *dto.BoardResponse, err error) {
+	ctx, span := s.startSpan(ctx, "CreateBoard", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	resp, err = s.createBoard(ctx, req, nil)
+	if err != nil {
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("board.id", resp.ID.String()))
+	return resp, nil
+}
+
+// createBoard creates a board whose custom fields may already be converted by a batch conversion
+func (s *boardServiceImpl) createBoard(ctx context.Context, req *dto.CreateBoardRequest, converted *convertedCustomFields) (*dto.BoardResponse, error) {
 	// Extract user_id from context (set by auth middleware as uuid.UUID)
 	authorID, exists := ctx.Value("user_id").(uuid.UUID)
 	if !exists {
 		return nil, response.NewAppError(response.ErrCodeUnauthorized, "User ID not found in context", "")
 	}
 
-	// Validate date range
+	// Store dates in UTC and validate the normalized range
+	req.StartDate, req.DueDate = normalizeBoardDate(req.StartDate), normalizeBoardDate(req.DueDate)
 	if err := validateDateRange(req.StartDate, req.DueDate); err != nil {
 		return nil, err
 	}
 
+	// Validate effort values
+	if err := validateEffortHours(req.EstimateHours, req.ActualHours); err != nil {
+		return nil, err
+	}
+
 	// Verify project exists
-	_, err := s.projectRepo.FindByID(ctx, req.ProjectID)
+	project, err := s.projectRepo.FindByID(ctx, req.ProjectID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
@@ -93,18 +260,32 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
 	}
 
+	titleUnique := project != nil && project.EnforceUniqueTitles
+	if titleUnique {
+		if err := s.checkTitleAvailable(ctx, req.ProjectID, uuid.Nil, req.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	// An external ID identifies at most one active board per project
+	if req.ExternalID != nil {
+		if err := s.checkExternalIDAvailable(ctx, req.ProjectID, *req.ExternalID); err != nil {
+			return nil, err
+		}
+	}
+
 	// Convert CustomFields from values to IDs, then to datatypes.JSON
 	var customFieldsJSON datatypes.JSON
 	if req.CustomFields != nil {
 		// Convert values to IDs
-		convertedFields, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, req.ProjectID, req.CustomFields)
+		convertedFields, err := s.convertCustomFields(ctx, req.ProjectID, req.CustomFields, converted)
 		if err != nil {
-			return nil, response.NewAppError(response.ErrCodeValidation, "Invalid custom field values", err.Error())
+			return nil, customFieldsError(err)
 		}
 
-		jsonBytes, err := json.Marshal(convertedFields)
+		jsonBytes, err := s.marshalCustomFields(convertedFields)
 		if err != nil {
-			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to marshal custom fields", err.Error())
+			return nil, err
 		}
 		customFieldsJSON = jsonBytes
 	}
@@ -117,55 +298,63 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 
 	// Validate and confirm attachments if provided
 	if len(req.AttachmentIDs) > 0 {
-		if err := s.validateAndConfirmAttachments(ctx, req.AttachmentIDs, domain.EntityTypeBoard, uuid.Nil); err != nil {
+		if err := s.validateAndConfirmAttachments(ctx, req.AttachmentIDs, domain.EntityTypeBoard, req.ProjectID); err != nil {
 			return nil, err
 		}
 	}
 
 	// Create domain model from request with AuthorID
 	board := &domain.Board{
-		ProjectID:    req.ProjectID,
-		AuthorID:     authorID,
-		Title:        req.Title,
-		Content:      req.Content,
-		CustomFields: customFieldsJSON,
-		AssigneeID:   assigneeID,
-		StartDate:    req.StartDate,
-		DueDate:      req.DueDate,
-	}
-
-	// Save to repository
-	if err := s.boardRepo.Create(ctx, board); err != nil {
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		ProjectID:     req.ProjectID,
+		AuthorID:      authorID,
+		Title:         req.Title,
+		Content:       req.Content,
+		CustomFields:  customFieldsJSON,
+		AssigneeID:    assigneeID,
+		StartDate:     req.StartDate,
+		DueDate:       req.DueDate,
+		EstimateHours: req.EstimateHours,
+		ActualHours:   req.ActualHours,
+		ExternalID:    req.ExternalID,
+		TitleUnique:   titleUnique,
 	}
 
-	// Confirm attachments after board creation
-	var createdAttachments []*domain.Attachment
-	if len(req.AttachmentIDs) > 0 {
-		// 에러 발생 시 board도 롤백
-		if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, board.ID); err != nil {
-			s.logger.Error("Failed to confirm attachments, rolling back board creation",
-				zap.String("board_id", board.ID.String()),
-				zap.Strings("attachment_ids", func() []string {
-					ids := make([]string, len(req.AttachmentIDs))
-					for i, id := range req.AttachmentIDs {
-						ids[i] = id.String()
-					}
-					return ids
-				}()),
-				zap.Error(err))
+	// Save the board and confirm its attachments atomically
+	// A failed confirmation rolls back the board as well
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		// Enforce per-project board quota
+		if err := s.checkBoardQuota(txCtx, req.ProjectID, 1); err != nil {
+			return err
+		}
 
-			// board 삭제 (롤백)
-			if deleteErr := s.boardRepo.Delete(ctx, board.ID); deleteErr != nil {
-				s.logger.Error("Failed to rollback board after attachment confirmation failure",
-					zap.String("board_id", board.ID.String()),
-					zap.Error(deleteErr))
-			}
+		if err := s.boardRepo.Create(txCtx, board); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+		}
 
-			return nil, response.NewAppError(response.ErrCodeInternal,
+		if err := s.attachmentRepo.ConfirmAttachments(txCtx, req.AttachmentIDs, board.ID); err != nil {
+			s.logger.Error("Failed to confirm attachments, rolling back board creation",
+				zap.String("project_id", req.ProjectID.String()),
+				zap.Int("attachment_count", len(req.AttachmentIDs)),
+				zap.Error(err))
+			return response.NewAppError(response.ErrCodeInternal,
 				"Failed to confirm attachments: "+err.Error(),
 				"Please ensure all attachment IDs are valid and not already used")
 		}
+		return nil
+	})
+	if err != nil {
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create board", err.Error())
+	}
+	s.publishBoardEvent(ctx, domain.WebhookEventBoardCreated, board, nil)
+
+	// Load confirmed attachment metadata for the response
+	var createdAttachments []*domain.Attachment
+	if len(req.AttachmentIDs) > 0 {
+		recordAttachmentChecksums(ctx, s.s3Client, s.attachmentRepo, req.AttachmentIDs, s.logger)
 
 		// Confirm 후 Attachments 메타데이터를 조회하여 board 객체에 할당
 		attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
@@ -183,11 +372,10 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 
 	// Add participants if provided
 	if len(req.Participants) > 0 {
-		successCount, err := s.addParticipantsInternal(ctx, board.ID, req.Participants)
-		if err != nil {
+		if _, err := s.addParticipantsInternal(ctx, board.ID, req.Participants); err != nil {
 			s.logger.Warn("Error occurred while adding participants during board creation",
 				zap.String("board_id", board.ID.String()),
-				zap.Int("success_count", successCount),
+				zap.Int("participant_count", len(req.Participants)),
 				zap.Error(err))
 		}
 
@@ -211,7 +399,10 @@ func (s *boardServiceImpl) CreateBoard(ctx context.Context, req *dto.CreateBoard
 }
 
 // GetBoard retrieves a board by ID with participants and comments
-func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID uuid.UUID) (*dto.BoardDetailResponse, error) {
+func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID uuid.UUID) (resp *dto.BoardDetailResponse, err error) {
+	ctx, span := s.startSpan(ctx, "GetBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
 	// Fetch board from repository
 	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
@@ -227,7 +418,7 @@ func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID uuid.UUID) (*dt
 		s.logger.Error("Failed to fetch attachments for board", zap.String("board_id", board.ID.String()), zap.Error(err))
 		// Continue with graceful degradation
 	}
-	board.Attachments = toDomainAttachments(attachments)
+	board.Attachments = toDomainAttachments(s.newAttachmentViewer(ctx, board.ProjectID).filter(ctx, attachments))
 
 	// Convert IDs to values in customFields
 	if err := s.convertBoardCustomFieldsToValues(ctx, board); err != nil {
@@ -235,7 +426,9 @@ func (s *boardServiceImpl) GetBoard(ctx context.Context, boardID uuid.UUID) (*dt
 	}
 
 	// Convert to detailed response DTO
-	return s.toBoardDetailResponse(board), nil
+	resp = s.toBoardDetailResponse(board)
+	markUnread(ctx, board, &resp.BoardResponse)
+	return resp, nil
 }
 
 // GetBoardsByProject retrieves all boards for a project with optional filters
@@ -249,26 +442,22 @@ func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uui
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
 	}
 
-	// Prepare filter parameter for repository
-	var filterParam interface{}
-	if filters != nil && filters.CustomFields != nil {
-		filterParam = filters.CustomFields
-	}
-
 	// Fetch boards from repository with filters
-	boards, err := s.boardRepo.FindByProjectID(ctx, projectID, filterParam)
+	boards, err := s.boardRepo.FindByProjectID(ctx, projectID, boardFilterParam(filters))
 	if err != nil {
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
 	}
 
 	// Board 목록 조회 시 Attachments 로드 (효율을 위해 각 board별로 로드)
+	viewer := s.newAttachmentViewer(ctx, projectID)
 	for _, board := range boards {
 		attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("Failed to fetch attachments for board list", zap.String("board_id", board.ID.String()), zap.Error(err))
 		}
-		board.Attachments = toDomainAttachments(attachments)
+		board.Attachments = toDomainAttachments(viewer.filter(ctx, attachments))
 	}
+	s.loadBoardCovers(ctx, boards)
 
 	// Convert IDs to values in batch for all boards
 	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
@@ -279,15 +468,229 @@ func (s *boardServiceImpl) GetBoardsByProject(ctx context.Context, projectID uui
 	responses := make([]*dto.BoardResponse, len(boards))
 	for i, board := range boards {
 		responses[i] = s.toBoardResponse(board)
+		markUnread(ctx, board, responses[i])
 	}
 
 	return responses, nil
 }
 
-// UpdateBoard updates a board's attributes
-func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) error {
+// SearchBoards finds the project's boards matching both a text query and the structured filters
+// Title matches rank above content-only matches; results are paginated
+func (s *boardServiceImpl) SearchBoards(ctx context.Context, projectID uuid.UUID, req *dto.SearchBoardsRequest) (*dto.PaginatedBoardsResponse, error) {
+	if strings.TrimSpace(req.Query) == "" {
+		return nil, response.NewValidationError("Search query cannot be empty", "")
+	}
+	if req.DueFrom != nil && req.DueTo != nil && req.DueFrom.After(*req.DueTo) {
+		return nil, response.NewValidationError("dueFrom must not be after dueTo", "")
+	}
+
+	// Set default pagination values
+	page, limit := req.Page, req.Limit
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > 100 {
+		limit = 10
+	}
+
+	// Verify project exists
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	// Boards store option IDs, so value filters are converted before querying
+	var customFields map[string]interface{}
+	if len(req.Filters.CustomFields) > 0 {
+		converted, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, projectID, req.Filters.CustomFields)
+		if err != nil {
+			return nil, customFieldsError(err)
+		}
+		customFields = converted
+	}
+
+	boards, total, err := s.boardRepo.SearchByProjectID(ctx, projectID, repository.BoardSearchQuery{
+		Text:            req.Query,
+		CustomFields:    customFields,
+		IncludeArchived: req.Filters.IncludeArchived,
+		DueFrom:         req.DueFrom,
+		DueTo:           req.DueTo,
+		Offset:          (page - 1) * limit,
+		Limit:           limit,
+	})
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to search boards", err.Error())
+	}
+
+	viewer := s.newAttachmentViewer(ctx, projectID)
+	for _, board := range boards {
+		attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			s.logger.Error("Failed to fetch attachments for board search", zap.String("board_id", board.ID.String()), zap.Error(err))
+		}
+		board.Attachments = toDomainAttachments(viewer.filter(ctx, attachments))
+	}
+	s.loadBoardCovers(ctx, boards)
+
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+
+	responses := make([]dto.BoardResponse, len(boards))
+	for i, board := range boards {
+		responses[i] = *s.toBoardResponse(board)
+		markUnread(ctx, board, &responses[i])
+	}
+
+	return &dto.PaginatedBoardsResponse{
+		Boards: responses,
+		Total:  total,
+		Page:   page,
+		Limit:  limit,
+	}, nil
+}
+
+// CountBoards counts the boards GetBoardsByProject would return for the same filters
+// approximate uses planner statistics instead of scanning, which is cheaper on large tables
+func (s *boardServiceImpl) CountBoards(ctx context.Context, projectID uuid.UUID, filters *dto.BoardFilters, approximate bool) (*dto.BoardCountResponse, error) {
+	// Verify project exists
+	_, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	var count int64
+	if approximate {
+		count, err = s.boardRepo.EstimateCountByProjectID(ctx, projectID, boardFilterParam(filters))
+	} else {
+		count, err = s.boardRepo.CountByProjectID(ctx, projectID, boardFilterParam(filters))
+	}
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to count boards", err.Error())
+	}
+
+	return &dto.BoardCountResponse{
+		Count:       count,
+		Approximate: approximate,
+	}, nil
+}
+
+// GetProjectEffort aggregates estimated vs actual effort across a project's boards
+func (s *boardServiceImpl) GetProjectEffort(ctx context.Context, projectID uuid.UUID) (*dto.ProjectEffortResponse, error) {
+	// Verify project exists
+	_, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	totalEstimate, totalActual, err := s.boardRepo.SumEffortByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to aggregate board effort", err.Error())
+	}
+
+	return &dto.ProjectEffortResponse{
+		ProjectID:          projectID,
+		TotalEstimateHours: totalEstimate,
+		TotalActualHours:   totalActual,
+		Variance:           totalActual - totalEstimate,
+	}, nil
+}
+
+// AggregateCustomField counts a project's boards per option of a select custom field
+// Values that no longer match a project option are reported as unset
+func (s *boardServiceImpl) AggregateCustomField(ctx context.Context, projectID uuid.UUID, fieldKey string) (*dto.CustomFieldAggregationResponse, error) {
+	fieldType := domain.FieldType(fieldKey)
+	switch fieldType {
+	case domain.FieldTypeStage, domain.FieldTypeRole, domain.FieldTypeImportance:
+	default:
+		return nil, response.NewValidationError("Invalid custom field", "field must be one of: stage, role, importance")
+	}
+
+	// Verify project exists
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, fieldType)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch field options", err.Error())
+	}
+
+	counts, err := s.boardRepo.CountByCustomFieldOption(ctx, projectID, fieldKey)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to aggregate custom field", err.Error())
+	}
+
+	resp := &dto.CustomFieldAggregationResponse{
+		ProjectID: projectID,
+		FieldKey:  fieldKey,
+		Options:   make([]dto.CustomFieldOptionCount, 0, len(options)),
+	}
+	for _, option := range options {
+		count := counts[option.ID.String()]
+		delete(counts, option.ID.String())
+		resp.Options = append(resp.Options, dto.CustomFieldOptionCount{
+			OptionID: option.ID,
+			Value:    option.Value,
+			Label:    option.Label,
+			Count:    count,
+		})
+		resp.Total += count
+	}
+	for _, count := range counts {
+		resp.Unset += count
+		resp.Total += count
+	}
+
+	return resp, nil
+}
+
+// boardFilterParam prepares the repository filter parameter from board filters
+func boardFilterParam(filters *dto.BoardFilters) interface{} {
+	if filters != nil && filters.IncludeArchived {
+		return repository.BoardListFilter{CustomFields: filters.CustomFields, IncludeArchived: true}
+	}
+	if filters != nil && filters.CustomFields != nil {
+		return filters.CustomFields
+	}
+	return nil
+}
+
+// TouchBoard marks a board as recently active by bumping updated_at
+// No field changes, so nothing else is written and no update event is broadcast
+func (s *boardServiceImpl) TouchBoard(ctx context.Context, boardID uuid.UUID) (err error) {
+	ctx, span := s.startSpan(ctx, "TouchBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	if err := s.boardRepo.Touch(ctx, boardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to touch board", err.Error())
+	}
+
+	return nil
+}
+
+// DeleteBoard deletes a board together with its attachments
+// With a delete queue the attachment files are removed by the worker once the deletion has committed
+func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) (err error) {
+	ctx, span := s.startSpan(ctx, "DeleteBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
 	// Verify board exists
-	_, err := s.boardRepo.FindByID(ctx, boardID)
+	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
@@ -304,16 +707,34 @@ func (s *boardServiceImpl) DeleteBoard(ctx context.Context, boardID uuid.UUID) e
 		// Continue with board deletion even if attachment fetch fails
 	}
 
-	// Delete attachments from S3 and database
-	if len(attachments) > 0 {
-		s.deleteAttachmentsWithS3(ctx, attachments)
-	}
+	if s.deleteQueue == nil {
+		// Delete attachments from S3 and database
+		if len(attachments) > 0 {
+			s.deleteAttachmentsWithS3(ctx, attachments)
+		}
 
-	// Delete board
-	if err := s.boardRepo.Delete(ctx, boardID); err != nil {
-		return response.NewAppError(response.ErrCodeInternal, "Failed to delete board", err.Error())
+		// Delete board
+		if err := s.boardRepo.Delete(ctx, boardID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to delete board", err.Error())
+		}
+		s.publishBoardEvent(ctx, domain.WebhookEventBoardDeleted, board, nil)
+		return nil
 	}
 
+	// The worker only sees the jobs once the board deletion has committed
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.deleteQueue.Enqueue(txCtx, newAttachmentDeleteJobs(attachments, s.logger)); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to schedule attachment deletion", err.Error())
+		}
+		if err := s.boardRepo.Delete(txCtx, boardID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to delete board", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.publishBoardEvent(ctx, domain.WebhookEventBoardDeleted, board, nil)
 	return nil
 }
 
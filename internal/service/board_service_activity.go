@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+const (
+	defaultActivityPageSize = 20
+	maxActivityPageSize     = 100
+)
+
+// boardSnapshot holds the audited fields of a board in human-readable form
+type boardSnapshot struct {
+	Title         string
+	Content       string
+	AssigneeID    *uuid.UUID
+	StartDate     *time.Time
+	DueDate       *time.Time
+	EstimateHours *float64
+	ActualHours   *float64
+	// CustomFields holds option values, not option IDs
+	CustomFields map[string]interface{}
+	// Participants is nil when the update does not touch participants
+	Participants []uuid.UUID
+}
+
+// snapshotBoard captures the audited fields of board, converting custom field option IDs to values
+func (s *boardServiceImpl) snapshotBoard(ctx context.Context, board *domain.Board) (boardSnapshot, error) {
+	snapshot := boardSnapshot{
+		Title:         board.Title,
+		Content:       board.Content,
+		AssigneeID:    board.AssigneeID,
+		StartDate:     board.StartDate,
+		DueDate:       board.DueDate,
+		EstimateHours: board.EstimateHours,
+		ActualHours:   board.ActualHours,
+	}
+
+	customFields, err := s.readableCustomFields(ctx, board.CustomFields)
+	if err != nil {
+		return boardSnapshot{}, err
+	}
+	snapshot.CustomFields = customFields
+	return snapshot, nil
+}
+
+// readableCustomFields decodes stored custom fields and converts their option IDs to values
+func (s *boardServiceImpl) readableCustomFields(ctx context.Context, raw []byte) (map[string]interface{}, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var customFields map[string]interface{}
+	if err := json.Unmarshal(raw, &customFields); err != nil {
+		return nil, err
+	}
+	return s.fieldOptionConverter.ConvertIDsToValues(ctx, customFields)
+}
+
+// diffBoardSnapshots returns one activity per field whose value differs; equal values produce nothing
+func diffBoardSnapshots(boardID, actorID uuid.UUID, before, after boardSnapshot) []*domain.BoardActivity {
+	var activities []*domain.BoardActivity
+	record := func(field, oldValue, newValue string) {
+		if oldValue == newValue {
+			return
+		}
+		activities = append(activities, &domain.BoardActivity{
+			BoardID:  boardID,
+			ActorID:  actorID,
+			Field:    field,
+			OldValue: oldValue,
+			NewValue: newValue,
+		})
+	}
+
+	record("title", before.Title, after.Title)
+	record("content", before.Content, after.Content)
+	record("assigneeId", formatActivityUUID(before.AssigneeID), formatActivityUUID(after.AssigneeID))
+	record("startDate", formatActivityTime(before.StartDate), formatActivityTime(after.StartDate))
+	record("dueDate", formatActivityTime(before.DueDate), formatActivityTime(after.DueDate))
+	record("estimateHours", formatActivityFloat(before.EstimateHours), formatActivityFloat(after.EstimateHours))
+	record("actualHours", formatActivityFloat(before.ActualHours), formatActivityFloat(after.ActualHours))
+
+	keys := make(map[string]bool, len(before.CustomFields)+len(after.CustomFields))
+	for key := range before.CustomFields {
+		keys[key] = true
+	}
+	for key := range after.CustomFields {
+		keys[key] = true
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+	for _, key := range sortedKeys {
+		record("customFields."+key, formatActivityValue(before.CustomFields[key]), formatActivityValue(after.CustomFields[key]))
+	}
+
+	if after.Participants != nil {
+		record("participants", formatActivityUUIDSet(before.Participants), formatActivityUUIDSet(after.Participants))
+	}
+
+	return activities
+}
+
+func formatActivityUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func formatActivityTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatActivityFloat(f *float64) string {
+	if f == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*f, 'f', -1, 64)
+}
+
+func formatActivityValue(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// formatActivityUUIDSet renders a set of IDs in a stable order so reordering alone is not a change
+func formatActivityUUIDSet(ids []uuid.UUID) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range removeDuplicateUUIDs(ids) {
+		values = append(values, id.String())
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+// participantUserIDs lists the users participating in a board
+func participantUserIDs(participants []domain.Participant) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(participants))
+	for _, p := range participants {
+		ids = append(ids, p.UserID)
+	}
+	return ids
+}
+
+// actorFromContext returns the requesting user, or uuid.Nil for system changes
+func actorFromContext(ctx context.Context) uuid.UUID {
+	actorID, _ := ctx.Value("user_id").(uuid.UUID)
+	return actorID
+}
+
+// GetBoardActivity returns one page of a board's change history, newest first
+func (s *boardServiceImpl) GetBoardActivity(ctx context.Context, boardID uuid.UUID, page, limit int) (resp *dto.BoardActivityPageResponse, err error) {
+	ctx, span := s.startSpan(ctx, "GetBoardActivity", boardID)
+	defer func() { endSpan(span, err) }()
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 || limit > maxActivityPageSize {
+		limit = defaultActivityPageSize
+	}
+
+	if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+
+	activities, total, err := s.boardRepo.FindActivitiesByBoardID(ctx, boardID, (page-1)*limit, limit)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board activity", err.Error())
+	}
+
+	resp = &dto.BoardActivityPageResponse{
+		Activities: make([]dto.BoardActivityResponse, len(activities)),
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+	}
+	for i, activity := range activities {
+		resp.Activities[i] = dto.BoardActivityResponse{
+			ID:        activity.ID,
+			BoardID:   activity.BoardID,
+			ActorID:   activity.ActorID,
+			Field:     activity.Field,
+			OldValue:  activity.OldValue,
+			NewValue:  activity.NewValue,
+			ChangedAt: activity.CreatedAt,
+		}
+	}
+	return resp, nil
+}
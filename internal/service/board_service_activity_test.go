@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardService_UpdateBoard_RecordsActivity(t *testing.T) {
+	boardID := uuid.New()
+	actorID := uuid.New()
+	participantID := uuid.New()
+
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: boardID},
+		ProjectID:    uuid.New(),
+		Title:        "Old title",
+		Content:      "Same content",
+		CustomFields: datatypes.JSON(`{"stage":"id-todo"}`),
+		Participants: []domain.Participant{{BoardID: boardID, UserID: participantID}},
+	}
+
+	var recorded [][]*domain.BoardActivity
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		UpdateFunc: func(ctx context.Context, updated *domain.Board) error {
+			board = updated
+			return nil
+		},
+		AddActivitiesFunc: func(ctx context.Context, activities []*domain.BoardActivity) error {
+			recorded = append(recorded, activities)
+			return nil
+		},
+	}
+	// Option IDs and values differ so the log can be checked for values
+	valueToID := map[string]string{"todo": "id-todo", "done": "id-done"}
+	idToValue := map[string]string{"id-todo": "todo", "id-done": "done"}
+	mockConverter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, projectID uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			converted := map[string]interface{}{}
+			for key, value := range fields {
+				converted[key] = valueToID[value.(string)]
+			}
+			return converted, nil
+		},
+		ConvertIDsToValuesFunc: func(ctx context.Context, fields map[string]interface{}) (map[string]interface{}, error) {
+			converted := map[string]interface{}{}
+			for key, value := range fields {
+				converted[key] = idToValue[value.(string)]
+			}
+			return converted, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindMembersByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID) ([]*domain.ProjectMember, error) {
+			return []*domain.ProjectMember{{ProjectID: projectID, UserID: participantID}}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, mockConverter, nil, zap.NewNop())
+	ctx := context.WithValue(context.Background(), "user_id", actorID)
+
+	newTitle := "New title"
+	sameContent := "Same content"
+	customFields := map[string]interface{}{"stage": "done"}
+	req := &dto.UpdateBoardRequest{
+		Title:        &newTitle,
+		Content:      &sameContent,
+		CustomFields: &customFields,
+		Participants: []uuid.UUID{participantID},
+	}
+	if _, err := service.UpdateBoard(ctx, boardID, req); err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+
+	if len(recorded) != 1 {
+		t.Fatalf("expected one AddActivities call, got %d", len(recorded))
+	}
+	want := map[string][2]string{
+		"title":              {"Old title", "New title"},
+		"customFields.stage": {"todo", "done"},
+	}
+	if len(recorded[0]) != len(want) {
+		t.Fatalf("expected %d activities, got %d", len(want), len(recorded[0]))
+	}
+	for _, activity := range recorded[0] {
+		values, ok := want[activity.Field]
+		if !ok {
+			t.Errorf("unexpected activity for unchanged field %q", activity.Field)
+			continue
+		}
+		if activity.OldValue != values[0] || activity.NewValue != values[1] {
+			t.Errorf("%s: got %q -> %q, want %q -> %q", activity.Field, activity.OldValue, activity.NewValue, values[0], values[1])
+		}
+		if activity.ActorID != actorID || activity.BoardID != boardID {
+			t.Errorf("%s: actor %v board %v, want %v %v", activity.Field, activity.ActorID, activity.BoardID, actorID, boardID)
+		}
+	}
+
+	// Submitting the current values again is a no-op and writes no activity
+	if _, err := service.UpdateBoard(ctx, boardID, req); err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+	if len(recorded) != 2 || len(recorded[1]) != 0 {
+		t.Errorf("expected no activities for a no-op update, got %v", recorded[len(recorded)-1])
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_AggregateCustomField(t *testing.T) {
+	projectID := uuid.New()
+	todo := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeStage, Value: "todo", Label: "할 일"}
+	done := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeStage, Value: "done", Label: "완료"}
+	blocked := &domain.FieldOption{BaseModel: domain.BaseModel{ID: uuid.New()}, FieldType: domain.FieldTypeStage, Value: "blocked", Label: "막힘"}
+
+	mockBoardRepo := &MockBoardRepository{
+		CountByCustomFieldOptionFunc: func(ctx context.Context, pid uuid.UUID, fieldKey string) (map[string]int64, error) {
+			return map[string]int64{
+				todo.ID.String(): 4,
+				done.ID.String(): 2,
+				"":               1,
+				// an option deleted since the boards were written
+				uuid.NewString(): 1,
+			}, nil
+		},
+	}
+	mockFieldOptionRepo := &MockFieldOptionRepository{
+		FindByProjectAndFieldTypeFunc: func(ctx context.Context, pid uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+			return []*domain.FieldOption{todo, done, blocked}, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, mockFieldOptionRepo, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop())
+
+	resp, err := service.AggregateCustomField(context.Background(), projectID, "stage")
+	if err != nil {
+		t.Fatalf("AggregateCustomField() unexpected error = %v", err)
+	}
+
+	wantCounts := []int64{4, 2, 0}
+	if len(resp.Options) != len(wantCounts) {
+		t.Fatalf("expected %d options, got %d", len(wantCounts), len(resp.Options))
+	}
+	for i, want := range wantCounts {
+		if resp.Options[i].Count != want {
+			t.Errorf("options[%d] (%s) count = %d, want %d", i, resp.Options[i].Value, resp.Options[i].Count, want)
+		}
+	}
+	if resp.Options[0].Label != "할 일" {
+		t.Errorf("expected option label to be included, got %q", resp.Options[0].Label)
+	}
+	if resp.Unset != 2 || resp.Total != 8 {
+		t.Errorf("unset = %d, total = %d, want 2 and 8", resp.Unset, resp.Total)
+	}
+
+	_, err = service.AggregateCustomField(context.Background(), projectID, "estimate")
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("AggregateCustomField() unknown field error = %v, want %v", err, response.ErrCodeValidation)
+	}
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+// ArchiveBoard hides a board from project lists without deleting it
+// Participants, comments and attachments are kept, so RestoreBoard brings the board back as it was
+// reason records why the board was archived; it is optional, and must be one of the configured reasons if any are set
+func (s *boardServiceImpl) ArchiveBoard(ctx context.Context, boardID uuid.UUID, reason string) (err error) {
+	ctx, span := s.startSpan(ctx, "ArchiveBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	archiveReason, err := s.validateArchiveReason(reason)
+	if err != nil {
+		return err
+	}
+
+	board, err := s.findBoardForArchive(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	if board.ArchivedAt != nil {
+		return response.NewValidationError("Board is already archived", "")
+	}
+
+	archivedAt := time.Now().UTC()
+	return s.setBoardArchived(ctx, board, &archivedAt, archiveReason)
+}
+
+// validateArchiveReason trims reason and checks it against the configured reasons
+// An empty reason means none was given and is always accepted
+func (s *boardServiceImpl) validateArchiveReason(reason string) (*string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, nil
+	}
+	if s.archiveReasons != nil && !s.archiveReasons[reason] {
+		allowed := make([]string, 0, len(s.archiveReasons))
+		for allowedReason := range s.archiveReasons {
+			allowed = append(allowed, allowedReason)
+		}
+		sort.Strings(allowed)
+		return nil, response.NewFieldValidationError("Invalid archive reason", map[string]string{
+			"reason": "must be one of: " + strings.Join(allowed, ", "),
+		})
+	}
+	return &reason, nil
+}
+
+// RestoreBoard brings an archived board back into project lists
+// The board counts against the project's board limit again, so a full project rejects the restore
+func (s *boardServiceImpl) RestoreBoard(ctx context.Context, boardID uuid.UUID) (err error) {
+	ctx, span := s.startSpan(ctx, "RestoreBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	board, err := s.findBoardForArchive(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	if board.ArchivedAt == nil {
+		return response.NewValidationError("Board is not archived", "")
+	}
+
+	return s.setBoardArchived(ctx, board, nil, nil)
+}
+
+func (s *boardServiceImpl) findBoardForArchive(ctx context.Context, boardID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	return board, nil
+}
+
+// setBoardArchived archives (archivedAt set) or restores (nil) a board and records the change in its history
+// A restore clears the archive reason, so reason is only set when archiving
+// A restored board counts against the project's board limit again, which is checked in the same transaction
+func (s *boardServiceImpl) setBoardArchived(ctx context.Context, board *domain.Board, archivedAt *time.Time, reason *string) error {
+	activities := []*domain.BoardActivity{{
+		BoardID:  board.ID,
+		ActorID:  actorFromContext(ctx),
+		Field:    "archivedAt",
+		OldValue: formatActivityTime(board.ArchivedAt),
+		NewValue: formatActivityTime(archivedAt),
+	}}
+	if oldReason, newReason := stringOrEmpty(board.ArchiveReason), stringOrEmpty(reason); oldReason != newReason {
+		activities = append(activities, &domain.BoardActivity{
+			BoardID:  board.ID,
+			ActorID:  actorFromContext(ctx),
+			Field:    "archiveReason",
+			OldValue: oldReason,
+			NewValue: newReason,
+		})
+	}
+
+	err := s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		if archivedAt != nil {
+			err = s.boardRepo.Archive(txCtx, board.ID, *archivedAt, reason)
+		} else {
+			if err := s.checkBoardQuota(txCtx, board.ProjectID, 1); err != nil {
+				return err
+			}
+			err = s.boardRepo.Restore(txCtx, board.ID)
+		}
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				// Archived or restored concurrently
+				return response.NewAppError(response.ErrCodeConflict, "Board was modified by someone else", "reload the board and retry")
+			}
+			return response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
+		}
+		if err := s.boardRepo.AddActivities(txCtx, activities); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board activity", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	board.ArchivedAt = archivedAt
+	board.ArchiveReason = reason
+	s.publishBoardUpdate(ctx, board, activities)
+	return nil
+}
+
+// checkNotArchived rejects changes to an archived board; it has to be restored first
+func checkNotArchived(board *domain.Board) error {
+	if board.ArchivedAt != nil {
+		return response.NewValidationError("Board is archived", "restore the board before changing it")
+	}
+	return nil
+}
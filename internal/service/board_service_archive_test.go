@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_ArchiveBoard(t *testing.T) {
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "Roadmap"}
+	var archivedID uuid.UUID
+	var recorded []*domain.BoardActivity
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		ArchiveFunc: func(ctx context.Context, id uuid.UUID, archivedAt time.Time, reason *string) error {
+			archivedID = id
+			board.ArchivedAt = &archivedAt
+			return nil
+		},
+		AddActivitiesFunc: func(ctx context.Context, activities []*domain.BoardActivity) error {
+			recorded = append(recorded, activities...)
+			return nil
+		},
+	}
+	publisher := &recordingPublisher{}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(), WithWebhookPublisher(publisher))
+
+	if err := service.ArchiveBoard(context.Background(), board.ID, ""); err != nil {
+		t.Fatalf("ArchiveBoard() unexpected error = %v", err)
+	}
+	if archivedID != board.ID {
+		t.Errorf("archived board = %s, want %s", archivedID, board.ID)
+	}
+	if len(recorded) != 1 || recorded[0].Field != "archivedAt" || recorded[0].OldValue != "" || recorded[0].NewValue == "" {
+		t.Errorf("expected one archivedAt activity, got %+v", recorded)
+	}
+	if len(publisher.events) != 1 || publisher.events[0].ChangedFields[0] != "archivedAt" {
+		t.Errorf("expected a board.updated event for archivedAt, got %+v", publisher.events)
+	}
+
+	// Archiving twice is rejected
+	err := service.ArchiveBoard(context.Background(), board.ID, "")
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("ArchiveBoard() of an archived board error = %v, want %s", err, response.ErrCodeValidation)
+	}
+}
+
+func TestBoardService_ArchiveBoard_Reason(t *testing.T) {
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "Roadmap"}
+	var storedReason *string
+	var recorded []*domain.BoardActivity
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		ArchiveFunc: func(ctx context.Context, id uuid.UUID, archivedAt time.Time, reason *string) error {
+			storedReason = reason
+			return nil
+		},
+		AddActivitiesFunc: func(ctx context.Context, activities []*domain.BoardActivity) error {
+			recorded = append(recorded, activities...)
+			return nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(),
+		WithArchiveReasons([]string{"completed", "cancelled", "duplicate"}))
+
+	// A reason outside the configured set is rejected before anything is stored
+	err := service.ArchiveBoard(context.Background(), board.ID, "abandoned")
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeValidation || appErr.Fields["reason"] == "" {
+		t.Fatalf("ArchiveBoard() with an invalid reason error = %v, want %s on reason", err, response.ErrCodeValidation)
+	}
+	if storedReason != nil || len(recorded) != 0 {
+		t.Fatal("board archived despite the invalid reason")
+	}
+
+	if err := service.ArchiveBoard(context.Background(), board.ID, " completed "); err != nil {
+		t.Fatalf("ArchiveBoard() unexpected error = %v", err)
+	}
+	if storedReason == nil || *storedReason != "completed" {
+		t.Errorf("stored reason = %v, want completed", storedReason)
+	}
+	if len(recorded) != 2 || recorded[1].Field != "archiveReason" || recorded[1].OldValue != "" || recorded[1].NewValue != "completed" {
+		t.Errorf("expected archivedAt and archiveReason activities, got %+v", recorded)
+	}
+}
+
+func TestBoardService_ArchiveBoard_AnyReasonWhenNotEnforced(t *testing.T) {
+	var storedReason *string
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+		ArchiveFunc: func(ctx context.Context, id uuid.UUID, archivedAt time.Time, reason *string) error {
+			storedReason = reason
+			return nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(), WithArchiveReasons(nil))
+
+	if err := service.ArchiveBoard(context.Background(), uuid.New(), "merged into the Q3 roadmap"); err != nil {
+		t.Fatalf("ArchiveBoard() unexpected error = %v", err)
+	}
+	if storedReason == nil || *storedReason != "merged into the Q3 roadmap" {
+		t.Errorf("stored reason = %v, want the free-form reason", storedReason)
+	}
+}
+
+func TestBoardService_ArchivedBoardRejectsUpdates(t *testing.T) {
+	archivedAt := time.Now()
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "Roadmap", ArchivedAt: &archivedAt}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		UpdateFunc: func(ctx context.Context, updated *domain.Board) error {
+			t.Fatal("an archived board must not be updated")
+			return nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop())
+
+	title := "Renamed"
+	_, err := service.UpdateBoard(context.Background(), board.ID, &dto.UpdateBoardRequest{Title: &title})
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation || appErr.Message != "Board is archived" {
+		t.Errorf("UpdateBoard() error = %v, want %s (Board is archived)", err, response.ErrCodeValidation)
+	}
+
+	_, err = service.PatchBoard(context.Background(), board.ID, []dto.PatchOp{{Op: "replace", Path: "/title", Value: []byte(`"Renamed"`)}})
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("PatchBoard() error = %v, want %s", err, response.ErrCodeValidation)
+	}
+}
+
+func TestBoardService_RestoreBoard(t *testing.T) {
+	archivedAt := time.Now()
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "Roadmap", ArchivedAt: &archivedAt}
+	activeCount := int64(2)
+	restored := false
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		CountActiveByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID) (int64, error) {
+			return activeCount, nil
+		},
+		RestoreFunc: func(ctx context.Context, id uuid.UUID) error {
+			restored = true
+			return nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(), WithMaxBoardsPerProject(2))
+
+	// The restored board would exceed the project's board limit
+	err := service.RestoreBoard(context.Background(), board.ID)
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeQuotaExceeded {
+		t.Fatalf("RestoreBoard() at quota error = %v, want %s", err, response.ErrCodeQuotaExceeded)
+	}
+	if restored {
+		t.Fatal("board restored despite the quota")
+	}
+
+	activeCount = 1
+	if err := service.RestoreBoard(context.Background(), board.ID); err != nil {
+		t.Fatalf("RestoreBoard() unexpected error = %v", err)
+	}
+	if !restored {
+		t.Error("expected the board to be restored")
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// orphanedAssigneeBatchSize bounds how many boards are updated per statement when cleaning assignees
+const orphanedAssigneeBatchSize = 100
+
+// autoParticipantField is the activity field of a participant added because they became the assignee
+// It is logged next to the assigneeId change so the participant's provenance stays visible
+const autoParticipantField = "participantAutoAdded"
+
+// assigneeToAutoAdd returns a newly assigned user who is not among participants, or nil
+// Unassigning never removes the participant, so only additions and reassignments count
+func assigneeToAutoAdd(before, after *uuid.UUID, participants []uuid.UUID) *uuid.UUID {
+	if after == nil || *after == uuid.Nil || (before != nil && *before == *after) {
+		return nil
+	}
+	for _, userID := range participants {
+		if userID == *after {
+			return nil
+		}
+	}
+	return after
+}
+
+// autoParticipantActivity records that userID was added as a participant on becoming the assignee
+func autoParticipantActivity(boardID, actorID, userID uuid.UUID) *domain.BoardActivity {
+	return &domain.BoardActivity{
+		BoardID:  boardID,
+		ActorID:  actorID,
+		Field:    autoParticipantField,
+		NewValue: userID.String(),
+	}
+}
+
+// FindOrphanedAssignees lists boards whose assignee is no longer a member of the project
+func (s *boardServiceImpl) FindOrphanedAssignees(ctx context.Context, projectID uuid.UUID) (*dto.OrphanedAssigneesResponse, error) {
+	boards, err := s.findOrphanedAssigneeBoards(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := make([]dto.OrphanedAssignee, 0, len(boards))
+	for _, board := range boards {
+		orphans = append(orphans, dto.OrphanedAssignee{
+			BoardID:    board.ID,
+			Title:      board.Title,
+			AssigneeID: *board.AssigneeID,
+		})
+	}
+
+	return &dto.OrphanedAssigneesResponse{
+		ProjectID: projectID,
+		Boards:    orphans,
+	}, nil
+}
+
+// CleanOrphanedAssignees removes assignees who are no longer project members, in batches
+func (s *boardServiceImpl) CleanOrphanedAssignees(ctx context.Context, projectID uuid.UUID) (*dto.CleanOrphanedAssigneesResponse, error) {
+	boards, err := s.findOrphanedAssigneeBoards(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var cleared int64
+	for start := 0; start < len(boards); start += orphanedAssigneeBatchSize {
+		end := start + orphanedAssigneeBatchSize
+		if end > len(boards) {
+			end = len(boards)
+		}
+
+		boardIDs := make([]uuid.UUID, 0, end-start)
+		for _, board := range boards[start:end] {
+			boardIDs = append(boardIDs, board.ID)
+		}
+
+		n, err := s.boardRepo.ClearOrphanedAssignees(ctx, projectID, boardIDs)
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to clean orphaned assignees", err.Error())
+		}
+		cleared += n
+	}
+
+	if cleared > 0 {
+		s.logger.Info("Cleaned orphaned board assignees",
+			zap.String("project_id", projectID.String()),
+			zap.Int64("cleared", cleared))
+	}
+
+	return &dto.CleanOrphanedAssigneesResponse{
+		ProjectID: projectID,
+		Cleared:   cleared,
+	}, nil
+}
+
+// findOrphanedAssigneeBoards verifies the project and loads its boards with orphaned assignees
+func (s *boardServiceImpl) findOrphanedAssigneeBoards(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error) {
+	_, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	boards, err := s.boardRepo.FindOrphanedAssignees(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to find orphaned assignees", err.Error())
+	}
+	return boards, nil
+}
+
+// projectMemberSet returns the user IDs of a project's members
+func (s *boardServiceImpl) projectMemberSet(ctx context.Context, projectID uuid.UUID) (map[uuid.UUID]bool, error) {
+	members, err := s.projectRepo.FindMembersByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project members", err.Error())
+	}
+	memberSet := make(map[uuid.UUID]bool, len(members))
+	for _, member := range members {
+		memberSet[member.UserID] = true
+	}
+	return memberSet, nil
+}
+
+// validateParticipantMembers rejects requested participants who are not members of the project
+// Every unknown user ID is named in the error fields
+func (s *boardServiceImpl) validateParticipantMembers(ctx context.Context, projectID uuid.UUID, userIDs []uuid.UUID) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	members, err := s.projectMemberSet(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	fields := make(map[string]string)
+	for _, userID := range userIDs {
+		if !members[userID] {
+			fields[userID.String()] = "user is not a member of the project"
+		}
+	}
+	if len(fields) > 0 {
+		return response.NewFieldValidationError("Invalid participants", fields)
+	}
+	return nil
+}
+
+// reconcileParticipants makes userIDs the exact participant set of a board
+// Existing participants who stay keep their membership; any failure is returned so the caller's transaction rolls back
+func (s *boardServiceImpl) reconcileParticipants(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) error {
+	existing, err := s.participantRepo.FindByBoardID(ctx, boardID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch participants", err.Error())
+	}
+
+	wanted := make(map[uuid.UUID]bool, len(userIDs))
+	for _, userID := range userIDs {
+		wanted[userID] = true
+	}
+	current := make(map[uuid.UUID]bool, len(existing))
+	for _, p := range existing {
+		current[p.UserID] = true
+		if wanted[p.UserID] {
+			continue
+		}
+		if err := s.participantRepo.Delete(ctx, boardID, p.UserID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to remove participant", err.Error())
+		}
+	}
+
+	for _, userID := range removeDuplicateUUIDs(userIDs) {
+		if current[userID] {
+			continue
+		}
+		if err := s.participantRepo.Create(ctx, &domain.Participant{BoardID: boardID, UserID: userID}); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to add participant", err.Error())
+		}
+	}
+	return nil
+}
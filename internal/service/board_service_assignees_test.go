@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardService_CleanOrphanedAssignees_Batches(t *testing.T) {
+	projectID := uuid.New()
+	removedUserID := uuid.New()
+
+	boards := make([]*domain.Board, orphanedAssigneeBatchSize+20)
+	for i := range boards {
+		boards[i] = &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, AssigneeID: &removedUserID}
+	}
+
+	var batchSizes []int
+	mockBoardRepo := &MockBoardRepository{
+		FindOrphanedAssigneesFunc: func(ctx context.Context, id uuid.UUID) ([]*domain.Board, error) {
+			return boards, nil
+		},
+		ClearOrphanedAssigneesFunc: func(ctx context.Context, id uuid.UUID, boardIDs []uuid.UUID) (int64, error) {
+			batchSizes = append(batchSizes, len(boardIDs))
+			return int64(len(boardIDs)), nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, logger)
+
+	found, err := service.FindOrphanedAssignees(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("FindOrphanedAssignees() error = %v", err)
+	}
+	if len(found.Boards) != len(boards) || found.Boards[0].AssigneeID != removedUserID {
+		t.Errorf("FindOrphanedAssignees() returned %d boards, want %d with assignee %s", len(found.Boards), len(boards), removedUserID)
+	}
+
+	result, err := service.CleanOrphanedAssignees(context.Background(), projectID)
+	if err != nil {
+		t.Fatalf("CleanOrphanedAssignees() error = %v", err)
+	}
+	if result.Cleared != int64(len(boards)) {
+		t.Errorf("Cleared = %d, want %d", result.Cleared, len(boards))
+	}
+	if len(batchSizes) != 2 || batchSizes[0] != orphanedAssigneeBatchSize || batchSizes[1] != 20 {
+		t.Errorf("batch sizes = %v, want [%d 20]", batchSizes, orphanedAssigneeBatchSize)
+	}
+}
+
+func TestBoardService_UpdateBoard_AssigneeBecomesParticipant(t *testing.T) {
+	boardID := uuid.New()
+	actorID := uuid.New()
+	assigneeID := uuid.New()
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, ProjectID: uuid.New(), Title: "Board"}
+
+	var recorded []*domain.BoardActivity
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		UpdateFunc: func(ctx context.Context, updated *domain.Board) error {
+			board = updated
+			return nil
+		},
+		AddActivitiesFunc: func(ctx context.Context, activities []*domain.BoardActivity) error {
+			recorded = append(recorded, activities...)
+			return nil
+		},
+	}
+	var added, removed []uuid.UUID
+	mockParticipantRepo := &MockParticipantRepository{
+		CreateFunc: func(ctx context.Context, participant *domain.Participant) error {
+			added = append(added, participant.UserID)
+			board.Participants = append(board.Participants, *participant)
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, boardID, userID uuid.UUID) error {
+			removed = append(removed, userID)
+			return nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, mockParticipantRepo,
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop())
+	ctx := context.WithValue(context.Background(), "user_id", actorID)
+
+	if _, err := service.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{AssigneeID: &assigneeID}); err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+
+	if len(added) != 1 || added[0] != assigneeID {
+		t.Fatalf("added participants = %v, want the assignee", added)
+	}
+	// The assignment and the participant it brought along are logged separately
+	if len(recorded) != 2 {
+		t.Fatalf("expected 2 activities, got %d", len(recorded))
+	}
+	if recorded[0].Field != "assigneeId" || recorded[0].NewValue != assigneeID.String() {
+		t.Errorf("first activity = %s -> %q, want the assignment", recorded[0].Field, recorded[0].NewValue)
+	}
+	if recorded[1].Field != autoParticipantField || recorded[1].NewValue != assigneeID.String() || recorded[1].ActorID != actorID {
+		t.Errorf("second activity = %s -> %q, want the automatic participant", recorded[1].Field, recorded[1].NewValue)
+	}
+
+	// Unassigning keeps the participant
+	recorded, added = nil, nil
+	unassign := uuid.Nil
+	if _, err := service.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{AssigneeID: &unassign}); err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+	if len(removed) != 0 || len(added) != 0 {
+		t.Errorf("unassigning changed participants: added %v, removed %v", added, removed)
+	}
+	if len(recorded) != 1 || recorded[0].Field != "assigneeId" {
+		t.Errorf("expected only the unassignment to be logged, got %d activities", len(recorded))
+	}
+}
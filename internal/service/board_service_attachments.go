@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+const (
+	defaultAttachmentPageSize = 20
+	maxAttachmentPageSize     = 100
+)
+
+// attachmentSortColumns maps the public sortBy values to repository sort columns
+var attachmentSortColumns = map[string]string{
+	"":          repository.AttachmentSortCreatedAt,
+	"createdAt": repository.AttachmentSortCreatedAt,
+	"size":      repository.AttachmentSortFileSize,
+}
+
+// ListAttachments returns one page of a board's attachments, newest first unless another order is requested
+// Restricted attachments are listed only for project owners and admins, and for their uploader
+// The cursor is the ID of the last attachment of the previous page
+func (s *boardServiceImpl) ListAttachments(ctx context.Context, boardID uuid.UUID, filter *dto.AttachmentFilter, pagination *dto.AttachmentPagination) (resp *dto.AttachmentPageResponse, err error) {
+	ctx, span := s.startSpan(ctx, "ListAttachments", boardID)
+	defer func() { endSpan(span, err) }()
+
+	if filter == nil {
+		filter = &dto.AttachmentFilter{}
+	}
+	if pagination == nil {
+		pagination = &dto.AttachmentPagination{}
+	}
+
+	column, ok := attachmentSortColumns[pagination.SortBy]
+	if !ok {
+		return nil, response.NewValidationError("Invalid sortBy", "sortBy must be one of: createdAt, size")
+	}
+	if pagination.Order != "" && pagination.Order != "asc" && pagination.Order != "desc" {
+		return nil, response.NewValidationError("Invalid order", "order must be asc or desc")
+	}
+
+	limit := pagination.Limit
+	if limit < 1 || limit > maxAttachmentPageSize {
+		limit = defaultAttachmentPageSize
+	}
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+
+	query := repository.AttachmentListQuery{
+		ContentType: filter.ContentType,
+		IncludeTemp: filter.IncludePending,
+		SortBy:      column,
+		Ascending:   pagination.Order == "asc",
+		// One extra row tells whether another page follows
+		Limit: limit + 1,
+	}
+
+	viewer := actorFromContext(ctx)
+	role, err := s.projectRoleOf(ctx, board.ProjectID, viewer)
+	if err != nil {
+		return nil, err
+	}
+	if role != domain.ProjectRoleOwner && role != domain.ProjectRoleAdmin {
+		query.HideRestricted = true
+		query.Viewer = viewer
+	}
+
+	if pagination.Cursor != "" {
+		after, err := s.resolveAttachmentCursor(ctx, boardID, pagination.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query.After = after
+	}
+
+	attachments, err := s.attachmentRepo.ListByEntityID(ctx, domain.EntityTypeBoard, boardID, query)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachments", err.Error())
+	}
+
+	resp = &dto.AttachmentPageResponse{Attachments: make([]dto.AttachmentResponse, 0, limit)}
+	if len(attachments) > limit {
+		attachments = attachments[:limit]
+		resp.NextCursor = attachments[limit-1].ID.String()
+	}
+	for _, attachment := range attachments {
+		resp.Attachments = append(resp.Attachments, s.toAttachmentResponse(attachment))
+	}
+
+	return resp, nil
+}
+
+// attachmentViewer hides restricted attachments from a caller who may not see them
+// The caller's project role is looked up once, and only when a restricted attachment turns up
+type attachmentViewer struct {
+	s         *boardServiceImpl
+	projectID uuid.UUID
+	userID    uuid.UUID
+	role      domain.ProjectRole
+	resolved  bool
+}
+
+func (s *boardServiceImpl) newAttachmentViewer(ctx context.Context, projectID uuid.UUID) *attachmentViewer {
+	return &attachmentViewer{s: s, projectID: projectID, userID: actorFromContext(ctx)}
+}
+
+// filter drops the attachments the caller may not see
+// If the role lookup fails, restricted attachments are hidden rather than failing the request
+func (v *attachmentViewer) filter(ctx context.Context, attachments []*domain.Attachment) []*domain.Attachment {
+	visible := attachments[:0]
+	for _, attachment := range attachments {
+		if attachment.IsRestricted() && !v.resolved {
+			role, err := v.s.projectRoleOf(ctx, v.projectID, v.userID)
+			if err != nil {
+				v.s.logger.Error("Failed to resolve project role for restricted attachments",
+					zap.String("project_id", v.projectID.String()), zap.Error(err))
+			}
+			v.role, v.resolved = role, true
+		}
+		if attachment.VisibleTo(v.userID, v.role) {
+			visible = append(visible, attachment)
+		}
+	}
+	return visible
+}
+
+// projectRoleOf returns the user's role in the project, or "" when the user is not a member
+func (s *boardServiceImpl) projectRoleOf(ctx context.Context, projectID, userID uuid.UUID) (domain.ProjectRole, error) {
+	if userID == uuid.Nil {
+		return "", nil
+	}
+
+	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil
+		}
+		return "", response.NewAppError(response.ErrCodeInternal, "Failed to fetch project member", err.Error())
+	}
+	if member == nil {
+		return "", nil
+	}
+
+	return member.RoleName, nil
+}
+
+// resolveAttachmentCursor loads the attachment a cursor points at; it must belong to the listed board
+func (s *boardServiceImpl) resolveAttachmentCursor(ctx context.Context, boardID uuid.UUID, cursor string) (*domain.Attachment, error) {
+	cursorID, err := uuid.Parse(cursor)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid cursor", "")
+	}
+
+	after, err := s.attachmentRepo.FindByID(ctx, cursorID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewValidationError("Invalid cursor", "the attachment it points at no longer exists")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to resolve cursor", err.Error())
+	}
+	if after.EntityType != domain.EntityTypeBoard || after.EntityID == nil || *after.EntityID != boardID {
+		return nil, response.NewValidationError("Invalid cursor", "")
+	}
+
+	return after, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_ListAttachments(t *testing.T) {
+	boardID := uuid.New()
+	stored := []*domain.Attachment{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, FileName: "a.png", ContentType: "image/png"},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, FileName: "b.png", ContentType: "image/png"},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, FileName: "c.png", ContentType: "image/png"},
+	}
+
+	tests := []struct {
+		name           string
+		pagination     *dto.AttachmentPagination
+		wantErrCode    string
+		wantCount      int
+		wantNextCursor string
+	}{
+		{
+			name:           "성공: 다음 페이지가 있으면 마지막 항목을 cursor로 반환",
+			pagination:     &dto.AttachmentPagination{SortBy: "size", Order: "asc", Limit: 2},
+			wantCount:      2,
+			wantNextCursor: stored[1].ID.String(),
+		},
+		{
+			name:       "성공: 마지막 페이지는 cursor 없음",
+			pagination: &dto.AttachmentPagination{Limit: 5},
+			wantCount:  3,
+		},
+		{
+			name:        "실패: 지원하지 않는 정렬 기준",
+			pagination:  &dto.AttachmentPagination{SortBy: "position"},
+			wantErrCode: response.ErrCodeValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var gotQuery repository.AttachmentListQuery
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}}, nil
+				},
+			}
+			mockAttachmentRepo := &MockAttachmentRepository{
+				ListByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID, query repository.AttachmentListQuery) ([]*domain.Attachment, error) {
+					gotQuery = query
+					if query.Limit < len(stored) {
+						return stored[:query.Limit], nil
+					}
+					return stored, nil
+				},
+			}
+			service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+				mockAttachmentRepo, &MockS3Client{}, &MockFieldOptionConverter{}, nil, zap.NewNop())
+
+			// When
+			got, err := service.ListAttachments(context.Background(), boardID, &dto.AttachmentFilter{ContentType: "image/"}, tt.pagination)
+
+			// Then
+			if tt.wantErrCode != "" {
+				var appErr *response.AppError
+				if !errors.As(err, &appErr) || appErr.Code != tt.wantErrCode {
+					t.Fatalf("ListAttachments() error = %v, want code %s", err, tt.wantErrCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListAttachments() unexpected error = %v", err)
+			}
+			if len(got.Attachments) != tt.wantCount {
+				t.Errorf("attachments = %d, want %d", len(got.Attachments), tt.wantCount)
+			}
+			if got.NextCursor != tt.wantNextCursor {
+				t.Errorf("NextCursor = %q, want %q", got.NextCursor, tt.wantNextCursor)
+			}
+			if gotQuery.ContentType != "image/" || gotQuery.IncludeTemp {
+				t.Errorf("repository query = %+v, want confirmed image/ attachments", gotQuery)
+			}
+		})
+	}
+}
@@ -0,0 +1,716 @@
+package service
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// BoardBackupFormatVersion is the version of the project backup layout written by ExportProjectBackup
+const BoardBackupFormatVersion = 1
+
+// A project backup is a gzip-compressed tar archive:
+//
+//	manifest.json                                   dto.BoardBackupManifest, always the first entry
+//	boards/{boardId}.json                           dto.BoardBackupRecord
+//	boards/{boardId}/attachments/{attachmentId}     attachment object, right after its board's record
+const boardBackupManifestName = "manifest.json"
+
+// ExportProjectBackup writes every board of a project, archived ones included, to w as a project backup
+// Boards are read page by page and attachment objects are streamed from S3, so memory use does not grow with the project
+// Nothing is written when the project cannot be found; a failure after that leaves w with a truncated archive
+func (s *boardServiceImpl) ExportProjectBackup(ctx context.Context, projectID uuid.UUID, w io.Writer) (err error) {
+	ctx, span := s.startSpan(ctx, "ExportProjectBackup", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+	manifest, err := s.backupManifest(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeBackupJSON(tw, boardBackupManifestName, manifest); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to write backup", err.Error())
+	}
+
+	query := repository.BoardPageQuery{
+		BoardListFilter: repository.BoardListFilter{IncludeArchived: true},
+		Ascending:       true,
+		Limit:           maxBoardPageSize,
+	}
+	for {
+		boards, err := s.boardRepo.ListByProjectID(ctx, projectID, query)
+		if err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+		}
+		if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+		}
+		for _, board := range boards {
+			if err := s.writeBoardBackup(ctx, tw, board); err != nil {
+				return err
+			}
+		}
+		if len(boards) < query.Limit {
+			break
+		}
+		query.After = boards[len(boards)-1]
+	}
+
+	if err := tw.Close(); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to write backup", err.Error())
+	}
+	if err := gz.Close(); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to write backup", err.Error())
+	}
+	return nil
+}
+
+// backupManifest describes the project's field options and labels for a backup
+func (s *boardServiceImpl) backupManifest(ctx context.Context, projectID uuid.UUID) (*dto.BoardBackupManifest, error) {
+	manifest := &dto.BoardBackupManifest{
+		FormatVersion: BoardBackupFormatVersion,
+		ProjectID:     projectID,
+		ExportedAt:    time.Now().UTC(),
+		FieldOptions:  []dto.BoardBackupFieldOption{},
+		Labels:        []dto.BoardBackupLabel{},
+	}
+
+	options, err := s.fieldOptionRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch field options", err.Error())
+	}
+	for _, option := range options {
+		manifest.FieldOptions = append(manifest.FieldOptions, dto.BoardBackupFieldOption{
+			FieldType:    string(option.FieldType),
+			Value:        option.Value,
+			Label:        option.Label,
+			Color:        option.Color,
+			DisplayOrder: option.DisplayOrder,
+			IsArchived:   option.IsArchived,
+		})
+	}
+
+	if s.labelRepo != nil {
+		labels, err := s.labelRepo.FindByProjectID(ctx, projectID)
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch labels", err.Error())
+		}
+		for _, label := range labels {
+			manifest.Labels = append(manifest.Labels, dto.BoardBackupLabel{Name: label.Name, Color: label.Color})
+		}
+	}
+	return manifest, nil
+}
+
+// writeBoardBackup writes a board whose custom fields already hold option values, followed by its attachment objects
+func (s *boardServiceImpl) writeBoardBackup(ctx context.Context, tw *tar.Writer, board *domain.Board) error {
+	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachments", err.Error())
+	}
+
+	record := toBoardBackupRecord(board, attachments)
+	if err := writeBackupJSON(tw, boardBackupRecordName(board.ID), record); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to write backup", err.Error())
+	}
+
+	for _, attachment := range attachments {
+		if err := s.writeAttachmentBackup(ctx, tw, board.ID, attachment); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to write attachment to backup",
+				fmt.Sprintf("attachment %s: %s", attachment.ID, err.Error()))
+		}
+	}
+	return nil
+}
+
+// writeAttachmentBackup streams one attachment object from S3 into the archive
+func (s *boardServiceImpl) writeAttachmentBackup(ctx context.Context, tw *tar.Writer, boardID uuid.UUID, attachment *domain.Attachment) error {
+	object, size, err := s.s3Client.OpenFile(ctx, attachment.FileURL)
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name:    boardBackupAttachmentName(boardID, attachment.ID),
+		Mode:    0o644,
+		Size:    size,
+		ModTime: attachment.CreatedAt,
+	}); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, object)
+	return err
+}
+
+// toBoardBackupRecord converts a board whose custom fields already hold option values
+func toBoardBackupRecord(board *domain.Board, attachments []*domain.Attachment) *dto.BoardBackupRecord {
+	customFields := map[string]interface{}{}
+	if len(board.CustomFields) > 0 {
+		_ = json.Unmarshal(board.CustomFields, &customFields)
+	}
+
+	record := &dto.BoardBackupRecord{
+		ID:            board.ID,
+		Title:         board.Title,
+		Content:       board.Content,
+		AuthorID:      board.AuthorID,
+		AssigneeID:    board.AssigneeID,
+		Participants:  make([]uuid.UUID, 0, len(board.Participants)),
+		CustomFields:  customFields,
+		Labels:        make([]string, 0, len(board.Labels)),
+		StartDate:     utcTime(board.StartDate),
+		DueDate:       utcTime(board.DueDate),
+		EstimateHours: board.EstimateHours,
+		ActualHours:   board.ActualHours,
+		ExternalID:    board.ExternalID,
+		ArchivedAt:    utcTime(board.ArchivedAt),
+		ArchiveReason: board.ArchiveReason,
+		CreatedAt:     board.CreatedAt.UTC(),
+		UpdatedAt:     board.UpdatedAt.UTC(),
+		Attachments:   make([]dto.BoardBackupAttachment, 0, len(attachments)),
+	}
+	for _, participant := range board.Participants {
+		record.Participants = append(record.Participants, participant.UserID)
+	}
+	for _, label := range board.Labels {
+		record.Labels = append(record.Labels, label.Name)
+	}
+	for _, attachment := range attachments {
+		record.Attachments = append(record.Attachments, dto.BoardBackupAttachment{
+			ID:             attachment.ID,
+			FileName:       attachment.FileName,
+			ContentType:    attachment.ContentType,
+			FileSize:       attachment.FileSize,
+			ChecksumSHA256: attachment.ChecksumSHA256,
+			UploadedBy:     attachment.UploadedBy,
+			Visibility:     string(attachment.Visibility),
+			CreatedAt:      attachment.CreatedAt.UTC(),
+		})
+	}
+	return record
+}
+
+// writeBackupJSON writes v as a JSON entry of the archive
+func writeBackupJSON(tw *tar.Writer, name string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: time.Now().UTC()}); err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+func boardBackupRecordName(boardID uuid.UUID) string {
+	return "boards/" + boardID.String() + ".json"
+}
+
+func boardBackupAttachmentName(boardID, attachmentID uuid.UUID) string {
+	return "boards/" + boardID.String() + "/attachments/" + attachmentID.String()
+}
+
+// ImportProjectBackup restores the boards of a project backup, with their attachments, into a project
+// Restored boards and attachments get new IDs; authors, participants, dates and archive state are kept as backed up
+// Field options and labels the project lacks are created from the manifest first and kept even if the import fails.
+// The whole archive is read and its attachment objects uploaded before any board is written, so no transaction is
+// held open across uploads. Each board is then restored in its own transaction under the board quota: a title or
+// external ID already taken in the project is replaced and reported, and a board that cannot be restored is
+// reported in the response while its uploaded objects are deleted again.
+func (s *boardServiceImpl) ImportProjectBackup(ctx context.Context, projectID uuid.UUID, r io.Reader) (resp *dto.ImportBoardBackupResponse, err error) {
+	ctx, span := s.startSpan(ctx, "ImportProjectBackup", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid backup archive", "backup must be a gzip-compressed tar archive")
+	}
+	defer gz.Close()
+	tr := tar.NewReader(gz)
+
+	manifest, err := readBackupManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+
+	restore := &backupRestore{
+		project: project,
+		resp:    &dto.ImportBoardBackupResponse{BoardIDs: make(map[uuid.UUID]uuid.UUID)},
+	}
+	if err := s.restoreBackupSchema(ctx, restore, manifest); err != nil {
+		return nil, err
+	}
+
+	err = s.readBackupBoards(ctx, tr, restore)
+	if err == nil {
+		err = s.convertBackupCustomFields(ctx, restore)
+	}
+	if err != nil {
+		// No record refers to the objects uploaded so far
+		for _, board := range restore.boards {
+			s.deleteCopiedFiles(ctx, board.attachments)
+		}
+		return nil, err
+	}
+
+	for _, board := range restore.boards {
+		var issues []string
+		err := s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+			var err error
+			issues, err = s.restoreBackupBoard(txCtx, restore, board)
+			return err
+		})
+		if err != nil {
+			// The board's rows were rolled back, so its objects are not referenced anymore
+			s.deleteCopiedFiles(ctx, board.attachments)
+			message := "Failed to restore board"
+			var appErr *response.AppError
+			if errors.As(err, &appErr) {
+				message = appErr.Message
+			}
+			restore.resp.Failed++
+			restore.resp.Issues = append(restore.resp.Issues, dto.BoardBackupIssue{SourceBoardID: board.record.ID, Message: message})
+			s.logger.Warn("Failed to restore board from backup",
+				zap.String("project_id", projectID.String()),
+				zap.String("source_board_id", board.record.ID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		for _, issue := range issues {
+			restore.resp.Issues = append(restore.resp.Issues, dto.BoardBackupIssue{SourceBoardID: board.record.ID, Restored: true, Message: issue})
+		}
+		restore.resp.Boards++
+		restore.resp.Attachments += len(board.attachments)
+		restore.resp.BoardIDs[board.record.ID] = board.restoredID
+	}
+
+	if s.metrics != nil {
+		for range restore.resp.Boards {
+			s.metrics.IncrementBoardCreated()
+		}
+	}
+	s.logger.Info("Project backup imported",
+		zap.String("project_id", projectID.String()),
+		zap.String("source_project_id", manifest.ProjectID.String()),
+		zap.Int("boards", restore.resp.Boards),
+		zap.Int("failed", restore.resp.Failed),
+		zap.Int("attachments", restore.resp.Attachments))
+
+	return restore.resp, nil
+}
+
+// maxBackupTitleSuffix bounds the numbered titles tried for a restored board whose title is taken
+const maxBackupTitleSuffix = 100
+
+// backupRestore is the state of an import in progress
+type backupRestore struct {
+	project *domain.Project
+	// labelIDs resolve the label names a record refers to in the target project
+	labelIDs map[string]uuid.UUID
+	// boards are the records read so far, in archive order; the last one is still receiving its attachments
+	boards []*backupBoard
+	// pending are the latest record's attachments whose objects have not been read yet
+	pending map[uuid.UUID]dto.BoardBackupAttachment
+	resp    *dto.ImportBoardBackupResponse
+}
+
+// backupBoard is a board record read from the archive, ready to be restored
+type backupBoard struct {
+	record       *dto.BoardBackupRecord
+	customFields datatypes.JSON
+	labelIDs     []uuid.UUID
+	// attachments hold the uploaded objects; they are linked to the board once it is restored
+	attachments []*domain.Attachment
+	restoredID  uuid.UUID
+}
+
+// readBackupManifest reads and checks the first entry of a backup
+func readBackupManifest(tr *tar.Reader) (*dto.BoardBackupManifest, error) {
+	header, err := tr.Next()
+	if err != nil {
+		return nil, response.NewValidationError("Invalid backup archive", "backup must be a gzip-compressed tar archive")
+	}
+	if header.Name != boardBackupManifestName {
+		return nil, response.NewValidationError("Invalid backup archive", "backup must start with "+boardBackupManifestName)
+	}
+
+	var manifest dto.BoardBackupManifest
+	if err := json.NewDecoder(tr).Decode(&manifest); err != nil {
+		return nil, response.NewValidationError("Invalid backup manifest", err.Error())
+	}
+	if manifest.FormatVersion != BoardBackupFormatVersion {
+		return nil, response.NewValidationError("Unsupported backup format",
+			fmt.Sprintf("format version %d, expected %d", manifest.FormatVersion, BoardBackupFormatVersion))
+	}
+	return &manifest, nil
+}
+
+// restoreBackupSchema creates the manifest's field options and labels the project does not define yet
+func (s *boardServiceImpl) restoreBackupSchema(ctx context.Context, restore *backupRestore, manifest *dto.BoardBackupManifest) error {
+	projectID := restore.project.ID
+
+	options, err := s.fieldOptionRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch field options", err.Error())
+	}
+	defined := make(map[domain.FieldType]map[string]bool)
+	addOption := func(option *domain.FieldOption) {
+		if defined[option.FieldType] == nil {
+			defined[option.FieldType] = make(map[string]bool)
+		}
+		defined[option.FieldType][option.Value] = true
+	}
+	for _, option := range options {
+		addOption(option)
+	}
+	for _, backedUp := range manifest.FieldOptions {
+		fieldType := domain.FieldType(backedUp.FieldType)
+		if defined[fieldType][backedUp.Value] {
+			continue
+		}
+		switch fieldType {
+		case domain.FieldTypeStage, domain.FieldTypeRole, domain.FieldTypeImportance:
+		default:
+			return response.NewValidationError("Invalid backup manifest", fmt.Sprintf("unknown field '%s'", backedUp.FieldType))
+		}
+
+		option := &domain.FieldOption{
+			ProjectID:    &projectID,
+			FieldType:    fieldType,
+			Value:        backedUp.Value,
+			Label:        backedUp.Label,
+			Color:        backedUp.Color,
+			DisplayOrder: backedUp.DisplayOrder,
+			IsArchived:   backedUp.IsArchived,
+		}
+		if err := s.fieldOptionRepo.Create(ctx, option); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to restore field option", err.Error())
+		}
+		addOption(option)
+		restore.resp.FieldOptions++
+	}
+
+	restore.labelIDs = make(map[string]uuid.UUID)
+	if len(manifest.Labels) == 0 {
+		return nil
+	}
+	if s.labelRepo == nil {
+		return response.NewValidationError("Labels are not supported", "backup contains labels")
+	}
+	labels, err := s.labelRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch labels", err.Error())
+	}
+	for _, label := range labels {
+		restore.labelIDs[label.Name] = label.ID
+	}
+	for _, backedUp := range manifest.Labels {
+		if _, exists := restore.labelIDs[backedUp.Name]; exists {
+			continue
+		}
+		label := &domain.Label{ProjectID: projectID, Name: backedUp.Name, Color: backedUp.Color}
+		if err := s.labelRepo.Create(ctx, label); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to restore label", err.Error())
+		}
+		restore.labelIDs[label.Name] = label.ID
+		restore.resp.Labels++
+	}
+	return nil
+}
+
+// readBackupBoards reads the board records following the manifest and uploads their attachment objects
+// Records are checked against the manifest here, so an invalid archive is rejected before any board is written
+func (s *boardServiceImpl) readBackupBoards(ctx context.Context, tr *tar.Reader, restore *backupRestore) error {
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return response.NewValidationError("Invalid backup archive", err.Error())
+		}
+
+		name := header.Name
+		switch {
+		case path.Dir(name) == "boards" && path.Ext(name) == ".json":
+			if err := restore.checkComplete(); err != nil {
+				return err
+			}
+			var record dto.BoardBackupRecord
+			if err := json.NewDecoder(tr).Decode(&record); err != nil {
+				return response.NewValidationError("Invalid board record", fmt.Sprintf("%s: %s", name, err.Error()))
+			}
+			if err := s.readBackupRecord(restore, &record); err != nil {
+				return err
+			}
+		case strings.HasPrefix(name, "boards/") && path.Base(path.Dir(name)) == "attachments":
+			attachment, err := restore.pendingAttachment(name)
+			if err != nil {
+				return err
+			}
+			if err := s.uploadBackupAttachment(ctx, restore, attachment, tr); err != nil {
+				return err
+			}
+		default:
+			return response.NewValidationError("Invalid backup archive", "unexpected entry "+name)
+		}
+	}
+	return restore.checkComplete()
+}
+
+// readBackupRecord resolves the label names of a record in the target project
+// Custom field values are converted once every record has been read, see convertBackupCustomFields
+func (s *boardServiceImpl) readBackupRecord(restore *backupRestore, record *dto.BoardBackupRecord) error {
+	board := &backupBoard{record: record}
+	board.labelIDs = make([]uuid.UUID, 0, len(record.Labels))
+	for _, name := range record.Labels {
+		labelID, ok := restore.labelIDs[name]
+		if !ok {
+			return response.NewValidationError("Invalid board record",
+				fmt.Sprintf("board %s: label '%s' is not defined in the backup", record.ID, name))
+		}
+		board.labelIDs = append(board.labelIDs, labelID)
+	}
+
+	restore.boards = append(restore.boards, board)
+	restore.pending = make(map[uuid.UUID]dto.BoardBackupAttachment, len(record.Attachments))
+	for _, attachment := range record.Attachments {
+		restore.pending[attachment.ID] = attachment
+	}
+	return nil
+}
+
+// convertBackupCustomFields converts the custom field values of every record read to the target's option IDs
+// All records are converted in one batch; options archived in the backup were restored archived and stay selectable
+func (s *boardServiceImpl) convertBackupCustomFields(ctx context.Context, restore *backupRestore) error {
+	customFields := make([]map[string]interface{}, len(restore.boards))
+	for i, board := range restore.boards {
+		customFields[i] = board.record.CustomFields
+	}
+	results, err := s.fieldOptionConverter.ConvertValuesToIDsBatch(converter.WithArchivedOnWrite(ctx), restore.project.ID, customFields)
+	if err != nil {
+		return customFieldsError(err)
+	}
+
+	for i, board := range restore.boards {
+		if len(board.record.CustomFields) == 0 {
+			continue
+		}
+		if results[i].Err != nil {
+			var fieldErr *converter.FieldValuesError
+			if !errors.As(results[i].Err, &fieldErr) {
+				return customFieldsError(results[i].Err)
+			}
+			return response.NewValidationError("Invalid board record", fmt.Sprintf("board %s: %s", board.record.ID, fieldErr.Error()))
+		}
+		jsonBytes, err := s.marshalCustomFields(results[i].Fields)
+		if err != nil {
+			return err
+		}
+		board.customFields = jsonBytes
+	}
+	return nil
+}
+
+// restoreBackupBoard creates the board of a record, with its labels and attachments, in the target project
+// It runs in the board's own transaction and returns the changes made to fit the board into the project
+func (s *boardServiceImpl) restoreBackupBoard(ctx context.Context, restore *backupRestore, backup *backupBoard) ([]string, error) {
+	record := backup.record
+	projectID := restore.project.ID
+	if err := s.checkBoardQuota(ctx, projectID, 1); err != nil {
+		return nil, err
+	}
+
+	board := &domain.Board{
+		BaseModel:     domain.BaseModel{CreatedAt: record.CreatedAt, UpdatedAt: record.UpdatedAt},
+		ProjectID:     projectID,
+		AuthorID:      record.AuthorID,
+		AssigneeID:    record.AssigneeID,
+		Title:         record.Title,
+		Content:       record.Content,
+		CustomFields:  backup.customFields,
+		StartDate:     record.StartDate,
+		DueDate:       record.DueDate,
+		EstimateHours: record.EstimateHours,
+		ActualHours:   record.ActualHours,
+		ExternalID:    record.ExternalID,
+		ArchivedAt:    record.ArchivedAt,
+		ArchiveReason: record.ArchiveReason,
+		TitleUnique:   restore.project.EnforceUniqueTitles,
+	}
+
+	var issues []string
+	if board.TitleUnique {
+		title, err := s.availableBackupTitle(ctx, projectID, record.Title)
+		if err != nil {
+			return nil, err
+		}
+		if title != record.Title {
+			board.Title = title
+			issues = append(issues, fmt.Sprintf("title '%s' is already used in the project; restored as '%s'", record.Title, title))
+		}
+	}
+	if record.ExternalID != nil {
+		_, err := s.boardRepo.FindByExternalID(ctx, projectID, *record.ExternalID)
+		switch {
+		case err == nil:
+			board.ExternalID = nil
+			issues = append(issues, fmt.Sprintf("external ID '%s' is already used in the project; restored without it", *record.ExternalID))
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check external ID", err.Error())
+		}
+	}
+
+	// Participants are inserted together with the board through its association
+	for _, userID := range record.Participants {
+		board.Participants = append(board.Participants, domain.Participant{UserID: userID})
+	}
+
+	if err := s.boardRepo.Create(ctx, board); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to restore board", fmt.Sprintf("board %s: %s", record.ID, err.Error()))
+	}
+	if len(backup.labelIDs) > 0 {
+		if err := s.labelRepo.AddToBoard(ctx, board.ID, backup.labelIDs); err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to restore labels", fmt.Sprintf("board %s: %s", record.ID, err.Error()))
+		}
+	}
+	for _, attachment := range backup.attachments {
+		attachment.EntityID = &board.ID
+		if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to restore attachment",
+				fmt.Sprintf("board %s: %s", record.ID, err.Error()))
+		}
+	}
+
+	backup.restoredID = board.ID
+	return issues, nil
+}
+
+// availableBackupTitle returns title, or the first "title (n)" no active board of the project holds
+func (s *boardServiceImpl) availableBackupTitle(ctx context.Context, projectID uuid.UUID, title string) (string, error) {
+	candidate := title
+	for n := 2; ; n++ {
+		err := s.checkTitleAvailable(ctx, projectID, uuid.Nil, candidate)
+		var appErr *response.AppError
+		if err == nil || !errors.As(err, &appErr) || appErr.Code != response.ErrCodeConflict || n > maxBackupTitleSuffix {
+			return candidate, err
+		}
+		candidate = fmt.Sprintf("%s (%d)", title, n)
+	}
+}
+
+// uploadBackupAttachment uploads an attachment object read from the archive for the latest record
+// The S3 client needs a seekable body, so the object is spooled to a temporary file rather than held in memory
+func (s *boardServiceImpl) uploadBackupAttachment(ctx context.Context, restore *backupRestore, backedUp dto.BoardBackupAttachment, object io.Reader) error {
+	spool, err := os.CreateTemp("", "board-backup-*")
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to restore attachment", err.Error())
+	}
+	defer os.Remove(spool.Name())
+	defer spool.Close()
+
+	size, err := io.Copy(spool, object)
+	if err == nil {
+		_, err = spool.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to restore attachment", err.Error())
+	}
+
+	fileKey, err := s.s3Client.GenerateFileKey("boards", restore.project.WorkspaceID.String(), filepath.Ext(backedUp.FileName))
+	if err == nil {
+		_, err = s.s3Client.UploadFile(ctx, fileKey, spool, backedUp.ContentType)
+	}
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to upload attachment",
+			fmt.Sprintf("attachment %s: %s", backedUp.ID, err.Error()))
+	}
+
+	visibility := domain.AttachmentVisibility(backedUp.Visibility)
+	if visibility == "" {
+		visibility = domain.AttachmentVisibilityBoard
+	}
+	board := restore.boards[len(restore.boards)-1]
+	board.attachments = append(board.attachments, &domain.Attachment{
+		BaseModel:      domain.BaseModel{ID: uuid.New()},
+		EntityType:     domain.EntityTypeBoard,
+		Status:         domain.AttachmentStatusConfirmed,
+		FileName:       backedUp.FileName,
+		FileURL:        fileKey,
+		FileSize:       size,
+		ContentType:    backedUp.ContentType,
+		UploadedBy:     backedUp.UploadedBy,
+		ChecksumSHA256: backedUp.ChecksumSHA256,
+		Visibility:     visibility,
+		ProjectID:      &restore.project.ID,
+	})
+
+	delete(restore.pending, backedUp.ID)
+	return nil
+}
+
+// pendingAttachment returns the metadata of the attachment object stored under name
+// Objects must follow the record of their board and be listed in it
+func (r *backupRestore) pendingAttachment(name string) (dto.BoardBackupAttachment, error) {
+	boardID, _ := uuid.Parse(path.Base(path.Dir(path.Dir(name))))
+	attachmentID, _ := uuid.Parse(path.Base(name))
+	attachment, ok := r.pending[attachmentID]
+	if len(r.boards) == 0 || boardID != r.boards[len(r.boards)-1].record.ID || !ok {
+		return dto.BoardBackupAttachment{}, response.NewValidationError("Invalid backup archive", "unexpected attachment "+name)
+	}
+	return attachment, nil
+}
+
+// checkComplete reports attachments of the latest record whose objects are missing from the archive
+func (r *backupRestore) checkComplete() error {
+	if len(r.pending) == 0 {
+		return nil
+	}
+	missing := make([]string, 0, len(r.pending))
+	for id := range r.pending {
+		missing = append(missing, id.String())
+	}
+	sort.Strings(missing)
+	return response.NewValidationError("Invalid backup archive",
+		fmt.Sprintf("board %s: missing attachments %s", r.boards[len(r.boards)-1].record.ID, strings.Join(missing, ", ")))
+}
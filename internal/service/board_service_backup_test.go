@@ -0,0 +1,462 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// backupTestStore keeps the records and S3 objects of the mocks used by the backup tests
+type backupTestStore struct {
+	projects    map[uuid.UUID]*domain.Project
+	options     []*domain.FieldOption
+	labels      []*domain.Label
+	boards      []*domain.Board
+	boardLabels map[uuid.UUID][]uuid.UUID
+	attachments []*domain.Attachment
+	objects     map[string][]byte
+	// conversions counts the batch value-to-ID conversions
+	conversions int
+	// uploadsInTx counts the objects uploaded inside a transaction of a recordingTransactor
+	uploadsInTx int
+}
+
+// mocks wires the repositories, S3 client and converter to the records and objects of the store
+func (store *backupTestStore) mocks() boardServiceMocks {
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return store.projects[id], nil
+		},
+	}
+	fieldOptionRepo := &MockFieldOptionRepository{
+		FindByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error) {
+			var options []*domain.FieldOption
+			for _, option := range store.options {
+				if *option.ProjectID == projectID {
+					options = append(options, option)
+				}
+			}
+			return options, nil
+		},
+		CreateFunc: func(ctx context.Context, option *domain.FieldOption) error {
+			option.ID = uuid.New()
+			store.options = append(store.options, option)
+			return nil
+		},
+	}
+	labelRepo := &MockLabelRepository{
+		FindByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error) {
+			var labels []*domain.Label
+			for _, label := range store.labels {
+				if label.ProjectID == projectID {
+					labels = append(labels, label)
+				}
+			}
+			return labels, nil
+		},
+		CreateFunc: func(ctx context.Context, label *domain.Label) error {
+			label.ID = uuid.New()
+			store.labels = append(store.labels, label)
+			return nil
+		},
+		AddToBoardFunc: func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+			store.boardLabels[boardID] = append(store.boardLabels[boardID], labelIDs...)
+			return nil
+		},
+	}
+	boardRepo := &MockBoardRepository{
+		ListByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error) {
+			var page []*domain.Board
+			after := query.After == nil
+			for _, board := range store.boards {
+				if board.ProjectID != projectID {
+					continue
+				}
+				if after && len(page) < query.Limit {
+					// Return copies, as the export converts custom fields in place
+					copied := *board
+					page = append(page, &copied)
+				}
+				if query.After != nil && board.ID == query.After.ID {
+					after = true
+				}
+			}
+			return page, nil
+		},
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			store.boards = append(store.boards, board)
+			return nil
+		},
+		FindByUniqueTitleFunc: func(ctx context.Context, projectID uuid.UUID, title string) (*domain.Board, error) {
+			for _, board := range store.boards {
+				if board.ProjectID == projectID && board.TitleUnique && board.Title == title {
+					return board, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindByExternalIDFunc: func(ctx context.Context, projectID uuid.UUID, externalID string) (*domain.Board, error) {
+			for _, board := range store.boards {
+				if board.ProjectID == projectID && board.ExternalID != nil && *board.ExternalID == externalID {
+					return board, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		CountActiveByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID) (int64, error) {
+			var count int64
+			for _, board := range store.boards {
+				if board.ProjectID == projectID && board.ArchivedAt == nil {
+					count++
+				}
+			}
+			return count, nil
+		},
+	}
+	attachmentRepo := &MockAttachmentRepository{
+		FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+			var attachments []*domain.Attachment
+			for _, attachment := range store.attachments {
+				if attachment.EntityType == entityType && *attachment.EntityID == entityID {
+					attachments = append(attachments, attachment)
+				}
+			}
+			return attachments, nil
+		},
+		CreateFunc: func(ctx context.Context, attachment *domain.Attachment) error {
+			store.attachments = append(store.attachments, attachment)
+			return nil
+		},
+	}
+	s3Client := &MockS3Client{
+		GenerateFileKeyFunc: func(entityType, workspaceID, fileExt string) (string, error) {
+			return entityType + "/" + workspaceID + "/" + uuid.NewString() + fileExt, nil
+		},
+		UploadFileFunc: func(ctx context.Context, key string, file io.Reader, contentType string) (string, error) {
+			data, err := io.ReadAll(file)
+			if err != nil {
+				return "", err
+			}
+			store.objects[key] = data
+			if inRecordedTransaction(ctx) {
+				store.uploadsInTx++
+			}
+			return key, nil
+		},
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			delete(store.objects, key)
+			return nil
+		},
+		OpenFileFunc: func(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+			data, ok := store.objects[key]
+			if !ok {
+				return nil, 0, errors.New("no such key")
+			}
+			return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+		},
+	}
+	optionConverter := &MockFieldOptionConverter{
+		ConvertValuesToIDsBatchFunc: func(ctx context.Context, projectID uuid.UUID, customFields []map[string]interface{}) ([]converter.ConvertedFields, error) {
+			store.conversions++
+			results := make([]converter.ConvertedFields, len(customFields))
+			for i, fields := range customFields {
+				results[i].Fields = make(map[string]interface{}, len(fields))
+				for key, value := range fields {
+					for _, option := range store.options {
+						if *option.ProjectID == projectID && string(option.FieldType) == key && option.Value == value {
+							results[i].Fields[key] = option.ID.String()
+						}
+					}
+				}
+			}
+			return results, nil
+		},
+		ConvertIDsToValuesBatchFunc: func(ctx context.Context, boards []*domain.Board) error {
+			values := make(map[string]string, len(store.options))
+			for _, option := range store.options {
+				values[option.ID.String()] = option.Value
+			}
+			for _, board := range boards {
+				var fields map[string]interface{}
+				_ = json.Unmarshal(board.CustomFields, &fields)
+				for key, id := range fields {
+					fields[key] = values[id.(string)]
+				}
+				board.CustomFields, _ = json.Marshal(fields)
+			}
+			return nil
+		},
+	}
+
+	return boardServiceMocks{
+		boardRepo:       boardRepo,
+		projectRepo:     projectRepo,
+		fieldOptionRepo: fieldOptionRepo,
+		attachmentRepo:  attachmentRepo,
+		labelRepo:       labelRepo,
+		s3Client:        s3Client,
+		converter:       optionConverter,
+	}
+}
+
+func TestBoardService_ProjectBackup_RoundTrip(t *testing.T) {
+	source := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New()}
+	target := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New()}
+	store := &backupTestStore{
+		projects:    map[uuid.UUID]*domain.Project{source.ID: source, target.ID: target},
+		boardLabels: map[uuid.UUID][]uuid.UUID{},
+		objects:     map[string][]byte{},
+	}
+
+	// The target already defines "todo", so only "done" is created from the manifest
+	store.options = []*domain.FieldOption{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: &source.ID, FieldType: domain.FieldTypeStage, Value: "todo"},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: &source.ID, FieldType: domain.FieldTypeStage, Value: "done", IsArchived: true},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: &target.ID, FieldType: domain.FieldTypeStage, Value: "todo"},
+	}
+	release := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: source.ID, Name: "release", Color: "#10B981"}
+	store.labels = []*domain.Label{release}
+
+	authorID, participantID := uuid.New(), uuid.New()
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	reason := "completed"
+	// More boards than one page, so the export pages through the project
+	for i := 0; i < maxBoardPageSize+2; i++ {
+		store.boards = append(store.boards, &domain.Board{
+			BaseModel:    domain.BaseModel{ID: uuid.New(), CreatedAt: created, UpdatedAt: created},
+			ProjectID:    source.ID,
+			AuthorID:     authorID,
+			Title:        "Board",
+			CustomFields: []byte(`{"stage":"` + store.options[0].ID.String() + `"}`),
+		})
+	}
+	first := store.boards[0]
+	first.Title = "Launch"
+	first.CustomFields = []byte(`{"stage":"` + store.options[1].ID.String() + `"}`)
+	first.Participants = []domain.Participant{{UserID: participantID}}
+	first.Labels = []domain.Label{*release}
+	first.ArchivedAt = &created
+	first.ArchiveReason = &reason
+
+	for i, content := range []string{"first file", "second file"} {
+		key := "boards/" + uuid.NewString() + ".txt"
+		store.objects[key] = []byte(content)
+		store.attachments = append(store.attachments, &domain.Attachment{
+			BaseModel:   domain.BaseModel{ID: uuid.New()},
+			EntityType:  domain.EntityTypeBoard,
+			EntityID:    &first.ID,
+			Status:      domain.AttachmentStatusConfirmed,
+			FileName:    []string{"a.txt", "b.txt"}[i],
+			FileURL:     key,
+			FileSize:    int64(len(content)),
+			ContentType: "text/plain",
+			UploadedBy:  authorID,
+			Visibility:  domain.AttachmentVisibilityRestricted,
+		})
+	}
+	sourceBoards, sourceAttachments := len(store.boards), len(store.attachments)
+
+	service := newTestBoardService(store.mocks())
+	ctx := context.Background()
+
+	var backup bytes.Buffer
+	if err := service.ExportProjectBackup(ctx, source.ID, &backup); err != nil {
+		t.Fatalf("ExportProjectBackup() error = %v", err)
+	}
+
+	resp, err := service.ImportProjectBackup(ctx, target.ID, &backup)
+	if err != nil {
+		t.Fatalf("ImportProjectBackup() error = %v", err)
+	}
+	if resp.Boards != sourceBoards || resp.Attachments != 2 {
+		t.Fatalf("restored %d boards and %d attachments, want %d and 2", resp.Boards, resp.Attachments, sourceBoards)
+	}
+	if resp.FieldOptions != 1 || resp.Labels != 1 {
+		t.Errorf("created %d field options and %d labels, want 1 and 1", resp.FieldOptions, resp.Labels)
+	}
+	// Custom fields of all records are converted together
+	if store.conversions != 1 {
+		t.Errorf("ran %d custom field conversions, want 1", store.conversions)
+	}
+
+	restoredID, ok := resp.BoardIDs[first.ID]
+	if !ok {
+		t.Fatalf("no restored board for %s in %v", first.ID, resp.BoardIDs)
+	}
+	var restored *domain.Board
+	for _, board := range store.boards {
+		if board.ID == restoredID {
+			restored = board
+		}
+	}
+	if restored == nil || restored.ProjectID != target.ID {
+		t.Fatalf("restored board = %+v, want a board in the target project", restored)
+	}
+	if restored.Title != "Launch" || restored.AuthorID != authorID || !restored.CreatedAt.Equal(created) {
+		t.Errorf("restored board = %q by %s at %v", restored.Title, restored.AuthorID, restored.CreatedAt)
+	}
+	if restored.ArchivedAt == nil || restored.ArchiveReason == nil || *restored.ArchiveReason != reason {
+		t.Errorf("restored archive state = %v, %v", restored.ArchivedAt, restored.ArchiveReason)
+	}
+	if len(restored.Participants) != 1 || restored.Participants[0].UserID != participantID {
+		t.Errorf("restored participants = %v", restored.Participants)
+	}
+
+	// Custom fields point at the target's options, including the archived one created from the manifest
+	var done *domain.FieldOption
+	for _, option := range store.options {
+		if *option.ProjectID == target.ID && option.Value == "done" {
+			done = option
+		}
+	}
+	if done == nil || !done.IsArchived {
+		t.Fatalf("expected an archived done option in the target project, got %+v", done)
+	}
+	var fields map[string]interface{}
+	_ = json.Unmarshal(restored.CustomFields, &fields)
+	if fields["stage"] != done.ID.String() {
+		t.Errorf("restored stage = %v, want %s", fields["stage"], done.ID)
+	}
+	restoredLabels := store.boardLabels[restoredID]
+	if len(restoredLabels) != 1 || restoredLabels[0] == release.ID {
+		t.Errorf("restored labels = %v, want the target's release label", restoredLabels)
+	}
+
+	restoredAttachments := store.attachments[sourceAttachments:]
+	for i, attachment := range restoredAttachments {
+		original := store.attachments[i]
+		if attachment.EntityID == nil || *attachment.EntityID != restoredID {
+			t.Errorf("attachment %s belongs to %v, want %s", attachment.FileName, attachment.EntityID, restoredID)
+		}
+		if attachment.FileName != original.FileName || attachment.Visibility != original.Visibility || attachment.Status != domain.AttachmentStatusConfirmed {
+			t.Errorf("restored attachment = %+v, want a confirmed copy of %+v", attachment, original)
+		}
+		if attachment.FileURL == original.FileURL || !strings.HasPrefix(attachment.FileURL, "boards/"+target.WorkspaceID.String()) {
+			t.Errorf("restored attachment stored at %q", attachment.FileURL)
+		}
+		if got := string(store.objects[attachment.FileURL]); got != string(store.objects[original.FileURL]) {
+			t.Errorf("restored object = %q, want %q", got, store.objects[original.FileURL])
+		}
+	}
+}
+
+func TestBoardService_ImportProjectBackup_RejectsInvalidArchive(t *testing.T) {
+	project := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}}
+	store := &backupTestStore{projects: map[uuid.UUID]*domain.Project{project.ID: project}, objects: map[string][]byte{}}
+	service := newTestBoardService(store.mocks())
+
+	_, err := service.ImportProjectBackup(context.Background(), project.ID, strings.NewReader(`{"boards":[]}`))
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("error = %v, want validation error", err)
+	}
+	if len(store.boards) != 0 {
+		t.Errorf("expected no boards to be created, got %d", len(store.boards))
+	}
+}
+
+func TestBoardService_ImportProjectBackup_ResolvesConflictsPerBoard(t *testing.T) {
+	source := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New()}
+	target := &domain.Project{BaseModel: domain.BaseModel{ID: uuid.New()}, WorkspaceID: uuid.New(), EnforceUniqueTitles: true}
+	store := &backupTestStore{
+		projects:    map[uuid.UUID]*domain.Project{source.ID: source, target.ID: target},
+		boardLabels: map[uuid.UUID][]uuid.UUID{},
+		objects:     map[string][]byte{},
+	}
+
+	// The target already holds the title "Launch" and the external ID EXT-1
+	externalID := "EXT-1"
+	store.boards = []*domain.Board{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: target.ID, Title: "Launch", TitleUnique: true, ExternalID: &externalID},
+	}
+	launch := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: source.ID, Title: "Launch", ExternalID: &externalID}
+	relaunch := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: source.ID, Title: "Launch"}
+	overQuota := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: source.ID, Title: "Retro"}
+	store.boards = append(store.boards, launch, relaunch, overQuota)
+	for _, board := range []*domain.Board{launch, overQuota} {
+		key := "boards/" + uuid.NewString() + ".txt"
+		store.objects[key] = []byte(board.Title)
+		store.attachments = append(store.attachments, &domain.Attachment{
+			BaseModel:   domain.BaseModel{ID: uuid.New()},
+			EntityType:  domain.EntityTypeBoard,
+			EntityID:    &board.ID,
+			Status:      domain.AttachmentStatusConfirmed,
+			FileName:    board.Title + ".txt",
+			FileURL:     key,
+			FileSize:    int64(len(board.Title)),
+			ContentType: "text/plain",
+		})
+	}
+	sourceObjects := len(store.objects)
+
+	// Room for two more boards in the target project, so the third one exceeds the quota
+	transactor := &recordingTransactor{}
+	service := newTestBoardService(store.mocks(), WithMaxBoardsPerProject(3), WithTransactor(transactor))
+	ctx := context.Background()
+
+	var backup bytes.Buffer
+	if err := service.ExportProjectBackup(ctx, source.ID, &backup); err != nil {
+		t.Fatalf("ExportProjectBackup() error = %v", err)
+	}
+
+	resp, err := service.ImportProjectBackup(ctx, target.ID, &backup)
+	if err != nil {
+		t.Fatalf("ImportProjectBackup() error = %v", err)
+	}
+	if store.uploadsInTx != 0 {
+		t.Errorf("uploaded %d attachment objects inside a transaction, want none", store.uploadsInTx)
+	}
+	// Each board is restored in a transaction of its own
+	if transactor.calls != 3 {
+		t.Errorf("ran %d transactions, want one per board", transactor.calls)
+	}
+	if resp.Boards != 2 || resp.Failed != 1 || resp.Attachments != 1 {
+		t.Fatalf("restored %d boards with %d attachments and %d failed, want 2, 1 and 1", resp.Boards, resp.Attachments, resp.Failed)
+	}
+
+	restored := make(map[uuid.UUID]*domain.Board)
+	for _, board := range store.boards {
+		restored[board.ID] = board
+	}
+	first, second := restored[resp.BoardIDs[launch.ID]], restored[resp.BoardIDs[relaunch.ID]]
+	if first == nil || first.Title != "Launch (2)" || first.ExternalID != nil {
+		t.Errorf("first restored board = %+v, want the title Launch (2) without an external ID", first)
+	}
+	if second == nil || second.Title != "Launch (3)" {
+		t.Errorf("second restored board = %+v, want the title Launch (3)", second)
+	}
+	if _, ok := resp.BoardIDs[overQuota.ID]; ok {
+		t.Errorf("board %s was restored over the quota", overQuota.ID)
+	}
+
+	issues := make(map[uuid.UUID][]dto.BoardBackupIssue)
+	for _, issue := range resp.Issues {
+		issues[issue.SourceBoardID] = append(issues[issue.SourceBoardID], issue)
+	}
+	if len(issues[launch.ID]) != 2 || len(issues[relaunch.ID]) != 1 || !issues[launch.ID][0].Restored {
+		t.Errorf("issues = %+v, want the renamed title and cleared external ID reported", resp.Issues)
+	}
+	if got := issues[overQuota.ID]; len(got) != 1 || got[0].Restored || got[0].Message != "Board quota exceeded for this project" {
+		t.Errorf("issues of %s = %+v, want the quota failure", overQuota.ID, got)
+	}
+
+	// Only the restored board's object is kept; the one uploaded for the failed board is deleted again
+	if len(store.objects) != sourceObjects+1 {
+		t.Errorf("stored %d objects, want the %d source objects and one restored object", len(store.objects), sourceObjects)
+	}
+}
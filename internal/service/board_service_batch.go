@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// MaxBatchUpdateItems caps the boards changed by one BatchUpdateBoards call, keeping its transaction short
+const MaxBatchUpdateItems = 100
+
+const (
+	batchRolledBackMessage   = "Not applied because another board in the batch failed"
+	batchNotAttemptedMessage = "Not attempted because an earlier board in the batch failed unexpectedly"
+)
+
+// errBatchRejected rolls back a batch in which at least one item failed
+var errBatchRejected = errors.New("batch update rejected")
+
+// BatchUpdateBoards applies several board updates in one transaction: either every board is updated or none is
+// Each item goes through the same steps as UpdateBoard, so validation, attachments and participants follow the same rules.
+// Items are still checked after one fails, so every invalid item is reported at once; after an unexpected
+// error the remaining items are not attempted. Custom fields are converted up front in one batch per project.
+// Notifications, threshold rules and attachment checksums wait until the whole batch has committed.
+func (s *boardServiceImpl) BatchUpdateBoards(ctx context.Context, items []dto.BatchBoardUpdateItem) (resp *dto.BatchUpdateBoardsResponse, err error) {
+	ctx, span := s.startSpan(ctx, "BatchUpdateBoards", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	if len(items) == 0 {
+		return nil, response.NewValidationError("Batch update needs at least one board", "")
+	}
+	if len(items) > MaxBatchUpdateItems {
+		return nil, response.NewValidationError(fmt.Sprintf("Batch update accepts at most %d boards", MaxBatchUpdateItems),
+			fmt.Sprintf("got %d boards", len(items)))
+	}
+
+	resp = &dto.BatchUpdateBoardsResponse{Results: make([]dto.BatchBoardUpdateResult, len(items))}
+	updates := make([]*boardUpdate, len(items))
+
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		converted, err := s.convertBatchCustomFields(txCtx, items)
+		if err != nil {
+			return err
+		}
+
+		failed, stopped := false, false
+		for i := range items {
+			result := &resp.Results[i]
+			result.BoardID = items[i].BoardID
+			if stopped {
+				result.Error = batchNotAttemptedMessage
+				continue
+			}
+
+			update, err := s.updateBoardInTx(txCtx, items[i].BoardID, &items[i].UpdateBoardRequest, converted[i])
+			if err != nil {
+				failed = true
+				var appErr *response.AppError
+				if errors.As(err, &appErr) {
+					result.Error = appErr.Message
+					result.Fields = appErr.Fields
+				} else {
+					result.Error = "Failed to update board"
+				}
+				// The transaction may be unusable after an unexpected error
+				stopped = appErr == nil || appErr.Code == response.ErrCodeInternal
+				s.logger.Warn("Batch board update item failed",
+					zap.String("board_id", items[i].BoardID.String()),
+					zap.Error(err))
+				continue
+			}
+			updates[i] = update
+		}
+		if failed {
+			return errBatchRejected
+		}
+		return nil
+	})
+	if errors.Is(err, errBatchRejected) {
+		for i := range resp.Results {
+			if resp.Results[i].Error == "" {
+				resp.Results[i].Error = batchRolledBackMessage
+			}
+		}
+		return resp, nil
+	}
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update boards", err.Error())
+	}
+
+	resp.Applied = true
+	for i, update := range updates {
+		resp.Results[i].Success = true
+		resp.Results[i].Board = s.finishBoardUpdate(ctx, update)
+	}
+	return resp, nil
+}
+
+// convertBatchCustomFields converts the custom fields of the batch's items with one conversion per project
+// Results are keyed by item index; items whose board cannot be found are left to updateBoardInTx to report
+func (s *boardServiceImpl) convertBatchCustomFields(ctx context.Context, items []dto.BatchBoardUpdateItem) (map[int]*convertedCustomFields, error) {
+	boardIDs := make([]uuid.UUID, 0, len(items))
+	for _, item := range items {
+		if item.CustomFields != nil {
+			boardIDs = append(boardIDs, item.BoardID)
+		}
+	}
+	if len(boardIDs) == 0 {
+		return nil, nil
+	}
+
+	projectIDs, err := s.boardRepo.FindProjectIDs(ctx, boardIDs)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+
+	byProject := make(map[uuid.UUID][]int)
+	for i, item := range items {
+		if projectID, ok := projectIDs[item.BoardID]; ok && item.CustomFields != nil {
+			byProject[projectID] = append(byProject[projectID], i)
+		}
+	}
+
+	converted := make(map[int]*convertedCustomFields, len(boardIDs))
+	for projectID, indexes := range byProject {
+		customFields := make([]map[string]interface{}, len(indexes))
+		for j, i := range indexes {
+			customFields[j] = *items[i].CustomFields
+		}
+		results, err := s.fieldOptionConverter.ConvertValuesToIDsBatch(ctx, projectID, customFields)
+		if err != nil {
+			return nil, customFieldsError(err)
+		}
+		for j, i := range indexes {
+			converted[i] = &convertedCustomFields{projectID: projectID, ConvertedFields: results[j]}
+		}
+	}
+	return converted, nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_BatchUpdateBoards_AppliesAll(t *testing.T) {
+	first := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "First"}
+	second := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: first.ProjectID, Title: "Second"}
+	boards := map[uuid.UUID]*domain.Board{first.ID: first, second.ID: second}
+	transactor := &recordingTransactor{}
+	publisher := &recordingPublisher{}
+	service := newTestBoardService(boardServiceMocks{boardRepo: newBoardMapRepository(boards)},
+		WithTransactor(transactor), WithWebhookPublisher(publisher))
+
+	dueDate := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	items := []dto.BatchBoardUpdateItem{
+		{BoardID: first.ID, UpdateBoardRequest: dto.UpdateBoardRequest{DueDate: dto.OptionalOf(&dueDate)}},
+		{BoardID: second.ID, UpdateBoardRequest: dto.UpdateBoardRequest{DueDate: dto.OptionalOf(&dueDate)}},
+	}
+	resp, err := service.BatchUpdateBoards(context.Background(), items)
+	if err != nil {
+		t.Fatalf("BatchUpdateBoards() unexpected error = %v", err)
+	}
+
+	if !resp.Applied || transactor.rolledBack {
+		t.Fatalf("expected the batch to be applied, applied = %v, rolled back = %v", resp.Applied, transactor.rolledBack)
+	}
+	for i, result := range resp.Results {
+		if !result.Success || result.Board == nil || result.BoardID != items[i].BoardID {
+			t.Errorf("result %d = %+v, want success with the board", i, result)
+		}
+	}
+	if len(publisher.events) != 2 {
+		t.Errorf("published %d events, want 2", len(publisher.events))
+	}
+}
+
+func TestBoardService_BatchUpdateBoards_RollsBackOnInvalidItem(t *testing.T) {
+	startDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	valid := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Title: "Valid"}
+	invalid := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: valid.ProjectID, Title: "Invalid", StartDate: &startDate}
+	missingID := uuid.New()
+	boards := map[uuid.UUID]*domain.Board{valid.ID: valid, invalid.ID: invalid}
+	transactor := &recordingTransactor{}
+	publisher := &recordingPublisher{}
+	service := newTestBoardService(boardServiceMocks{boardRepo: newBoardMapRepository(boards)},
+		WithTransactor(transactor), WithWebhookPublisher(publisher))
+
+	// Due before the board's existing start date
+	dueDate := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	items := []dto.BatchBoardUpdateItem{
+		{BoardID: valid.ID, UpdateBoardRequest: dto.UpdateBoardRequest{DueDate: dto.OptionalOf(&dueDate)}},
+		{BoardID: invalid.ID, UpdateBoardRequest: dto.UpdateBoardRequest{DueDate: dto.OptionalOf(&dueDate)}},
+		{BoardID: missingID, UpdateBoardRequest: dto.UpdateBoardRequest{DueDate: dto.OptionalOf(&dueDate)}},
+	}
+	resp, err := service.BatchUpdateBoards(context.Background(), items)
+	if err != nil {
+		t.Fatalf("BatchUpdateBoards() unexpected error = %v", err)
+	}
+
+	if resp.Applied || !transactor.rolledBack {
+		t.Fatalf("expected the batch to be rolled back, applied = %v, rolled back = %v", resp.Applied, transactor.rolledBack)
+	}
+	if result := resp.Results[0]; result.Success || result.Board != nil || result.Error != batchRolledBackMessage {
+		t.Errorf("valid item result = %+v, want rolled back", result)
+	}
+	// Every failing item is reported, not just the first
+	if result := resp.Results[1]; result.Success || result.Error == "" || result.Error == batchRolledBackMessage {
+		t.Errorf("invalid item result = %+v, want its validation error", result)
+	}
+	if result := resp.Results[2]; result.Success || result.Error != "Board not found" {
+		t.Errorf("missing item result = %+v, want Board not found", result)
+	}
+	if len(publisher.events) != 0 {
+		t.Errorf("published %d events for a rolled back batch, want 0", len(publisher.events))
+	}
+}
+
+func TestBoardService_BatchUpdateBoards_ChecksumsAttachmentsAfterCommit(t *testing.T) {
+	projectID := uuid.New()
+	first := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "First"}
+	second := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "Second"}
+	boards := map[uuid.UUID]*domain.Board{first.ID: first, second.ID: second}
+
+	attachments := map[uuid.UUID]*domain.Attachment{}
+	for i := 0; i < 2; i++ {
+		attachment := &domain.Attachment{
+			BaseModel:  domain.BaseModel{ID: uuid.New()},
+			EntityType: domain.EntityTypeBoard,
+			Status:     domain.AttachmentStatusTemp,
+			FileURL:    "boards/file-" + uuid.NewString(),
+		}
+		attachments[attachment.ID] = attachment
+	}
+	transactor := &recordingTransactor{}
+	var checksummed []uuid.UUID
+	attachmentRepo := &MockAttachmentRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+			found := make([]*domain.Attachment, 0, len(ids))
+			for _, id := range ids {
+				found = append(found, attachments[id])
+			}
+			return found, nil
+		},
+		ConfirmAttachmentsFunc: func(ctx context.Context, ids []uuid.UUID, entityID uuid.UUID) error {
+			for _, id := range ids {
+				attachments[id].Status = domain.AttachmentStatusConfirmed
+				attachments[id].EntityID = &entityID
+			}
+			return nil
+		},
+		UpdateChecksumFunc: func(ctx context.Context, id uuid.UUID, checksum string) error {
+			if inRecordedTransaction(ctx) {
+				t.Errorf("checksum of %s stored inside the batch transaction", id)
+			}
+			checksummed = append(checksummed, id)
+			return nil
+		},
+	}
+	s3Client := &MockS3Client{
+		ComputeSHA256Func: func(ctx context.Context, key string) (string, error) {
+			if inRecordedTransaction(ctx) {
+				t.Errorf("%s read from S3 inside the batch transaction", key)
+			}
+			return "sha256-" + key, nil
+		},
+	}
+	service := newTestBoardService(boardServiceMocks{boardRepo: newBoardMapRepository(boards), attachmentRepo: attachmentRepo, s3Client: s3Client},
+		WithTransactor(transactor))
+
+	ids := make([]uuid.UUID, 0, len(attachments))
+	for id := range attachments {
+		ids = append(ids, id)
+	}
+	items := []dto.BatchBoardUpdateItem{
+		{BoardID: first.ID, UpdateBoardRequest: dto.UpdateBoardRequest{AttachmentIDs: ids[:1]}},
+		{BoardID: second.ID, UpdateBoardRequest: dto.UpdateBoardRequest{AttachmentIDs: ids[1:]}},
+	}
+	resp, err := service.BatchUpdateBoards(context.Background(), items)
+	if err != nil || !resp.Applied {
+		t.Fatalf("BatchUpdateBoards() = %+v, %v; want the batch applied", resp, err)
+	}
+
+	if len(checksummed) != 2 {
+		t.Errorf("checksummed attachments = %v, want both once the batch committed", checksummed)
+	}
+}
+
+func TestBoardService_BatchUpdateBoards_ConvertsCustomFieldsPerProject(t *testing.T) {
+	projectA, projectB := uuid.New(), uuid.New()
+	boards := map[uuid.UUID]*domain.Board{}
+	for _, projectID := range []uuid.UUID{projectA, projectA, projectB} {
+		board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "Board"}
+		boards[board.ID] = board
+	}
+	boardRepo := newBoardMapRepository(boards)
+	boardRepo.FindProjectIDsFunc = func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+		projectIDs := make(map[uuid.UUID]uuid.UUID, len(ids))
+		for _, id := range ids {
+			projectIDs[id] = boards[id].ProjectID
+		}
+		return projectIDs, nil
+	}
+	converted := map[uuid.UUID]int{}
+	mockConverter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, pid uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			t.Errorf("ConvertValuesToIDs() called for %v, want the batch conversion to be reused", fields)
+			return fields, nil
+		},
+		ConvertValuesToIDsBatchFunc: func(ctx context.Context, pid uuid.UUID, fields []map[string]interface{}) ([]converter.ConvertedFields, error) {
+			converted[pid] += len(fields)
+			results := make([]converter.ConvertedFields, len(fields))
+			for i := range fields {
+				results[i].Fields = map[string]interface{}{"stage": "option-of-" + pid.String()}
+			}
+			return results, nil
+		},
+	}
+	service := newTestBoardService(boardServiceMocks{boardRepo: boardRepo, converter: mockConverter}, WithTransactor(&recordingTransactor{}))
+
+	stage := map[string]interface{}{"stage": "done"}
+	var items []dto.BatchBoardUpdateItem
+	for id := range boards {
+		items = append(items, dto.BatchBoardUpdateItem{BoardID: id, UpdateBoardRequest: dto.UpdateBoardRequest{CustomFields: &stage}})
+	}
+	resp, err := service.BatchUpdateBoards(context.Background(), items)
+	if err != nil || !resp.Applied {
+		t.Fatalf("BatchUpdateBoards() = %+v, %v; want the batch applied", resp, err)
+	}
+
+	// One batch conversion per project covers all of its boards
+	if len(converted) != 2 || converted[projectA] != 2 || converted[projectB] != 1 {
+		t.Errorf("converted boards per project = %v, want 2 for one project and 1 for the other", converted)
+	}
+	for id, board := range boards {
+		if want := `{"stage":"option-of-` + board.ProjectID.String() + `"}`; string(board.CustomFields) != want {
+			t.Errorf("board %s custom fields = %s, want %s", id, board.CustomFields, want)
+		}
+	}
+}
+
+func TestBoardService_BatchUpdateBoards_RejectsOversizedBatch(t *testing.T) {
+	service := newTestBoardService(boardServiceMocks{}, WithTransactor(&recordingTransactor{}))
+
+	items := make([]dto.BatchBoardUpdateItem, MaxBatchUpdateItems+1)
+	_, err := service.BatchUpdateBoards(context.Background(), items)
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeValidation {
+		t.Fatalf("BatchUpdateBoards() error = %v, want %s", err, response.ErrCodeValidation)
+	}
+	if appErr.Message != "Batch update accepts at most 100 boards" {
+		t.Errorf("error message = %q", appErr.Message)
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// BulkUpdateBoardsStream applies each update in order and reports its result through onResult
+// as soon as it completes. Processing stops when ctx is cancelled and ctx.Err() is returned;
+// items that were not reached produce no result.
+func (s *boardServiceImpl) BulkUpdateBoardsStream(ctx context.Context, items []dto.BulkBoardUpdateItem, onResult func(dto.BulkBoardUpdateResult)) error {
+	var throttle <-chan time.Time
+	if s.bulkUpdateInterval > 0 {
+		ticker := time.NewTicker(s.bulkUpdateInterval)
+		defer ticker.Stop()
+		throttle = ticker.C
+	}
+
+	for i := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// Wait for the next slot, but never past cancellation
+		if throttle != nil && i > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-throttle:
+			}
+		}
+
+		onResult(s.updateBoardForBulk(ctx, &items[i]))
+	}
+
+	return nil
+}
+
+// updateBoardForBulk runs a single bulk item through UpdateBoard and converts the outcome
+func (s *boardServiceImpl) updateBoardForBulk(ctx context.Context, item *dto.BulkBoardUpdateItem) dto.BulkBoardUpdateResult {
+	result := dto.BulkBoardUpdateResult{
+		BoardID: item.BoardID,
+		Success: false,
+	}
+
+	board, err := s.UpdateBoard(ctx, item.BoardID, &item.Update)
+	if err != nil {
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			result.Error = appErr.Message
+		} else {
+			result.Error = "Failed to update board"
+		}
+		s.logger.Warn("Bulk board update item failed",
+			zap.String("board_id", item.BoardID.String()),
+			zap.Error(err))
+		return result
+	}
+
+	result.Success = true
+	result.Board = board
+	return result
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+const (
+	duplicateExistingTitleWarning = "A board with this title already exists in the project"
+	duplicateRequestTitleWarning  = "This title repeats an earlier title in the request"
+)
+
+// BulkCreateBoards creates one board per title, sharing the request's defaults, in a single transaction
+// Duplicate titles are allowed but reported as warnings in the per-title results
+func (s *boardServiceImpl) BulkCreateBoards(ctx context.Context, req *dto.BulkCreateBoardsRequest) (resp *dto.BulkCreateBoardsResponse, err error) {
+	ctx, span := s.startSpan(ctx, "BulkCreateBoards", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	authorID, exists := ctx.Value("user_id").(uuid.UUID)
+	if !exists {
+		return nil, response.NewAppError(response.ErrCodeUnauthorized, "User ID not found in context", "")
+	}
+
+	if len(req.Titles) == 0 {
+		return nil, response.NewValidationError("At least one title is required", "")
+	}
+	titles := make([]string, len(req.Titles))
+	for i, title := range req.Titles {
+		titles[i] = strings.TrimSpace(title)
+		if titles[i] == "" {
+			return nil, response.NewValidationError("Title cannot be empty", fmt.Sprintf("titles[%d]", i))
+		}
+	}
+
+	defaults := req.Defaults
+	defaults.StartDate, defaults.DueDate = normalizeBoardDate(defaults.StartDate), normalizeBoardDate(defaults.DueDate)
+	if err := validateDateRange(defaults.StartDate, defaults.DueDate); err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, req.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+	titleUnique := project != nil && project.EnforceUniqueTitles
+
+	// The shared defaults are converted once, with the project's options loaded in a single query
+	var customFieldsJSON datatypes.JSON
+	if defaults.CustomFields != nil {
+		results, err := s.fieldOptionConverter.ConvertValuesToIDsBatch(ctx, req.ProjectID, []map[string]interface{}{defaults.CustomFields})
+		if err != nil {
+			return nil, customFieldsError(err)
+		}
+		if results[0].Err != nil {
+			return nil, customFieldsError(results[0].Err)
+		}
+		if customFieldsJSON, err = s.marshalCustomFields(results[0].Fields); err != nil {
+			return nil, err
+		}
+	}
+
+	assigneeID := defaults.AssigneeID
+	if assigneeID == nil {
+		assigneeID = &authorID
+	}
+
+	// Archived boards still hold their titles
+	existing, err := s.boardRepo.FindByProjectID(ctx, req.ProjectID, repository.BoardListFilter{IncludeArchived: true})
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+	existingTitles := make(map[string]bool, len(existing))
+	takenTitles := make(map[string]bool, len(existing))
+	for _, board := range existing {
+		existingTitles[normalizeTitle(board.Title)] = true
+		takenTitles[board.Title] = true
+	}
+
+	boards := make([]*domain.Board, len(titles))
+	resp = &dto.BulkCreateBoardsResponse{Results: make([]dto.BulkCreateBoardResult, len(titles))}
+	seen := make(map[string]bool, len(titles))
+	for i, title := range titles {
+		// Near-duplicates only warn, but an exact duplicate fails the batch while the project enforces unique titles
+		if titleUnique && takenTitles[title] {
+			return nil, response.NewAppError(response.ErrCodeConflict, "A board with this title already exists in the project", fmt.Sprintf("titles[%d]: %s", i, title))
+		}
+		takenTitles[title] = true
+
+		boards[i] = &domain.Board{
+			ProjectID:    req.ProjectID,
+			AuthorID:     authorID,
+			Title:        title,
+			CustomFields: customFieldsJSON,
+			AssigneeID:   assigneeID,
+			StartDate:    defaults.StartDate,
+			DueDate:      defaults.DueDate,
+			TitleUnique:  titleUnique,
+		}
+
+		result := dto.BulkCreateBoardResult{Title: title}
+		key := normalizeTitle(title)
+		switch {
+		case existingTitles[key]:
+			result.Warning = duplicateExistingTitleWarning
+		case seen[key]:
+			result.Warning = duplicateRequestTitleWarning
+		}
+		seen[key] = true
+		resp.Results[i] = result
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.checkBoardQuota(txCtx, req.ProjectID, len(boards)); err != nil {
+			return err
+		}
+		for i, board := range boards {
+			if err := s.boardRepo.Create(txCtx, board); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to create board", fmt.Sprintf("titles[%d]: %s", i, err.Error()))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create boards", err.Error())
+	}
+
+	for i, board := range boards {
+		resp.Results[i].BoardID = board.ID
+		if s.metrics != nil {
+			s.metrics.IncrementBoardCreated()
+		}
+	}
+	resp.Created = len(boards)
+
+	s.logger.Info("Boards bulk created",
+		zap.String("project_id", req.ProjectID.String()),
+		zap.Int("created", resp.Created))
+
+	return resp, nil
+}
+
+// normalizeTitle is the form in which titles are compared for duplicates
+func normalizeTitle(title string) string {
+	return strings.ToLower(strings.TrimSpace(title))
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardService_BulkCreateBoards_WarnsOnDuplicateTitle(t *testing.T) {
+	// Given: a project that already has a "Release notes" board
+	projectID := uuid.New()
+	var created []*domain.Board
+	mockBoardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			created = append(created, board)
+			return nil
+		},
+		FindByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, filters interface{}) ([]*domain.Board, error) {
+			return []*domain.Board{{ProjectID: pid, Title: "Release notes"}}, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, zap.NewNop())
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+	assigneeID := uuid.New()
+
+	// When: five boards are created, one of them titled like the existing board
+	resp, err := service.BulkCreateBoards(ctx, &dto.BulkCreateBoardsRequest{
+		ProjectID: projectID,
+		Titles:    []string{"Design", "Build", " release NOTES ", "Test", "Ship"},
+		Defaults:  dto.BulkBoardDefaults{AssigneeID: &assigneeID},
+	})
+	if err != nil {
+		t.Fatalf("BulkCreateBoards() unexpected error = %v", err)
+	}
+
+	// Then: all five are created with the shared defaults and only the duplicate is flagged
+	if resp.Created != 5 || len(created) != 5 || len(resp.Results) != 5 {
+		t.Fatalf("expected 5 boards, got created=%d stored=%d results=%d", resp.Created, len(created), len(resp.Results))
+	}
+	for i, result := range resp.Results {
+		if result.BoardID != created[i].ID {
+			t.Errorf("results[%d].BoardID = %v, want %v", i, result.BoardID, created[i].ID)
+		}
+		if created[i].AssigneeID == nil || *created[i].AssigneeID != assigneeID {
+			t.Errorf("board %q did not get the default assignee", created[i].Title)
+		}
+		wantWarning := ""
+		if i == 2 {
+			wantWarning = duplicateExistingTitleWarning
+		}
+		if result.Warning != wantWarning {
+			t.Errorf("results[%d].Warning = %q, want %q", i, result.Warning, wantWarning)
+		}
+	}
+	if created[2].Title != "release NOTES" {
+		t.Errorf("expected title to be trimmed, got %q", created[2].Title)
+	}
+}
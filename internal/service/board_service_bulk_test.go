@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// newBulkTestRepository finds every board but missing and records the IDs of the updated boards
+func newBulkTestRepository(updates *[]uuid.UUID, missing uuid.UUID) *MockBoardRepository {
+	return &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			if id == missing {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return &domain.Board{BaseModel: domain.BaseModel{ID: id}, Title: "Board"}, nil
+		},
+		UpdateFunc: func(ctx context.Context, b *domain.Board) error {
+			*updates = append(*updates, b.ID)
+			return nil
+		},
+	}
+}
+
+func bulkItems(ids ...uuid.UUID) []dto.BulkBoardUpdateItem {
+	title := "Updated"
+	items := make([]dto.BulkBoardUpdateItem, len(ids))
+	for i, id := range ids {
+		items[i] = dto.BulkBoardUpdateItem{BoardID: id, Update: dto.UpdateBoardRequest{Title: &title}}
+	}
+	return items
+}
+
+func TestBoardService_BulkUpdateBoardsStream_StreamsIncrementally(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	var updates []uuid.UUID
+	service := newTestBoardService(boardServiceMocks{boardRepo: newBulkTestRepository(&updates, ids[1])})
+
+	var results []dto.BulkBoardUpdateResult
+	err := service.BulkUpdateBoardsStream(context.Background(), bulkItems(ids...), func(result dto.BulkBoardUpdateResult) {
+		// Each result arrives before the next item is processed
+		if len(updates) > len(results)+1 {
+			t.Errorf("result %d delivered after %d updates ran", len(results), len(updates))
+		}
+		results = append(results, result)
+	})
+	if err != nil {
+		t.Fatalf("BulkUpdateBoardsStream() error = %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	for i, result := range results {
+		if result.BoardID != ids[i] {
+			t.Errorf("result %d BoardID = %v, want %v", i, result.BoardID, ids[i])
+		}
+	}
+	if !results[0].Success || results[0].Board == nil {
+		t.Errorf("result 0 = %+v, want successful update", results[0])
+	}
+	if results[1].Success || results[1].Error != "Board not found" {
+		t.Errorf("result 1 = %+v, want Board not found failure", results[1])
+	}
+	if !results[2].Success {
+		t.Errorf("result 2 = %+v, want success after a failed item", results[2])
+	}
+}
+
+func TestBoardService_BulkUpdateBoardsStream_CancellationStops(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	var updates []uuid.UUID
+	service := newTestBoardService(boardServiceMocks{boardRepo: newBulkTestRepository(&updates, uuid.Nil)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resultCount := 0
+	err := service.BulkUpdateBoardsStream(ctx, bulkItems(ids...), func(result dto.BulkBoardUpdateResult) {
+		resultCount++
+		cancel()
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("BulkUpdateBoardsStream() error = %v, want %v", err, context.Canceled)
+	}
+	if resultCount != 1 || len(updates) != 1 {
+		t.Errorf("expected processing to stop after 1 item, got %d results and %d updates", resultCount, len(updates))
+	}
+}
+
+func TestBoardService_BulkUpdateBoardsStream_CancelledWhileThrottled(t *testing.T) {
+	ids := []uuid.UUID{uuid.New(), uuid.New()}
+	var updates []uuid.UUID
+	service := newTestBoardService(boardServiceMocks{boardRepo: newBulkTestRepository(&updates, uuid.Nil)},
+		WithBulkUpdateInterval(time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := service.BulkUpdateBoardsStream(ctx, bulkItems(ids...), func(result dto.BulkBoardUpdateResult) {})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("BulkUpdateBoardsStream() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if len(updates) != 1 {
+		t.Errorf("expected only the first item before the throttle wait, got %d updates", len(updates))
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("cancellation did not interrupt the throttle wait (took %v)", elapsed)
+	}
+}
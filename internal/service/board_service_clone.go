@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// cloneOptions is a CloneBoardRequest with its defaults applied
+type cloneOptions struct {
+	attachments  bool
+	participants bool
+	comments     bool
+	customFields bool
+}
+
+// resolveCloneOptions applies the defaults for flags the caller left out
+// Comments are opt-in because they often hold discussion that should not travel with a copy
+func resolveCloneOptions(req *dto.CloneBoardRequest) cloneOptions {
+	opts := cloneOptions{participants: true, customFields: true}
+	if req == nil {
+		return opts
+	}
+	if req.IncludeAttachments != nil {
+		opts.attachments = *req.IncludeAttachments
+	}
+	if req.IncludeParticipants != nil {
+		opts.participants = *req.IncludeParticipants
+	}
+	if req.IncludeComments != nil {
+		opts.comments = *req.IncludeComments
+	}
+	if req.IncludeCustomFields != nil {
+		opts.customFields = *req.IncludeCustomFields
+	}
+	return opts
+}
+
+// CloneBoard copies a board into the same project, including only the components selected by req
+// The caller becomes the author of the copy; spent effort is not copied
+func (s *boardServiceImpl) CloneBoard(ctx context.Context, boardID uuid.UUID, req *dto.CloneBoardRequest) (resp *dto.BoardResponse, err error) {
+	ctx, span := s.startSpan(ctx, "CloneBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	authorID, exists := ctx.Value("user_id").(uuid.UUID)
+	if !exists {
+		return nil, response.NewAppError(response.ErrCodeUnauthorized, "User ID not found in context", "")
+	}
+
+	source, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, source.ProjectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	// The copy keeps the source's title, so it is rejected while the project enforces unique titles
+	if project.EnforceUniqueTitles {
+		if err := s.checkTitleAvailable(ctx, source.ProjectID, uuid.Nil, source.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	opts := resolveCloneOptions(req)
+	clone := &domain.Board{
+		ProjectID:     source.ProjectID,
+		AuthorID:      authorID,
+		AssigneeID:    source.AssigneeID,
+		Title:         source.Title,
+		Content:       source.Content,
+		StartDate:     source.StartDate,
+		DueDate:       source.DueDate,
+		EstimateHours: source.EstimateHours,
+		TitleUnique:   project.EnforceUniqueTitles,
+		// Labels belong to the same project; only the board_labels rows are inserted for them
+		Labels: source.Labels,
+	}
+	// Custom fields are stored as option IDs of the same project, so they can be copied as is
+	if opts.customFields {
+		clone.CustomFields = source.CustomFields
+	}
+	// Participants and comments are inserted together with the board through its associations
+	if opts.participants {
+		for _, p := range source.Participants {
+			clone.Participants = append(clone.Participants, domain.Participant{UserID: p.UserID})
+		}
+	}
+	if opts.comments {
+		for _, c := range source.Comments {
+			clone.Comments = append(clone.Comments, domain.Comment{UserID: c.UserID, Content: c.Content})
+		}
+	}
+
+	var attachments []*domain.Attachment
+	if opts.attachments {
+		attachments, err = s.copyBoardAttachments(ctx, source.ID, project.WorkspaceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.checkBoardQuota(txCtx, source.ProjectID, 1); err != nil {
+			return err
+		}
+		if err := s.boardRepo.Create(txCtx, clone); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to clone board", err.Error())
+		}
+		for _, attachment := range attachments {
+			attachment.EntityID = &clone.ID
+			if err := s.attachmentRepo.Create(txCtx, attachment); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to clone attachments", err.Error())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// The copied S3 objects are not referenced by any record anymore
+		s.deleteCopiedFiles(ctx, attachments)
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to clone board", err.Error())
+	}
+	span.SetAttributes(attribute.String("board.clone_id", clone.ID.String()))
+
+	if s.metrics != nil {
+		s.metrics.IncrementBoardCreated()
+	}
+
+	clone.Attachments = toDomainAttachments(attachments)
+	if err := s.convertBoardCustomFieldsToValues(ctx, clone); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+
+	return s.toBoardResponse(clone), nil
+}
+
+// copyBoardAttachments copies a board's stored files to new S3 keys and returns unsaved attachment records for them
+// Files already copied are deleted again if a later copy fails
+func (s *boardServiceImpl) copyBoardAttachments(ctx context.Context, boardID, workspaceID uuid.UUID) ([]*domain.Attachment, error) {
+	sources, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, boardID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachments", err.Error())
+	}
+
+	copies := make([]*domain.Attachment, 0, len(sources))
+	for _, src := range sources {
+		fileKey, err := s.s3Client.GenerateFileKey("boards", workspaceID.String(), filepath.Ext(src.FileName))
+		if err == nil {
+			err = s.s3Client.CopyFile(ctx, src.FileURL, fileKey)
+		}
+		if err != nil {
+			s.deleteCopiedFiles(ctx, copies)
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to copy attachment", err.Error())
+		}
+
+		copies = append(copies, &domain.Attachment{
+			BaseModel:      domain.BaseModel{ID: uuid.New()},
+			EntityType:     domain.EntityTypeBoard,
+			Status:         domain.AttachmentStatusConfirmed,
+			FileName:       src.FileName,
+			FileURL:        fileKey,
+			FileSize:       src.FileSize,
+			ContentType:    src.ContentType,
+			UploadedBy:     src.UploadedBy,
+			ChecksumSHA256: src.ChecksumSHA256,
+		})
+	}
+	return copies, nil
+}
+
+// deleteCopiedFiles removes S3 copies made for a clone that did not complete
+func (s *boardServiceImpl) deleteCopiedFiles(ctx context.Context, copies []*domain.Attachment) {
+	for _, copied := range copies {
+		if err := s.s3Client.DeleteFile(ctx, copied.FileURL); err != nil {
+			s.logger.Warn("Failed to delete copied attachment",
+				zap.String("file_key", copied.FileURL),
+				zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardService_CloneBoard(t *testing.T) {
+	boolPtr := func(v bool) *bool { return &v }
+	sourceID := uuid.New()
+	participantID := uuid.New()
+
+	tests := []struct {
+		name             string
+		req              *dto.CloneBoardRequest
+		wantAttachments  int
+		wantComments     int
+		wantParticipants int
+	}{
+		{
+			name:             "성공: 기본 옵션은 댓글과 첨부파일을 복사하지 않음",
+			req:              &dto.CloneBoardRequest{},
+			wantParticipants: 1,
+		},
+		{
+			name:             "성공: 첨부파일 제외, 댓글 포함",
+			req:              &dto.CloneBoardRequest{IncludeAttachments: boolPtr(false), IncludeComments: boolPtr(true)},
+			wantComments:     2,
+			wantParticipants: 1,
+		},
+		{
+			name:             "성공: 첨부파일 포함, 참여자 제외",
+			req:              &dto.CloneBoardRequest{IncludeAttachments: boolPtr(true), IncludeParticipants: boolPtr(false)},
+			wantAttachments:  1,
+			wantParticipants: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+			var created *domain.Board
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return &domain.Board{
+						BaseModel:    domain.BaseModel{ID: sourceID},
+						ProjectID:    uuid.New(),
+						Title:        "Source",
+						Participants: []domain.Participant{{BoardID: sourceID, UserID: participantID}},
+						Comments: []domain.Comment{
+							{BoardID: sourceID, UserID: uuid.New(), Content: "first"},
+							{BoardID: sourceID, UserID: uuid.New(), Content: "second"},
+						},
+					}, nil
+				},
+				CreateFunc: func(ctx context.Context, board *domain.Board) error {
+					board.ID = uuid.New()
+					created = board
+					return nil
+				},
+			}
+			mockProjectRepo := &MockProjectRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+					return &domain.Project{WorkspaceID: uuid.New()}, nil
+				},
+			}
+			var savedAttachments []*domain.Attachment
+			mockAttachmentRepo := &MockAttachmentRepository{
+				FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+					return []*domain.Attachment{{
+						BaseModel:  domain.BaseModel{ID: uuid.New()},
+						EntityType: domain.EntityTypeBoard,
+						EntityID:   &sourceID,
+						Status:     domain.AttachmentStatusConfirmed,
+						FileName:   "spec.pdf",
+						FileURL:    "board/boards/ws/spec.pdf",
+					}}, nil
+				},
+				CreateFunc: func(ctx context.Context, attachment *domain.Attachment) error {
+					savedAttachments = append(savedAttachments, attachment)
+					return nil
+				},
+			}
+			var copied []string
+			mockS3 := &MockS3Client{
+				CopyFileFunc: func(ctx context.Context, srcKey, dstKey string) error {
+					copied = append(copied, srcKey)
+					return nil
+				},
+			}
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+				mockAttachmentRepo, mockS3, &MockFieldOptionConverter{}, nil, zap.NewNop())
+
+			// When
+			got, err := service.CloneBoard(ctx, sourceID, tt.req)
+
+			// Then
+			if err != nil {
+				t.Fatalf("CloneBoard() unexpected error = %v", err)
+			}
+			if got.ID == sourceID || got.ID != created.ID {
+				t.Errorf("CloneBoard() returned board %v, want the new board %v", got.ID, created.ID)
+			}
+			if len(created.Comments) != tt.wantComments {
+				t.Errorf("cloned comments = %d, want %d", len(created.Comments), tt.wantComments)
+			}
+			if len(created.Participants) != tt.wantParticipants {
+				t.Errorf("cloned participants = %d, want %d", len(created.Participants), tt.wantParticipants)
+			}
+			if len(got.Attachments) != tt.wantAttachments || len(savedAttachments) != tt.wantAttachments || len(copied) != tt.wantAttachments {
+				t.Errorf("cloned attachments = %d (saved %d, copied %d), want %d",
+					len(got.Attachments), len(savedAttachments), len(copied), tt.wantAttachments)
+			}
+			for _, attachment := range savedAttachments {
+				if attachment.EntityID == nil || *attachment.EntityID != created.ID {
+					t.Errorf("attachment linked to %v, want clone %v", attachment.EntityID, created.ID)
+				}
+				if attachment.FileURL == "board/boards/ws/spec.pdf" {
+					t.Error("cloned attachment must not share the source S3 key")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,416 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// Board export formats
+const (
+	BoardExportFormatCSV  = "csv"
+	BoardExportFormatJSON = "json"
+)
+
+// boardExportContentTypes maps each export format to the content type it is served with
+var boardExportContentTypes = map[string]string{
+	BoardExportFormatCSV:  "text/csv; charset=utf-8",
+	BoardExportFormatJSON: "application/json",
+}
+
+// boardExportFieldTypes are the custom fields a project can define, in column order
+var boardExportFieldTypes = []domain.FieldType{domain.FieldTypeStage, domain.FieldTypeRole, domain.FieldTypeImportance}
+
+// boardExportCSVHeader are the CSV columns before the project's custom fields
+var boardExportCSVHeader = []string{
+	"id", "projectId", "title", "content",
+	"authorId", "authorName", "assigneeId", "assigneeName", "participantIds", "participantNames",
+	"labels", "startDate", "dueDate", "estimateHours", "actualHours", "archivedAt", "createdAt", "updatedAt",
+}
+
+// BoardExport is a rendered export together with the file name and content type to serve it under
+// Project exports are written while Content is read, so the caller must read it to the end or close it
+type BoardExport struct {
+	Content     io.ReadCloser
+	FileName    string
+	ContentType string
+}
+
+// WithUserClient resolves assignee and participant IDs to display names in exports (nil = IDs only)
+func WithUserClient(userClient client.UserClient) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.userClient = userClient
+	}
+}
+
+// ExportBoard renders one board as CSV or JSON
+func (s *boardServiceImpl) ExportBoard(ctx context.Context, boardID uuid.UUID, format string) (export *BoardExport, err error) {
+	ctx, span := s.startSpan(ctx, "ExportBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	format, err = normalizeExportFormat(format)
+	if err != nil {
+		return nil, err
+	}
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, board.ProjectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project", err.Error())
+	}
+	fieldKeys, err := s.exportFieldKeys(ctx, board.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, []*domain.Board{board}); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+
+	var buf bytes.Buffer
+	w, err := newBoardExportWriter(&buf, format, fieldKeys, false)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to write export", err.Error())
+	}
+	names := s.newExportUserNames(ctx, project.WorkspaceID)
+	if err := w.write(toBoardExportRecord(ctx, board, names)); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to write export", err.Error())
+	}
+	if err := w.close(); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to write export", err.Error())
+	}
+
+	return &BoardExport{
+		Content:     io.NopCloser(&buf),
+		FileName:    fmt.Sprintf("board-%s.%s", board.ID, format),
+		ContentType: boardExportContentTypes[format],
+	}, nil
+}
+
+// ExportProjectBoards renders every board of a project matching query as CSV or JSON
+// query filters and sorts like ListBoards; its cursor and limit are ignored
+// The boards are read page by page while Content is consumed, so the project is never held in memory;
+// a failure after the first page surfaces as a read error on Content
+func (s *boardServiceImpl) ExportProjectBoards(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery, format string) (export *BoardExport, err error) {
+	ctx, span := s.startSpan(ctx, "ExportProjectBoards", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	format, err = normalizeExportFormat(format)
+	if err != nil {
+		return nil, err
+	}
+	if query == nil {
+		query = &dto.BoardListQuery{}
+	}
+	pageQuery, err := boardListPageQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	if len(query.CustomFields) > 0 {
+		converted, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, projectID, query.CustomFields)
+		if err != nil {
+			return nil, customFieldsError(err)
+		}
+		pageQuery.CustomFields = converted
+	}
+	pageQuery.Limit = maxBoardPageSize
+
+	fieldKeys, err := s.exportFieldKeys(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	names := s.newExportUserNames(ctx, project.WorkspaceID)
+	go func() {
+		pw.CloseWithError(s.writeProjectExport(ctx, pw, format, fieldKeys, projectID, pageQuery, names))
+	}()
+
+	return &BoardExport{
+		Content:     pr,
+		FileName:    fmt.Sprintf("project-%s-boards.%s", projectID, format),
+		ContentType: boardExportContentTypes[format],
+	}, nil
+}
+
+// writeProjectExport writes the boards of every page of pageQuery to out
+func (s *boardServiceImpl) writeProjectExport(ctx context.Context, out io.Writer, format string, fieldKeys []string, projectID uuid.UUID, pageQuery repository.BoardPageQuery, names *exportUserNames) error {
+	w, err := newBoardExportWriter(out, format, fieldKeys, true)
+	if err != nil {
+		return err
+	}
+
+	for {
+		boards, err := s.boardRepo.ListByProjectID(ctx, projectID, pageQuery)
+		if err != nil {
+			return fmt.Errorf("failed to fetch boards: %w", err)
+		}
+		if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+			return fmt.Errorf("failed to convert custom fields: %w", err)
+		}
+		for _, board := range boards {
+			if err := w.write(toBoardExportRecord(ctx, board, names)); err != nil {
+				return err
+			}
+		}
+		if len(boards) < pageQuery.Limit {
+			break
+		}
+		pageQuery.After = boards[len(boards)-1]
+	}
+
+	return w.close()
+}
+
+// normalizeExportFormat validates an export format; formats are case-insensitive
+func normalizeExportFormat(format string) (string, error) {
+	format = strings.ToLower(strings.TrimSpace(format))
+	if _, ok := boardExportContentTypes[format]; !ok {
+		return "", response.NewValidationError("Invalid export format", "format must be csv or json")
+	}
+	return format, nil
+}
+
+// exportFieldKeys returns the custom fields the project defines options for, in column order
+// Every CSV row gets these columns, whether or not the board has set the field
+func (s *boardServiceImpl) exportFieldKeys(ctx context.Context, projectID uuid.UUID) ([]string, error) {
+	var keys []string
+	for _, fieldType := range boardExportFieldTypes {
+		options, err := s.fieldOptionRepo.FindByProjectAndFieldType(ctx, projectID, fieldType)
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch field options", err.Error())
+		}
+		if len(options) > 0 {
+			keys = append(keys, string(fieldType))
+		}
+	}
+	return keys, nil
+}
+
+// toBoardExportRecord converts a board whose custom fields already hold option values
+func toBoardExportRecord(ctx context.Context, board *domain.Board, names *exportUserNames) *dto.BoardExportRecord {
+	customFields := map[string]interface{}{}
+	if len(board.CustomFields) > 0 {
+		_ = json.Unmarshal(board.CustomFields, &customFields)
+	}
+
+	record := &dto.BoardExportRecord{
+		ID:            board.ID,
+		ProjectID:     board.ProjectID,
+		Title:         board.Title,
+		Content:       board.Content,
+		Author:        names.user(ctx, board.AuthorID),
+		Participants:  make([]dto.BoardExportUser, 0, len(board.Participants)),
+		CustomFields:  customFields,
+		Labels:        make([]string, 0, len(board.Labels)),
+		StartDate:     exportTimePtr(board.StartDate),
+		DueDate:       exportTimePtr(board.DueDate),
+		EstimateHours: board.EstimateHours,
+		ActualHours:   board.ActualHours,
+		ArchivedAt:    exportTimePtr(board.ArchivedAt),
+		CreatedAt:     exportTime(board.CreatedAt),
+		UpdatedAt:     exportTime(board.UpdatedAt),
+	}
+	if board.AssigneeID != nil {
+		assignee := names.user(ctx, *board.AssigneeID)
+		record.Assignee = &assignee
+	}
+	for _, participant := range board.Participants {
+		record.Participants = append(record.Participants, names.user(ctx, participant.UserID))
+	}
+	for _, label := range board.Labels {
+		record.Labels = append(record.Labels, label.Name)
+	}
+	return record
+}
+
+// exportTime formats t as ISO-8601 in UTC
+func exportTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func exportTimePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := exportTime(*t)
+	return &formatted
+}
+
+// exportUserNames resolves user IDs to workspace display names, looking each user up once per export
+// Lookups need the caller's token; without a user client or token, or when a lookup fails, the name stays empty
+type exportUserNames struct {
+	userClient  client.UserClient
+	workspaceID uuid.UUID
+	token       string
+	names       map[uuid.UUID]string
+}
+
+func (s *boardServiceImpl) newExportUserNames(ctx context.Context, workspaceID uuid.UUID) *exportUserNames {
+	token, _ := ctx.Value("jwtToken").(string)
+	return &exportUserNames{
+		userClient:  s.userClient,
+		workspaceID: workspaceID,
+		token:       token,
+		names:       make(map[uuid.UUID]string),
+	}
+}
+
+func (n *exportUserNames) user(ctx context.Context, userID uuid.UUID) dto.BoardExportUser {
+	name, ok := n.names[userID]
+	if !ok {
+		if n.userClient != nil && n.token != "" {
+			if profile, err := n.userClient.GetWorkspaceProfile(ctx, n.workspaceID, userID, n.token); err == nil && profile != nil {
+				name = profile.NickName
+			}
+		}
+		n.names[userID] = name
+	}
+	return dto.BoardExportUser{ID: userID, Name: name}
+}
+
+// boardExportWriter renders export records one at a time
+type boardExportWriter interface {
+	write(record *dto.BoardExportRecord) error
+	// close finishes the document; nothing is complete until it returns
+	close() error
+}
+
+// newBoardExportWriter creates the writer for format; list writes a JSON array instead of a single object
+func newBoardExportWriter(out io.Writer, format string, fieldKeys []string, list bool) (boardExportWriter, error) {
+	if format == BoardExportFormatJSON {
+		return &jsonBoardExportWriter{out: out, list: list}, nil
+	}
+
+	w := csv.NewWriter(out)
+	if err := w.Write(append(append([]string{}, boardExportCSVHeader...), fieldKeys...)); err != nil {
+		return nil, err
+	}
+	return &csvBoardExportWriter{w: w, fieldKeys: fieldKeys}, nil
+}
+
+// csvBoardExportWriter writes one row per board; users and labels are joined with "; "
+type csvBoardExportWriter struct {
+	w         *csv.Writer
+	fieldKeys []string
+}
+
+func (c *csvBoardExportWriter) write(record *dto.BoardExportRecord) error {
+	var assigneeID, assigneeName string
+	if record.Assignee != nil {
+		assigneeID, assigneeName = record.Assignee.ID.String(), record.Assignee.Name
+	}
+	participantIDs := make([]string, len(record.Participants))
+	participantNames := make([]string, len(record.Participants))
+	for i, participant := range record.Participants {
+		participantIDs[i], participantNames[i] = participant.ID.String(), participant.Name
+	}
+
+	row := []string{
+		record.ID.String(), record.ProjectID.String(), record.Title, record.Content,
+		record.Author.ID.String(), record.Author.Name, assigneeID, assigneeName,
+		strings.Join(participantIDs, "; "), strings.Join(participantNames, "; "),
+		strings.Join(record.Labels, "; "), stringOrEmpty(record.StartDate), stringOrEmpty(record.DueDate),
+		formatHours(record.EstimateHours), formatHours(record.ActualHours), stringOrEmpty(record.ArchivedAt),
+		record.CreatedAt, record.UpdatedAt,
+	}
+	for _, key := range c.fieldKeys {
+		value := ""
+		if v, ok := record.CustomFields[key]; ok && v != nil {
+			value = fmt.Sprint(v)
+		}
+		row = append(row, value)
+	}
+	return c.w.Write(row)
+}
+
+func (c *csvBoardExportWriter) close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonBoardExportWriter writes a single object, or an array written element by element
+type jsonBoardExportWriter struct {
+	out     io.Writer
+	list    bool
+	written int
+}
+
+func (j *jsonBoardExportWriter) write(record *dto.BoardExportRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	prefix := ""
+	if j.list {
+		prefix = ",\n"
+		if j.written == 0 {
+			prefix = "[\n"
+		}
+	}
+	if _, err := io.WriteString(j.out, prefix); err != nil {
+		return err
+	}
+	if _, err := j.out.Write(data); err != nil {
+		return err
+	}
+	j.written++
+	return nil
+}
+
+func (j *jsonBoardExportWriter) close() error {
+	suffix := "\n"
+	if j.list {
+		suffix = "\n]\n"
+		if j.written == 0 {
+			suffix = "[]\n"
+		}
+	}
+	_, err := io.WriteString(j.out, suffix)
+	return err
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func formatHours(hours *float64) string {
+	if hours == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*hours, 'f', -1, 64)
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/client"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// newExportTestMocks wires the mocks of a project that defines stage and importance options
+// Boards store the option IDs in optionValues, which the converter resolves back to values
+func newExportTestMocks(boardRepo *MockBoardRepository, workspaceID uuid.UUID, optionValues map[string]string, names map[uuid.UUID]string) boardServiceMocks {
+	projectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}, WorkspaceID: workspaceID}, nil
+		},
+	}
+	fieldOptionRepo := &MockFieldOptionRepository{
+		FindByProjectAndFieldTypeFunc: func(ctx context.Context, projectID uuid.UUID, fieldType domain.FieldType) ([]*domain.FieldOption, error) {
+			if fieldType == domain.FieldTypeRole {
+				return nil, nil
+			}
+			return []*domain.FieldOption{{FieldType: fieldType}}, nil
+		},
+	}
+	converter := &MockFieldOptionConverter{
+		ConvertIDsToValuesBatchFunc: func(ctx context.Context, boards []*domain.Board) error {
+			for _, board := range boards {
+				var fields map[string]interface{}
+				_ = json.Unmarshal(board.CustomFields, &fields)
+				for key, id := range fields {
+					fields[key] = optionValues[id.(string)]
+				}
+				board.CustomFields, _ = json.Marshal(fields)
+			}
+			return nil
+		},
+	}
+	userClient := &MockUserClient{
+		GetWorkspaceProfileFunc: func(ctx context.Context, wsID, userID uuid.UUID, token string) (*client.WorkspaceProfile, error) {
+			if wsID != workspaceID || token != "token" {
+				return nil, errors.New("unauthorized")
+			}
+			name, ok := names[userID]
+			if !ok {
+				return nil, errors.New("user not found")
+			}
+			return &client.WorkspaceProfile{UserID: userID, NickName: name}, nil
+		},
+	}
+	return boardServiceMocks{boardRepo: boardRepo, projectRepo: projectRepo, fieldOptionRepo: fieldOptionRepo, converter: converter, userClient: userClient}
+}
+
+func TestBoardService_ExportProjectBoards_CSV(t *testing.T) {
+	projectID := uuid.New()
+	authorID, assigneeID, unknownID := uuid.New(), uuid.New(), uuid.New()
+	seoul := time.FixedZone("KST", 9*60*60)
+	due := time.Date(2024, 3, 1, 9, 0, 0, 0, seoul)
+
+	// Two full pages and a partial one; only the first board sets a custom field
+	var boards []*domain.Board
+	for i := 0; i < 2*maxBoardPageSize+1; i++ {
+		boards = append(boards, &domain.Board{
+			BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: due, UpdatedAt: due},
+			ProjectID: projectID,
+			AuthorID:  authorID,
+			Title:     "Board",
+		})
+	}
+	boards[0].CustomFields = []byte(`{"stage":"option-stage"}`)
+	boards[0].AssigneeID = &assigneeID
+	boards[0].DueDate = &due
+	boards[0].Participants = []domain.Participant{{UserID: assigneeID}, {UserID: unknownID}}
+
+	var queries []repository.BoardPageQuery
+	boardRepo := &MockBoardRepository{
+		ListByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error) {
+			queries = append(queries, query)
+			start := 0
+			if query.After != nil {
+				for i, board := range boards {
+					if board.ID == query.After.ID {
+						start = i + 1
+					}
+				}
+			}
+			end := min(start+query.Limit, len(boards))
+			return boards[start:end], nil
+		},
+	}
+	service := newTestBoardService(newExportTestMocks(boardRepo, uuid.New(), map[string]string{"option-stage": "in_progress"},
+		map[uuid.UUID]string{authorID: "Author", assigneeID: "Assignee"}))
+
+	ctx := context.WithValue(context.Background(), "jwtToken", "token")
+	export, err := service.ExportProjectBoards(ctx, projectID, &dto.BoardListQuery{SortBy: "dueDate"}, "CSV")
+	if err != nil {
+		t.Fatalf("ExportProjectBoards() error = %v", err)
+	}
+	defer export.Content.Close()
+	if export.ContentType != "text/csv; charset=utf-8" || export.FileName != "project-"+projectID.String()+"-boards.csv" {
+		t.Errorf("export served as %q named %q", export.ContentType, export.FileName)
+	}
+
+	rows, err := csv.NewReader(export.Content).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	if len(queries) != 3 {
+		t.Errorf("expected 3 page queries, got %d", len(queries))
+	}
+	if len(rows) != len(boards)+1 {
+		t.Fatalf("expected header and %d rows, got %d rows", len(boards), len(rows))
+	}
+
+	header := rows[0]
+	column := make(map[string]int, len(header))
+	for i, name := range header {
+		column[name] = i
+	}
+	if _, ok := column["role"]; ok {
+		t.Error("role has no options in the project and must not be a column")
+	}
+	for _, name := range []string{"stage", "importance"} {
+		if _, ok := column[name]; !ok {
+			t.Errorf("expected a %s column, got header %v", name, header)
+		}
+	}
+	for i, row := range rows {
+		if len(row) != len(header) {
+			t.Fatalf("row %d has %d columns, want %d", i, len(row), len(header))
+		}
+	}
+
+	first := rows[1]
+	want := map[string]string{
+		"stage":            "in_progress",
+		"importance":       "",
+		"authorName":       "Author",
+		"assigneeName":     "Assignee",
+		"participantIds":   assigneeID.String() + "; " + unknownID.String(),
+		"participantNames": "Assignee; ",
+		"dueDate":          "2024-03-01T00:00:00Z",
+		"createdAt":        "2024-03-01T00:00:00Z",
+	}
+	for name, value := range want {
+		if got := first[column[name]]; got != value {
+			t.Errorf("%s = %q, want %q", name, got, value)
+		}
+	}
+}
+
+func TestBoardService_ExportBoard_JSON(t *testing.T) {
+	workspaceID := uuid.New()
+	authorID := uuid.New()
+	start := time.Date(2024, 5, 1, 12, 30, 0, 0, time.FixedZone("PST", -8*60*60))
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: uuid.New(), CreatedAt: start, UpdatedAt: start},
+		ProjectID:    uuid.New(),
+		AuthorID:     authorID,
+		Title:        "Launch",
+		CustomFields: []byte(`{"importance":"option-high"}`),
+		StartDate:    &start,
+		Labels:       []domain.Label{{Name: "release"}},
+	}
+	boardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return board, nil
+		},
+	}
+	service := newTestBoardService(newExportTestMocks(boardRepo, workspaceID, map[string]string{"option-high": "high"}, map[uuid.UUID]string{authorID: "Author"}))
+
+	// Without the caller's token names cannot be looked up
+	export, err := service.ExportBoard(context.Background(), board.ID, "json")
+	if err != nil {
+		t.Fatalf("ExportBoard() error = %v", err)
+	}
+	data, err := io.ReadAll(export.Content)
+	if err != nil {
+		t.Fatalf("failed to read export: %v", err)
+	}
+
+	var record dto.BoardExportRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		t.Fatalf("export is not a JSON object: %v", err)
+	}
+	if record.CustomFields["importance"] != "high" {
+		t.Errorf("importance = %v, want the option value", record.CustomFields["importance"])
+	}
+	if record.StartDate == nil || *record.StartDate != "2024-05-01T20:30:00Z" {
+		t.Errorf("startDate = %v, want UTC ISO-8601", record.StartDate)
+	}
+	if record.Author.ID != authorID || record.Author.Name != "" {
+		t.Errorf("author = %+v, want the ID without a name", record.Author)
+	}
+	if len(record.Labels) != 1 || record.Labels[0] != "release" {
+		t.Errorf("labels = %v", record.Labels)
+	}
+	if export.FileName != "board-"+board.ID.String()+".json" {
+		t.Errorf("file name = %q", export.FileName)
+	}
+}
+
+func TestBoardService_ExportBoard_RejectsUnknownFormat(t *testing.T) {
+	service := newTestBoardService(newExportTestMocks(&MockBoardRepository{}, uuid.New(), nil, nil))
+
+	_, err := service.ExportBoard(context.Background(), uuid.New(), "xlsx")
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("error = %v, want validation error", err)
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"strings"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
 
+	"project-board-api/internal/converter"
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
 	"project-board-api/internal/response"
@@ -20,12 +22,7 @@ func (s *boardServiceImpl) convertBoardCustomFieldsToValues(ctx context.Context,
 		return nil
 	}
 
-	var customFields map[string]interface{}
-	if err := json.Unmarshal(board.CustomFields, &customFields); err != nil {
-		return err
-	}
-
-	convertedFields, err := s.fieldOptionConverter.ConvertIDsToValues(ctx, customFields)
+	convertedFields, err := s.readableCustomFields(ctx, board.CustomFields)
 	if err != nil {
 		return err
 	}
@@ -39,6 +36,22 @@ func (s *boardServiceImpl) convertBoardCustomFieldsToValues(ctx context.Context,
 	return nil
 }
 
+// toAttachmentResponse converts domain.Attachment to dto.AttachmentResponse
+func (s *boardServiceImpl) toAttachmentResponse(a *domain.Attachment) dto.AttachmentResponse {
+	return dto.AttachmentResponse{
+		ID:             a.ID,
+		FileName:       a.FileName,
+		FileURL:        s.s3Client.GetFileURL(a.FileURL), // DB의 FileURL은 S3 Key이므로 full URL로 변환
+		FileSize:       a.FileSize,
+		ContentType:    a.ContentType,
+		UploadedBy:     a.UploadedBy,
+		UploadedAt:     a.CreatedAt,
+		ChecksumSHA256: a.ChecksumSHA256,
+		DownloadCount:  a.DownloadCount,
+		Visibility:     string(a.Visibility),
+	}
+}
+
 // toBoardResponse converts domain.Board to dto.BoardResponse
 func (s *boardServiceImpl) toBoardResponse(board *domain.Board) *dto.BoardResponse {
 	// Convert datatypes.JSON to map[string]interface{}
@@ -55,35 +68,63 @@ func (s *boardServiceImpl) toBoardResponse(board *domain.Board) *dto.BoardRespon
 
 	// Convert attachments to response DTOs with s3Client.GetFileURL
 	attachments := make([]dto.AttachmentResponse, 0, len(board.Attachments))
-	for _, a := range board.Attachments {
-		// s3Client.GetFileURL을 사용하여 FileURL 필드 채우기 (DB의 FileURL은 S3 Key)
-		fileURL := s.s3Client.GetFileURL(a.FileURL)
+	for i := range board.Attachments {
+		attachments = append(attachments, s.toAttachmentResponse(&board.Attachments[i]))
+	}
+
+	// List queries compute the overdue flag in SQL; single-board reads fall back to Go
+	isOverdue := board.IsOverdue(time.Now())
+	if board.Overdue != nil {
+		isOverdue = *board.Overdue
+	}
 
-		attachments = append(attachments, dto.AttachmentResponse{
-			ID:          a.ID,
-			FileName:    a.FileName,
-			FileURL:     fileURL, // full URL 반환
-			FileSize:    a.FileSize,
-			ContentType: a.ContentType,
-			UploadedBy:  a.UploadedBy,
-			UploadedAt:  a.CreatedAt,
-		})
+	var coverThumbnailURL *string
+	if board.Cover != nil {
+		url := s.s3Client.GetFileURL(board.Cover.FileURL)
+		coverThumbnailURL = &url
 	}
 
 	return &dto.BoardResponse{
-		ID:             board.ID,
-		ProjectID:      board.ProjectID,
-		AuthorID:       board.AuthorID,
-		AssigneeID:     board.AssigneeID,
-		Title:          board.Title,
-		Content:        board.Content,
-		CustomFields:   customFields,
-		StartDate:      board.StartDate,
-		DueDate:        board.DueDate,
-		ParticipantIDs: participantIDs,
-		Attachments:    attachments,
-		CreatedAt:      board.CreatedAt,
-		UpdatedAt:      board.UpdatedAt,
+		ID:                board.ID,
+		ProjectID:         board.ProjectID,
+		AuthorID:          board.AuthorID,
+		AssigneeID:        board.AssigneeID,
+		Title:             board.Title,
+		Content:           board.Content,
+		CustomFields:      customFields,
+		ExternalID:        board.ExternalID,
+		StartDate:         utcTime(board.StartDate),
+		DueDate:           utcTime(board.DueDate),
+		EstimateHours:     board.EstimateHours,
+		ActualHours:       board.ActualHours,
+		Variance:          effortVariance(board.EstimateHours, board.ActualHours),
+		IsOverdue:         isOverdue,
+		ParticipantIDs:    participantIDs,
+		Labels:            toLabelResponses(board.Labels),
+		Checklist:         checklistProgress(board.ChecklistItems),
+		Attachments:       attachments,
+		CoverThumbnailURL: coverThumbnailURL,
+		CreatedAt:         board.CreatedAt.UTC(),
+		UpdatedAt:         board.UpdatedAt.UTC(),
+		Version:           board.Version,
+		ArchivedAt:        utcTime(board.ArchivedAt),
+		ArchiveReason:     board.ArchiveReason,
+		LastActivityAt:    utcTime(board.LastActivityAt),
+	}
+}
+
+// markUnread sets hasUnread for the requesting user
+// Users who do not participate in the board do not track what they have seen, so it stays false for them
+func markUnread(ctx context.Context, board *domain.Board, resp *dto.BoardResponse) {
+	userID, ok := ctx.Value("user_id").(uuid.UUID)
+	if !ok {
+		return
+	}
+	for i := range board.Participants {
+		if board.Participants[i].UserID == userID {
+			resp.HasUnread = board.Participants[i].HasUnread(board.LastActivityAt)
+			return
+		}
 	}
 }
 
@@ -93,10 +134,11 @@ func (s *boardServiceImpl) toBoardDetailResponse(board *domain.Board) *dto.Board
 	participants := make([]dto.ParticipantResponse, len(board.Participants))
 	for i, p := range board.Participants {
 		participants[i] = dto.ParticipantResponse{
-			ID:        p.ID,
-			BoardID:   p.BoardID,
-			UserID:    p.UserID,
-			CreatedAt: p.CreatedAt,
+			ID:         p.ID,
+			BoardID:    p.BoardID,
+			UserID:     p.UserID,
+			CreatedAt:  p.CreatedAt,
+			LastSeenAt: p.LastSeenAt,
 		}
 	}
 
@@ -113,53 +155,38 @@ func (s *boardServiceImpl) toBoardDetailResponse(board *domain.Board) *dto.Board
 		}
 	}
 
+	checklistItems := make([]dto.ChecklistItemResponse, len(board.ChecklistItems))
+	for i := range board.ChecklistItems {
+		checklistItems[i] = toChecklistItemResponse(&board.ChecklistItems[i])
+	}
+
 	return &dto.BoardDetailResponse{
-		BoardResponse: *s.toBoardResponse(board),
-		Participants:  participants,
-		Comments:      comments,
+		BoardResponse:  *s.toBoardResponse(board),
+		Participants:   participants,
+		Comments:       comments,
+		ChecklistItems: checklistItems,
 	}
 }
 
 // addParticipantsInternal is an internal helper to add participants during board creation
 // It does not verify board existence (assumes board was just created)
-// Returns the number of successfully added participants and any errors
-func (s *boardServiceImpl) addParticipantsInternal(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) (int, error) {
+// Users who already participate keep their membership and are included in the result;
+// users that fail to be added are logged and left out
+func (s *boardServiceImpl) addParticipantsInternal(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) ([]*domain.Participant, error) {
 	// Remove duplicates from the user IDs
 	uniqueUserIDs := removeDuplicateUUIDs(userIDs)
 
-	successCount := 0
+	participants := make([]*domain.Participant, 0, len(uniqueUserIDs))
 	var failedUserIDs []uuid.UUID
 
-	// Process each participant individually
 	for _, userID := range uniqueUserIDs {
-		// Check if participant already exists
-		existing, err := s.participantRepo.FindByBoardAndUser(ctx, boardID, userID)
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			s.logger.Warn("Failed to check existing participant",
-				zap.String("board_id", boardID.String()),
-				zap.String("user_id", userID.String()),
-				zap.Error(err))
-			failedUserIDs = append(failedUserIDs, userID)
-			continue
-		}
-		if existing != nil {
-			// Participant already exists, skip
-			continue
-		}
-
-		// Create domain model
 		participant := &domain.Participant{
 			BoardID: boardID,
 			UserID:  userID,
 		}
 
-		// Save to repository
-		if err := s.participantRepo.Create(ctx, participant); err != nil {
-			// Check for unique constraint violation
-			if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
-				// Participant already exists, skip
-				continue
-			}
+		// Upsert on (board_id, user_id): an existing membership is returned instead of an error
+		if err := s.participantRepo.Upsert(ctx, participant); err != nil {
 			s.logger.Warn("Failed to add participant",
 				zap.String("board_id", boardID.String()),
 				zap.String("user_id", userID.String()),
@@ -168,54 +195,114 @@ func (s *boardServiceImpl) addParticipantsInternal(ctx context.Context, boardID
 			continue
 		}
 
-		successCount++
+		participants = append(participants, participant)
 	}
 
 	// Log summary if there were failures
 	if len(failedUserIDs) > 0 {
 		s.logger.Warn("Some participants failed to be added during board creation",
 			zap.String("board_id", boardID.String()),
-			zap.Int("success_count", successCount),
+			zap.Int("success_count", len(participants)),
 			zap.Int("failed_count", len(failedUserIDs)),
 			zap.Any("failed_user_ids", failedUserIDs))
 	}
 
-	return successCount, nil
+	return participants, nil
 }
 
-// validateAndConfirmAttachments validates that attachments exist and are in TEMP status
-func (s *boardServiceImpl) validateAndConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityType domain.EntityType, entityID uuid.UUID) error {
-	if len(attachmentIDs) == 0 {
-		return nil
+// marshalCustomFields serializes converted custom fields and enforces the configured size limit
+// The check runs on the final JSON that will be stored in the custom_fields column
+func (s *boardServiceImpl) marshalCustomFields(convertedFields map[string]interface{}) ([]byte, error) {
+	jsonBytes, err := json.Marshal(convertedFields)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to marshal custom fields", err.Error())
 	}
 
-	// Fetch attachments by IDs
-	attachments, err := s.attachmentRepo.FindByIDs(ctx, attachmentIDs)
-	if err != nil {
-		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachments", err.Error())
+	if s.maxCustomFieldsBytes > 0 && len(jsonBytes) > s.maxCustomFieldsBytes {
+		return nil, response.NewAppError(response.ErrCodeTooLarge, "Custom fields payload is too large",
+			fmt.Sprintf("size %d bytes exceeds limit of %d bytes", len(jsonBytes), s.maxCustomFieldsBytes))
 	}
 
-	// Check if all attachments exist
-	if len(attachments) != len(attachmentIDs) {
-		return response.NewAppError(response.ErrCodeValidation, "One or more attachments not found", "")
+	return jsonBytes, nil
+}
+
+// customFieldsError maps a failed custom field conversion to an AppError
+// Invalid values are reported per field so clients can highlight every offending input at once
+func customFieldsError(err error) error {
+	var fieldErr *converter.FieldValuesError
+	if errors.As(err, &fieldErr) {
+		appErr := response.NewFieldValidationError("Invalid custom field values", fieldErr.Fields)
+		appErr.Details = fieldErr.Error()
+		return appErr
 	}
+	return response.NewAppError(response.ErrCodeInternal, "Failed to convert custom field values", err.Error())
+}
 
-	// Validate each attachment
-	for _, attachment := range attachments {
-		// Check if attachment is in TEMP status
-		if attachment.Status != domain.AttachmentStatusTemp {
-			return response.NewAppError(response.ErrCodeValidation, "Attachment is not in temporary status and cannot be reused", "")
-		}
+// convertedCustomFields is one board's result of a batch conversion in a project
+type convertedCustomFields struct {
+	projectID uuid.UUID
+	converter.ConvertedFields
+}
+
+// convertCustomFields converts a board's custom field values to option IDs
+// A batch conversion done ahead for the same project is used as is, so batch operations
+// look up the project's options once instead of once per board
+func (s *boardServiceImpl) convertCustomFields(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}, converted *convertedCustomFields) (map[string]interface{}, error) {
+	if converted != nil && converted.projectID == projectID {
+		return converted.Fields, converted.Err
+	}
+	return s.fieldOptionConverter.ConvertValuesToIDs(ctx, projectID, customFields)
+}
+
+// checkBoardQuota rejects adding boards when that would exceed the project's active board limit
+// It locks the project row before counting, so call it inside the transaction that adds the boards:
+// concurrent writers to the same project then wait for each other instead of all passing the check
+func (s *boardServiceImpl) checkBoardQuota(ctx context.Context, projectID uuid.UUID, adding int) error {
+	if s.maxBoardsPerProject <= 0 {
+		return nil
+	}
 
-		// Check if attachment entity type matches
-		if attachment.EntityType != entityType {
-			return response.NewAppError(response.ErrCodeValidation, "Attachment entity type does not match", "")
+	if err := s.projectRepo.LockByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
 		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to lock project", err.Error())
+	}
+
+	count, err := s.boardRepo.CountActiveByProjectID(ctx, projectID)
+	if err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to count boards", err.Error())
+	}
+	if count+int64(adding) > int64(s.maxBoardsPerProject) {
+		return response.NewAppError(response.ErrCodeQuotaExceeded, "Board quota exceeded for this project",
+			fmt.Sprintf("maximum %d boards per project", s.maxBoardsPerProject))
 	}
 
 	return nil
 }
 
+// checkTitleAvailable rejects title if another active board of the project holds it under the unique title constraint
+// boardID is the board being renamed, or uuid.Nil for a new board
+func (s *boardServiceImpl) checkTitleAvailable(ctx context.Context, projectID, boardID uuid.UUID, title string) error {
+	existing, err := s.boardRepo.FindByUniqueTitle(ctx, projectID, title)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to check board title", err.Error())
+	}
+	if existing.ID != boardID {
+		return response.NewAppError(response.ErrCodeConflict, "A board with this title already exists in the project", title)
+	}
+	return nil
+}
+
+// validateAndConfirmAttachments validates that attachments exist, are in TEMP status
+// and were not uploaded for a project other than the board's
+func (s *boardServiceImpl) validateAndConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityType domain.EntityType, projectID uuid.UUID) error {
+	return validateAttachmentsForConfirmation(ctx, s.attachmentRepo, s.s3Client, attachmentIDs, entityType, projectID)
+}
+
 // deleteAttachmentsWithS3 deletes attachments from both S3 and database
 func (s *boardServiceImpl) deleteAttachmentsWithS3(ctx context.Context, attachments []*domain.Attachment) {
 	attachmentIDs := make([]uuid.UUID, 0, len(attachments))
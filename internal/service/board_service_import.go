@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// ImportBoards creates or updates boards from an external system, matching them by external ID within the project
+// Items are applied one by one; a failed item is reported in its result and does not stop the import
+// Custom fields of all items are converted in one batch before the first item is applied
+func (s *boardServiceImpl) ImportBoards(ctx context.Context, req *dto.ImportBoardsRequest) (resp *dto.ImportBoardsResponse, err error) {
+	ctx, span := s.startSpan(ctx, "ImportBoards", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	if _, err := s.projectRepo.FindByID(ctx, req.ProjectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	// Every item's custom fields are converted up front, loading the project's options once
+	customFields := make([]map[string]interface{}, len(req.Items))
+	for i := range req.Items {
+		customFields[i] = req.Items[i].CustomFields
+	}
+	converted, err := s.fieldOptionConverter.ConvertValuesToIDsBatch(ctx, req.ProjectID, customFields)
+	if err != nil {
+		return nil, customFieldsError(err)
+	}
+
+	resp = &dto.ImportBoardsResponse{Results: make([]dto.ImportBoardResult, 0, len(req.Items))}
+	for i := range req.Items {
+		var itemConverted *convertedCustomFields
+		if req.Items[i].CustomFields != nil {
+			itemConverted = &convertedCustomFields{projectID: req.ProjectID, ConvertedFields: converted[i]}
+		}
+		result := s.importBoard(ctx, req.ProjectID, &req.Items[i], itemConverted)
+		switch {
+		case result.Error != "":
+			resp.Failed++
+		case result.Created:
+			resp.Created++
+		default:
+			resp.Updated++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	s.logger.Info("Boards imported",
+		zap.String("project_id", req.ProjectID.String()),
+		zap.Int("created", resp.Created),
+		zap.Int("updated", resp.Updated),
+		zap.Int("failed", resp.Failed))
+
+	return resp, nil
+}
+
+// importBoard updates the board already imported with item's external ID, or creates it
+// converted holds the item's custom fields from the import's batch conversion
+func (s *boardServiceImpl) importBoard(ctx context.Context, projectID uuid.UUID, item *dto.ImportBoardItem, converted *convertedCustomFields) dto.ImportBoardResult {
+	result := dto.ImportBoardResult{ExternalID: item.ExternalID}
+
+	existing, err := s.boardRepo.FindByExternalID(ctx, projectID, item.ExternalID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		result.Error = "Failed to look up board"
+		return result
+	}
+
+	var board *dto.BoardResponse
+	if existing != nil {
+		update := &dto.UpdateBoardRequest{
+			Title:         &item.Title,
+			Content:       &item.Content,
+			AssigneeID:    item.AssigneeID,
+			StartDate:     dto.OptionalOf(item.StartDate),
+			DueDate:       dto.OptionalOf(item.DueDate),
+			EstimateHours: item.EstimateHours,
+			ActualHours:   item.ActualHours,
+		}
+		if item.CustomFields != nil {
+			update.CustomFields = &item.CustomFields
+		}
+		board, err = s.updateBoard(ctx, existing.ID, update, converted)
+	} else {
+		externalID := item.ExternalID
+		board, err = s.createBoard(ctx, &dto.CreateBoardRequest{
+			ProjectID:     projectID,
+			Title:         item.Title,
+			Content:       item.Content,
+			CustomFields:  item.CustomFields,
+			AssigneeID:    item.AssigneeID,
+			StartDate:     item.StartDate,
+			DueDate:       item.DueDate,
+			EstimateHours: item.EstimateHours,
+			ActualHours:   item.ActualHours,
+			ExternalID:    &externalID,
+		}, converted)
+		result.Created = err == nil
+	}
+	if err != nil {
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			result.Error = appErr.Message
+		} else {
+			result.Error = err.Error()
+		}
+		return result
+	}
+
+	result.BoardID = board.ID
+	return result
+}
+
+// checkExternalIDAvailable rejects an external ID already used by an active board of the project
+func (s *boardServiceImpl) checkExternalIDAvailable(ctx context.Context, projectID uuid.UUID, externalID string) error {
+	_, err := s.boardRepo.FindByExternalID(ctx, projectID, externalID)
+	if err == nil {
+		return response.NewAppError(response.ErrCodeAlreadyExists, "A board with this external ID already exists in the project", externalID)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to check external ID", err.Error())
+	}
+	return nil
+}
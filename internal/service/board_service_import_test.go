@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardService_ImportBoards_ReimportUpdates(t *testing.T) {
+	// Given: an in-memory board store keyed by ID
+	projectID := uuid.New()
+	boards := map[uuid.UUID]*domain.Board{}
+	mockBoardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			boards[board.ID] = board
+			return nil
+		},
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			if board, ok := boards[id]; ok {
+				return board, nil
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		FindByExternalIDFunc: func(ctx context.Context, pid uuid.UUID, externalID string) (*domain.Board, error) {
+			for _, board := range boards {
+				if board.ProjectID == pid && board.ExternalID != nil && *board.ExternalID == externalID {
+					return board, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			boards[board.ID] = board
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, zap.NewNop())
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	importRequest := func(suffix string) *dto.ImportBoardsRequest {
+		return &dto.ImportBoardsRequest{
+			ProjectID: projectID,
+			Items: []dto.ImportBoardItem{
+				{ExternalID: "JIRA-1", Title: "First" + suffix},
+				{ExternalID: "JIRA-2", Title: "Second" + suffix},
+			},
+		}
+	}
+
+	// When
+	first, err := service.ImportBoards(ctx, importRequest(""))
+	if err != nil {
+		t.Fatalf("first ImportBoards() error = %v", err)
+	}
+	second, err := service.ImportBoards(ctx, importRequest(" (edited)"))
+	if err != nil {
+		t.Fatalf("second ImportBoards() error = %v", err)
+	}
+
+	// Then
+	if first.Created != 2 || first.Updated != 0 {
+		t.Errorf("first import created %d, updated %d; want 2 created", first.Created, first.Updated)
+	}
+	if second.Created != 0 || second.Updated != 2 || second.Failed != 0 {
+		t.Errorf("second import created %d, updated %d, failed %d; want 2 updated", second.Created, second.Updated, second.Failed)
+	}
+	if len(boards) != 2 {
+		t.Fatalf("boards stored = %d, want 2", len(boards))
+	}
+	for i, result := range second.Results {
+		if result.BoardID != first.Results[i].BoardID {
+			t.Errorf("%s re-imported into %v, want existing board %v", result.ExternalID, result.BoardID, first.Results[i].BoardID)
+		}
+		if got := boards[result.BoardID].Title; got != importRequest(" (edited)").Items[i].Title {
+			t.Errorf("%s title = %q, want the re-imported title", result.ExternalID, got)
+		}
+	}
+}
+
+func TestBoardService_ImportBoards_ConvertsCustomFieldsInOneBatch(t *testing.T) {
+	projectID := uuid.New()
+	var created []*domain.Board
+	mockBoardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			created = append(created, board)
+			return nil
+		},
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			for _, board := range created {
+				if board.ID == id {
+					return board, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	batches := 0
+	mockConverter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, pid uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			t.Errorf("ConvertValuesToIDs() called for %v, want the batch conversion to be reused", fields)
+			return fields, nil
+		},
+		ConvertValuesToIDsBatchFunc: func(ctx context.Context, pid uuid.UUID, fields []map[string]interface{}) ([]converter.ConvertedFields, error) {
+			batches++
+			return []converter.ConvertedFields{
+				{Fields: map[string]interface{}{"stage": "option-todo"}},
+				{Fields: nil},
+				{Err: &converter.FieldValuesError{Fields: map[string]string{"stage": "invalid field option value 'later'"}}},
+			}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, &MockS3Client{}, mockConverter, nil, zap.NewNop())
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	resp, err := service.ImportBoards(ctx, &dto.ImportBoardsRequest{
+		ProjectID: projectID,
+		Items: []dto.ImportBoardItem{
+			{ExternalID: "JIRA-1", Title: "First", CustomFields: map[string]interface{}{"stage": "todo"}},
+			{ExternalID: "JIRA-2", Title: "Second"},
+			{ExternalID: "JIRA-3", Title: "Third", CustomFields: map[string]interface{}{"stage": "later"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ImportBoards() error = %v", err)
+	}
+
+	if batches != 1 {
+		t.Errorf("ConvertValuesToIDsBatch() called %d times, want 1", batches)
+	}
+	if resp.Created != 2 || resp.Failed != 1 || resp.Results[2].Error != "Invalid custom field values" {
+		t.Errorf("import = %+v, want the third item rejected for its custom fields", resp)
+	}
+	if len(created) != 2 || string(created[0].CustomFields) != `{"stage":"option-todo"}` || created[1].CustomFields != nil {
+		t.Errorf("created boards = %+v, want the batch-converted custom fields", created)
+	}
+}
@@ -0,0 +1,304 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+const (
+	defaultBoardPageSize = 50
+	maxBoardPageSize     = 100
+)
+
+// boardSortDefaultDesc is the direction of a sort key whose direction is not given
+// Newest boards come first; titles and dates read naturally from the earliest
+var boardSortDefaultDesc = map[string]bool{
+	repository.BoardSortCreatedAt: true,
+	repository.BoardSortTitle:     false,
+	repository.BoardSortStartDate: false,
+	repository.BoardSortDueDate:   false,
+}
+
+// boardSortColumns maps the public sortBy values to repository sort columns
+var boardSortColumns = map[string]string{
+	"":          repository.BoardSortCreatedAt,
+	"createdAt": repository.BoardSortCreatedAt,
+	"title":     repository.BoardSortTitle,
+	"startDate": repository.BoardSortStartDate,
+	"dueDate":   repository.BoardSortDueDate,
+}
+
+// ListBoards returns one page of a project's boards, newest first unless another order is requested
+// Custom field filters take option values and are converted to the stored option IDs before querying
+// The cursor is the opaque nextCursor of the previous page and is only valid for the same sort order
+func (s *boardServiceImpl) ListBoards(ctx context.Context, projectID uuid.UUID, query *dto.BoardListQuery) (resp *dto.BoardListPageResponse, err error) {
+	ctx, span := s.startSpan(ctx, "ListBoards", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	if query == nil {
+		query = &dto.BoardListQuery{}
+	}
+
+	pageQuery, err := boardListPageQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit < 1 || limit > maxBoardPageSize {
+		limit = defaultBoardPageSize
+	}
+	// One extra row tells whether another page follows
+	pageQuery.Limit = limit + 1
+
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	// Boards store option IDs, so value filters are converted before querying
+	if len(query.CustomFields) > 0 {
+		converted, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, projectID, query.CustomFields)
+		if err != nil {
+			return nil, customFieldsError(err)
+		}
+		pageQuery.CustomFields = converted
+	}
+
+	if query.Cursor != "" {
+		after, err := decodeBoardCursor(query.Cursor, pageQuery)
+		if err != nil {
+			return nil, err
+		}
+		pageQuery.After = after
+	}
+
+	boards, err := s.boardRepo.ListByProjectID(ctx, projectID, pageQuery)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch boards", err.Error())
+	}
+
+	resp = &dto.BoardListPageResponse{Boards: make([]dto.BoardResponse, 0, limit)}
+	if len(boards) > limit {
+		boards = boards[:limit]
+		resp.NextCursor = encodeBoardCursor(boards[limit-1], pageQuery)
+		resp.HasMore = true
+	}
+
+	s.loadBoardAttachments(ctx, projectID, boards)
+	s.loadBoardCovers(ctx, boards)
+
+	if err := s.fieldOptionConverter.ConvertIDsToValuesBatch(ctx, boards); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+
+	for _, board := range boards {
+		boardResp := s.toBoardResponse(board)
+		markUnread(ctx, board, boardResp)
+		resp.Boards = append(resp.Boards, *boardResp)
+	}
+	return resp, nil
+}
+
+// boardListPageQuery validates the filters and sort order of query and maps them to a repository query
+// Custom field filters, the cursor and the page size are left to the caller
+func boardListPageQuery(query *dto.BoardListQuery) (repository.BoardPageQuery, error) {
+	column, ok := boardSortColumns[query.SortBy]
+	if !ok {
+		return repository.BoardPageQuery{}, response.NewValidationError("Invalid sortBy", "sortBy must be one of: createdAt, title, startDate, dueDate")
+	}
+	if len(query.SortSpec) > 0 && (query.SortBy != "" || query.Order != "") {
+		return repository.BoardPageQuery{}, response.NewValidationError("sort cannot be combined with sortBy or order", "")
+	}
+	sortFields, err := resolveBoardSortSpec(query.SortSpec)
+	if err != nil {
+		return repository.BoardPageQuery{}, err
+	}
+	if query.Order != "" && query.Order != "asc" && query.Order != "desc" {
+		return repository.BoardPageQuery{}, response.NewValidationError("Invalid order", "order must be asc or desc")
+	}
+	if query.DueFrom != nil && query.DueTo != nil && query.DueFrom.After(*query.DueTo) {
+		return repository.BoardPageQuery{}, response.NewValidationError("dueFrom must not be after dueTo", "")
+	}
+
+	return repository.BoardPageQuery{
+		BoardListFilter: repository.BoardListFilter{IncludeArchived: query.IncludeArchived, LabelIDs: query.LabelIDs},
+		AssigneeID:      query.AssigneeID,
+		ParticipantID:   query.ParticipantID,
+		DueFrom:         query.DueFrom,
+		DueTo:           query.DueTo,
+		SortBy:          column,
+		Ascending:       query.Order == "asc",
+		Sort:            sortFields,
+	}, nil
+}
+
+// resolveBoardSortSpec maps the requested sort keys to repository sort fields
+// Every key must be known and used at most once; a missing direction takes the key's default
+func resolveBoardSortSpec(spec []dto.SortField) ([]repository.BoardSortField, error) {
+	if len(spec) == 0 {
+		return nil, nil
+	}
+
+	fields := make([]repository.BoardSortField, 0, len(spec))
+	seen := make(map[string]bool, len(spec))
+	for _, field := range spec {
+		column, ok := boardSortColumns[field.Key]
+		if !ok || field.Key == "" {
+			return nil, response.NewFieldValidationError("Invalid sort key", map[string]string{
+				field.Key: "sort key must be one of: createdAt, title, startDate, dueDate",
+			})
+		}
+		if seen[column] {
+			return nil, response.NewFieldValidationError("Duplicate sort key", map[string]string{
+				field.Key: "sort key may only be used once",
+			})
+		}
+		seen[column] = true
+
+		desc := boardSortDefaultDesc[column]
+		if field.Desc != nil {
+			desc = *field.Desc
+		}
+		fields = append(fields, repository.BoardSortField{Column: column, Desc: desc})
+	}
+	return fields, nil
+}
+
+// boardCursor is the position after which the next page of ListBoards starts
+// It carries the sort keys of the last listed board rather than its ID, so the page after it
+// still resolves when that board has since been edited, archived or deleted
+type boardCursor struct {
+	// Sort is the sort order the cursor was issued for
+	Sort      string     `json:"k"`
+	Title     string     `json:"t,omitempty"`
+	StartDate *time.Time `json:"s,omitempty"`
+	DueDate   *time.Time `json:"d,omitempty"`
+	CreatedAt time.Time  `json:"c"`
+	ID        uuid.UUID  `json:"i"`
+}
+
+// boardPageSortFields returns the sort keys the repository applies for query
+func boardPageSortFields(query repository.BoardPageQuery) []repository.BoardSortField {
+	if len(query.Sort) > 0 {
+		return query.Sort
+	}
+	return []repository.BoardSortField{{Column: query.SortBy, Desc: !query.Ascending}}
+}
+
+// boardPageSortKey names the sort order of query, e.g. "due_date:asc,created_at:desc"
+func boardPageSortKey(query repository.BoardPageQuery) string {
+	fields := boardPageSortFields(query)
+	keys := make([]string, len(fields))
+	for i, field := range fields {
+		direction := "asc"
+		if field.Desc {
+			direction = "desc"
+		}
+		keys[i] = field.Column + ":" + direction
+	}
+	return strings.Join(keys, ",")
+}
+
+// encodeBoardCursor returns the opaque cursor of the page that follows board
+// Only the sort keys in use are stored, besides the creation time and ID that break ties
+func encodeBoardCursor(board *domain.Board, query repository.BoardPageQuery) string {
+	cursor := boardCursor{Sort: boardPageSortKey(query), CreatedAt: board.CreatedAt.UTC(), ID: board.ID}
+	for _, field := range boardPageSortFields(query) {
+		switch field.Column {
+		case repository.BoardSortTitle:
+			cursor.Title = board.Title
+		case repository.BoardSortStartDate:
+			cursor.StartDate = board.StartDate
+		case repository.BoardSortDueDate:
+			cursor.DueDate = board.DueDate
+		}
+	}
+
+	// Marshalling a struct of strings, times and a UUID cannot fail
+	encoded, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(encoded)
+}
+
+// decodeBoardCursor turns a cursor back into the board position the repository pages after
+// A cursor issued for another sort order is rejected, since its keys do not describe a position in this one
+func decodeBoardCursor(encoded string, query repository.BoardPageQuery) (*domain.Board, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, response.NewValidationError("Invalid cursor", "")
+	}
+	var cursor boardCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return nil, response.NewValidationError("Invalid cursor", "")
+	}
+	if cursor.Sort != boardPageSortKey(query) {
+		return nil, response.NewValidationError("Invalid cursor", "the cursor was issued for a different sort order")
+	}
+
+	return &domain.Board{
+		BaseModel: domain.BaseModel{ID: cursor.ID, CreatedAt: cursor.CreatedAt},
+		Title:     cursor.Title,
+		StartDate: cursor.StartDate,
+		DueDate:   cursor.DueDate,
+	}, nil
+}
+
+// loadBoardAttachments sets the visible attachments of every listed board with one batch query
+// A failed lookup only leaves the attachments empty rather than failing the list
+func (s *boardServiceImpl) loadBoardAttachments(ctx context.Context, projectID uuid.UUID, boards []*domain.Board) {
+	if len(boards) == 0 {
+		return
+	}
+
+	boardIDs := make([]uuid.UUID, len(boards))
+	for i, board := range boards {
+		boardIDs[i] = board.ID
+	}
+
+	attachments, err := s.attachmentRepo.FindByEntityIDs(ctx, domain.EntityTypeBoard, boardIDs)
+	if err != nil {
+		s.logger.Error("Failed to fetch attachments for board list", zap.Int("board_count", len(boards)), zap.Error(err))
+	}
+
+	viewer := s.newAttachmentViewer(ctx, projectID)
+	for _, board := range boards {
+		board.Attachments = toDomainAttachments(viewer.filter(ctx, attachments[board.ID]))
+	}
+}
+
+// loadBoardCovers sets the cover image of every listed board with one batch query
+// A failed lookup only leaves the covers empty, like the attachments of a list
+func (s *boardServiceImpl) loadBoardCovers(ctx context.Context, boards []*domain.Board) {
+	if len(boards) == 0 {
+		return
+	}
+
+	boardIDs := make([]uuid.UUID, len(boards))
+	for i, board := range boards {
+		boardIDs[i] = board.ID
+	}
+
+	covers, err := s.attachmentRepo.FindCoverImages(ctx, domain.EntityTypeBoard, boardIDs)
+	if err != nil {
+		s.logger.Error("Failed to fetch board covers", zap.Int("board_count", len(boards)), zap.Error(err))
+		return
+	}
+	for _, board := range boards {
+		board.Cover = covers[board.ID]
+	}
+}
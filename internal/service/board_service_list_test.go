@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_ListBoards(t *testing.T) {
+	projectID := uuid.New()
+	due := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := time.Date(2024, 4, 1, 9, 30, 0, 0, time.UTC)
+	pageBoards := []*domain.Board{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "First"},
+		{BaseModel: domain.BaseModel{ID: uuid.New(), CreatedAt: createdAt}, ProjectID: projectID, Title: "Second", DueDate: &due},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "Third"},
+	}
+
+	var got repository.BoardPageQuery
+	mockBoardRepo := &MockBoardRepository{
+		ListByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error) {
+			got = query
+			return pageBoards, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	mockConverter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, pid uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"stage": "option-in-progress"}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, mockConverter, nil, zap.NewNop())
+
+	query := &dto.BoardListQuery{
+		CustomFields: map[string]interface{}{"stage": "in_progress"},
+		SortBy:       "dueDate",
+		Order:        "asc",
+		Limit:        2,
+	}
+	resp, err := service.ListBoards(context.Background(), projectID, query)
+	if err != nil {
+		t.Fatalf("ListBoards() unexpected error = %v", err)
+	}
+
+	// The value filter reaches the repository as the stored option ID
+	if got.CustomFields["stage"] != "option-in-progress" {
+		t.Errorf("customFields.stage = %v, want option-in-progress", got.CustomFields["stage"])
+	}
+	if got.SortBy != repository.BoardSortDueDate || !got.Ascending || got.After != nil || got.Limit != 3 {
+		t.Errorf("unexpected page query %+v", got)
+	}
+	// One row past the limit means another page follows
+	if len(resp.Boards) != 2 || !resp.HasMore || resp.NextCursor == "" {
+		t.Fatalf("got %d boards, hasMore %v, nextCursor %q", len(resp.Boards), resp.HasMore, resp.NextCursor)
+	}
+
+	// The next page starts after the sort keys of the last board, without looking that board up again
+	query.Cursor = resp.NextCursor
+	if _, err := service.ListBoards(context.Background(), projectID, query); err != nil {
+		t.Fatalf("ListBoards() with cursor unexpected error = %v", err)
+	}
+	last := pageBoards[1]
+	if got.After == nil || got.After.ID != last.ID || !got.After.CreatedAt.Equal(createdAt) ||
+		got.After.DueDate == nil || !got.After.DueDate.Equal(due) {
+		t.Errorf("cursor position = %+v, want the keys of %s", got.After, last.ID)
+	}
+
+	for name, query := range map[string]*dto.BoardListQuery{
+		"board ID":            {Cursor: uuid.New().String()},
+		"other sort order":    {SortBy: "title", Cursor: resp.NextCursor},
+		"unknown sort column": {SortBy: "priority"},
+	} {
+		_, err := service.ListBoards(context.Background(), projectID, query)
+		if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+			t.Errorf("%s: ListBoards() error = %v, want %s", name, err, response.ErrCodeValidation)
+		}
+	}
+}
+
+func TestBoardService_ListBoards_BatchesAttachments(t *testing.T) {
+	projectID := uuid.New()
+	boards := []*domain.Board{
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID},
+		{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID},
+	}
+
+	mockBoardRepo := &MockBoardRepository{
+		ListByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error) {
+			return boards, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	batches := 0
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+			t.Errorf("FindByEntityID() called for %s, want one batch lookup", entityID)
+			return nil, nil
+		},
+		FindByEntityIDsFunc: func(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error) {
+			batches++
+			if len(entityIDs) != len(boards) {
+				t.Errorf("FindByEntityIDs() got %d board IDs, want %d", len(entityIDs), len(boards))
+			}
+			return map[uuid.UUID][]*domain.Attachment{
+				boards[1].ID: {{BaseModel: domain.BaseModel{ID: uuid.New()}, FileName: "spec.pdf", FileURL: "board/files/spec.pdf"}},
+			}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		mockAttachmentRepo, &MockS3Client{}, &MockFieldOptionConverter{}, nil, zap.NewNop())
+
+	resp, err := service.ListBoards(context.Background(), projectID, &dto.BoardListQuery{})
+	if err != nil {
+		t.Fatalf("ListBoards() unexpected error = %v", err)
+	}
+	if batches != 1 {
+		t.Errorf("FindByEntityIDs() called %d times, want 1", batches)
+	}
+	if len(resp.Boards) != 3 || len(resp.Boards[0].Attachments) != 0 || len(resp.Boards[1].Attachments) != 1 {
+		t.Errorf("attachments were not matched to their boards: %+v", resp.Boards)
+	}
+}
+
+func TestBoardService_ListBoards_SortSpec(t *testing.T) {
+	var got repository.BoardPageQuery
+	mockBoardRepo := &MockBoardRepository{
+		ListByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error) {
+			got = query
+			return nil, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop())
+	projectID := uuid.New()
+
+	desc := true
+	_, err := service.ListBoards(context.Background(), projectID, &dto.BoardListQuery{
+		SortSpec: []dto.SortField{{Key: "dueDate"}, {Key: "title", Desc: &desc}, {Key: "createdAt"}},
+	})
+	if err != nil {
+		t.Fatalf("ListBoards() unexpected error = %v", err)
+	}
+	// Keys without a direction take their default: dates ascending, creation time descending
+	want := []repository.BoardSortField{
+		{Column: repository.BoardSortDueDate, Desc: false},
+		{Column: repository.BoardSortTitle, Desc: true},
+		{Column: repository.BoardSortCreatedAt, Desc: true},
+	}
+	if len(got.Sort) != len(want) {
+		t.Fatalf("sort = %+v, want %+v", got.Sort, want)
+	}
+	for i := range want {
+		if got.Sort[i] != want[i] {
+			t.Errorf("sort[%d] = %+v, want %+v", i, got.Sort[i], want[i])
+		}
+	}
+
+	for name, query := range map[string]*dto.BoardListQuery{
+		"unknown key":    {SortSpec: []dto.SortField{{Key: "dueDate"}, {Key: "priority"}}},
+		"duplicate key":  {SortSpec: []dto.SortField{{Key: "title"}, {Key: "title", Desc: &desc}}},
+		"mixed with old": {SortBy: "title", SortSpec: []dto.SortField{{Key: "dueDate"}}},
+	} {
+		_, err := service.ListBoards(context.Background(), projectID, query)
+		if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+			t.Errorf("%s: ListBoards() error = %v, want %s", name, err, response.ErrCodeValidation)
+		}
+	}
+}
+
+func TestBoardService_ListBoards_CoverThumbnails(t *testing.T) {
+	projectID := uuid.New()
+	withCover := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID}
+	withoutCover := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID}
+
+	mockBoardRepo := &MockBoardRepository{
+		ListByProjectIDFunc: func(ctx context.Context, pid uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error) {
+			return []*domain.Board{withCover, withoutCover}, nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	batches := 0
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindCoverImagesFunc: func(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]*domain.Attachment, error) {
+			batches++
+			if len(entityIDs) != 2 {
+				t.Errorf("FindCoverImages() got %d board IDs, want 2", len(entityIDs))
+			}
+			return map[uuid.UUID]*domain.Attachment{
+				withCover.ID: {FileURL: "board/images/cover.png", ContentType: "image/png"},
+			}, nil
+		},
+	}
+	mockS3 := &MockS3Client{
+		GetFileURLFunc: func(key string) string { return "https://cdn.example.com/" + key },
+	}
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		mockAttachmentRepo, mockS3, &MockFieldOptionConverter{}, nil, zap.NewNop())
+
+	resp, err := service.ListBoards(context.Background(), projectID, &dto.BoardListQuery{})
+	if err != nil {
+		t.Fatalf("ListBoards() unexpected error = %v", err)
+	}
+	// Covers for the whole page come from a single batch lookup
+	if batches != 1 {
+		t.Errorf("FindCoverImages() called %d times, want 1", batches)
+	}
+	if len(resp.Boards) != 2 {
+		t.Fatalf("got %d boards, want 2", len(resp.Boards))
+	}
+	if got := resp.Boards[0].CoverThumbnailURL; got == nil || *got != "https://cdn.example.com/board/images/cover.png" {
+		t.Errorf("coverThumbnailUrl = %v, want the cover's URL", got)
+	}
+	if resp.Boards[1].CoverThumbnailURL != nil {
+		t.Errorf("coverThumbnailUrl = %q, want null for a board without a cover", *resp.Boards[1].CoverThumbnailURL)
+	}
+}
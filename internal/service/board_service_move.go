@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// MaxBulkMoveBoards caps the boards moved by one BulkMoveBoards call
+const MaxBulkMoveBoards = 100
+
+// boardMove is a board moved into another project and what the move had to give up
+type boardMove struct {
+	board         *domain.Board
+	fromProjectID uuid.UUID
+	droppedFields []string
+	// nonMembers are participants who are not members of the target project
+	nonMembers []uuid.UUID
+}
+
+// MoveBoard moves a board into another project, remapping its custom fields to the target's options
+// Fields the target cannot represent are dropped, or with strict refuse the move. Participants stay on the board;
+// those who are not members of the target project are flagged in the response.
+func (s *boardServiceImpl) MoveBoard(ctx context.Context, boardID, targetProjectID uuid.UUID, strict bool) (resp *dto.MoveBoardToProjectResponse, err error) {
+	ctx, span := s.startSpan(ctx, "MoveBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	target, members, err := s.findMoveTarget(ctx, targetProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The board is read and written in one transaction so a concurrent change cannot be half-migrated
+	var move *boardMove
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		move, err = s.moveBoardToProject(txCtx, boardID, target, members, strict)
+		return err
+	})
+	if err != nil {
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to move board", err.Error())
+	}
+
+	if err := s.convertBoardCustomFieldsToValues(ctx, move.board); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	return &dto.MoveBoardToProjectResponse{
+		Board:                 *s.toBoardResponse(move.board),
+		FromProjectID:         move.fromProjectID,
+		DroppedFields:         move.droppedFields,
+		NonMemberParticipants: move.nonMembers,
+	}, nil
+}
+
+// findMoveTarget loads the target project of a move and the set of its members
+func (s *boardServiceImpl) findMoveTarget(ctx context.Context, projectID uuid.UUID) (*domain.Project, map[uuid.UUID]bool, error) {
+	target, err := s.projectRepo.FindByID(ctx, projectID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, response.NewAppError(response.ErrCodeNotFound, "Target project not found", "")
+		}
+		return nil, nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	memberSet, err := s.projectMemberSet(ctx, projectID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return target, memberSet, nil
+}
+
+// BulkMoveBoards moves boards into another project, remapping their custom fields to the target's options
+// With allOrNothing the boards are moved in one transaction; otherwise each board is moved, or not, on its own.
+// Every board is reported either way, including the custom fields that were dropped or refused.
+func (s *boardServiceImpl) BulkMoveBoards(ctx context.Context, req *dto.BulkMoveBoardsRequest) (resp *dto.BulkMoveBoardsResponse, err error) {
+	ctx, span := s.startSpan(ctx, "BulkMoveBoards", uuid.Nil)
+	defer func() { endSpan(span, err) }()
+
+	if len(req.BoardIDs) == 0 {
+		return nil, response.NewValidationError("Bulk move needs at least one board", "")
+	}
+	if len(req.BoardIDs) > MaxBulkMoveBoards {
+		return nil, response.NewValidationError(fmt.Sprintf("Bulk move accepts at most %d boards", MaxBulkMoveBoards),
+			fmt.Sprintf("got %d boards", len(req.BoardIDs)))
+	}
+	boardIDs := removeDuplicateUUIDs(req.BoardIDs)
+
+	target, members, err := s.findMoveTarget(ctx, req.TargetProjectID)
+	if err != nil {
+		return nil, err
+	}
+	// Reject a batch that cannot fit before moving anything; each move checks again under the project lock
+	if err := s.checkBoardQuota(ctx, target.ID, len(boardIDs)); err != nil {
+		return nil, err
+	}
+
+	resp = &dto.BulkMoveBoardsResponse{Results: make([]dto.BulkMoveBoardResult, len(boardIDs))}
+	moves := make([]*boardMove, len(boardIDs))
+	moveOne := func(moveCtx context.Context, i int) error {
+		result := &resp.Results[i]
+		result.BoardID = boardIDs[i]
+
+		move, err := s.moveBoardToProject(moveCtx, boardIDs[i], target, members, req.Strict)
+		if err != nil {
+			var appErr *response.AppError
+			if errors.As(err, &appErr) {
+				result.Error = appErr.Message
+				result.Fields = appErr.Fields
+			} else {
+				result.Error = "Failed to move board"
+			}
+			s.logger.Warn("Bulk board move item failed",
+				zap.String("board_id", boardIDs[i].String()),
+				zap.Error(err))
+			return err
+		}
+		moves[i] = move
+		result.FromProjectID = move.fromProjectID
+		result.DroppedFields = move.droppedFields
+		result.NonMemberParticipants = move.nonMembers
+		return nil
+	}
+
+	if req.AllOrNothing {
+		// Webhook events wait for the commit, so a rolled back move announces nothing
+		bufferedCtx, events := withBoardEventBuffer(ctx)
+		err = s.transactor.WithinTransaction(bufferedCtx, func(txCtx context.Context) error {
+			failed, stopped := false, false
+			for i := range boardIDs {
+				if stopped {
+					resp.Results[i].BoardID = boardIDs[i]
+					resp.Results[i].Error = batchNotAttemptedMessage
+					continue
+				}
+				if err := moveOne(txCtx, i); err != nil {
+					failed = true
+					// The transaction may be unusable after an unexpected error
+					var appErr *response.AppError
+					stopped = !errors.As(err, &appErr) || appErr.Code == response.ErrCodeInternal
+				}
+			}
+			if failed {
+				return errBatchRejected
+			}
+			return nil
+		})
+		if errors.Is(err, errBatchRejected) {
+			for i := range resp.Results {
+				resp.Results[i].FromProjectID = uuid.Nil
+				resp.Results[i].DroppedFields = nil
+				resp.Results[i].NonMemberParticipants = nil
+				if resp.Results[i].Error == "" {
+					resp.Results[i].Error = batchRolledBackMessage
+				}
+			}
+			resp.Failed = len(boardIDs)
+			return resp, nil
+		}
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to move boards", err.Error())
+		}
+		s.publishBufferedEvents(events)
+	} else {
+		// Each failure is already recorded in its result
+		for i := range boardIDs {
+			_ = moveOne(ctx, i)
+		}
+	}
+
+	resp.Applied = true
+	for i, move := range moves {
+		if move == nil {
+			resp.Failed++
+			continue
+		}
+		resp.Moved++
+		resp.Results[i].Success = true
+		if err := s.convertBoardCustomFieldsToValues(ctx, move.board); err != nil {
+			s.logger.Warn("Failed to convert custom fields of moved board",
+				zap.String("board_id", move.board.ID.String()),
+				zap.Error(err))
+		}
+		resp.Results[i].Board = s.toBoardResponse(move.board)
+	}
+	return resp, nil
+}
+
+// moveBoardToProject moves one board into target and records the move in its history
+// Custom fields are re-resolved to values and converted to target's option IDs. Fields target cannot represent
+// are dropped, or with strict refuse the move with a field validation error listing them.
+// members is the set of target's members, used to flag participants who are not among them.
+func (s *boardServiceImpl) moveBoardToProject(ctx context.Context, boardID uuid.UUID, target *domain.Project, members map[uuid.UUID]bool, strict bool) (*boardMove, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	if board.ProjectID == target.ID {
+		return nil, response.NewValidationError("Board is already in the target project", "")
+	}
+	if target.EnforceUniqueTitles {
+		if err := s.checkTitleAvailable(ctx, target.ID, board.ID, board.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	before, err := s.snapshotBoard(ctx, board)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+
+	move := &boardMove{board: board, fromProjectID: board.ProjectID}
+	for _, participant := range board.Participants {
+		if !members[participant.UserID] {
+			move.nonMembers = append(move.nonMembers, participant.UserID)
+		}
+	}
+	if len(before.CustomFields) > 0 {
+		values := make(map[string]interface{}, len(before.CustomFields))
+		for key, value := range before.CustomFields {
+			values[key] = value
+		}
+
+		converted, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, target.ID, values)
+		var fieldErr *converter.FieldValuesError
+		if errors.As(err, &fieldErr) {
+			if strict {
+				return nil, response.NewFieldValidationError("Custom fields are not compatible with the target project", fieldErr.Fields)
+			}
+			for key := range fieldErr.Fields {
+				delete(values, key)
+				move.droppedFields = append(move.droppedFields, key)
+			}
+			sort.Strings(move.droppedFields)
+			converted, err = s.fieldOptionConverter.ConvertValuesToIDs(ctx, target.ID, values)
+		}
+		if err != nil {
+			return nil, customFieldsError(err)
+		}
+
+		jsonBytes, err := s.marshalCustomFields(converted)
+		if err != nil {
+			return nil, err
+		}
+		board.CustomFields = jsonBytes
+	}
+
+	board.ProjectID = target.ID
+	board.TitleUnique = target.EnforceUniqueTitles
+	// Labels belong to the source project and do not follow the board
+	hadLabels := len(board.Labels) > 0
+	board.Labels = nil
+
+	after, err := s.snapshotBoard(ctx, board)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	actorID := actorFromContext(ctx)
+	activities := append([]*domain.BoardActivity{{
+		BoardID:  board.ID,
+		ActorID:  actorID,
+		Field:    "projectId",
+		OldValue: move.fromProjectID.String(),
+		NewValue: target.ID.String(),
+	}}, diffBoardSnapshots(board.ID, actorID, before, after)...)
+
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.checkBoardQuota(txCtx, target.ID, 1); err != nil {
+			return err
+		}
+		if err := s.boardRepo.Update(txCtx, board); err != nil {
+			return boardUpdateError(err)
+		}
+		if hadLabels && s.labelRepo != nil {
+			if err := s.labelRepo.ReplaceForBoard(txCtx, board.ID, nil); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to remove labels", err.Error())
+			}
+		}
+		if err := s.boardRepo.AddActivities(txCtx, activities); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board activity", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.publishBoardUpdate(ctx, board, activities)
+
+	return move, nil
+}
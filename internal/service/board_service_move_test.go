@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// newMoveTestMocks moves boards into a target project that has a "stage" field with a "todo" option only
+// and the given members
+func newMoveTestMocks(boards map[uuid.UUID]*domain.Board, members ...uuid.UUID) boardServiceMocks {
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+		FindMembersByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]*domain.ProjectMember, error) {
+			projectMembers := make([]*domain.ProjectMember, len(members))
+			for i, userID := range members {
+				projectMembers[i] = &domain.ProjectMember{ProjectID: id, UserID: userID}
+			}
+			return projectMembers, nil
+		},
+	}
+	mockConverter := &MockFieldOptionConverter{
+		// Source boards store option values as their IDs to keep the test readable
+		ConvertIDsToValuesFunc: func(ctx context.Context, fields map[string]interface{}) (map[string]interface{}, error) {
+			return fields, nil
+		},
+		ConvertValuesToIDsFunc: func(ctx context.Context, projectID uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			converted := map[string]interface{}{}
+			invalid := map[string]string{}
+			for key, value := range fields {
+				if key == "stage" && value == "todo" {
+					converted[key] = "target-todo"
+					continue
+				}
+				invalid[key] = "no matching option in the target project"
+			}
+			if len(invalid) > 0 {
+				return nil, &converter.FieldValuesError{Fields: invalid}
+			}
+			return converted, nil
+		},
+	}
+	return boardServiceMocks{boardRepo: newBoardMapRepository(boards), projectRepo: mockProjectRepo, converter: mockConverter}
+}
+
+func newMoveTestBoard(projectID uuid.UUID, customFields string) *domain.Board {
+	return &domain.Board{
+		BaseModel:    domain.BaseModel{ID: uuid.New()},
+		ProjectID:    projectID,
+		Title:        "Board",
+		CustomFields: datatypes.JSON(customFields),
+	}
+}
+
+func TestBoardService_BulkMoveBoards_BestEffortReportsIncompatibleBoard(t *testing.T) {
+	sourceID, targetID := uuid.New(), uuid.New()
+	compatible := newMoveTestBoard(sourceID, `{"stage":"todo"}`)
+	incompatible := newMoveTestBoard(sourceID, `{"stage":"blocked"}`)
+	noFields := newMoveTestBoard(sourceID, `{}`)
+	boards := map[uuid.UUID]*domain.Board{compatible.ID: compatible, incompatible.ID: incompatible, noFields.ID: noFields}
+	service := newTestBoardService(newMoveTestMocks(boards), WithTransactor(&recordingTransactor{}))
+
+	resp, err := service.BulkMoveBoards(context.Background(), &dto.BulkMoveBoardsRequest{
+		BoardIDs:        []uuid.UUID{compatible.ID, incompatible.ID, noFields.ID},
+		TargetProjectID: targetID,
+		Strict:          true,
+	})
+	if err != nil {
+		t.Fatalf("BulkMoveBoards() unexpected error = %v", err)
+	}
+
+	if !resp.Applied || resp.Moved != 2 || resp.Failed != 1 {
+		t.Fatalf("applied %v, moved %d, failed %d; want true, 2, 1", resp.Applied, resp.Moved, resp.Failed)
+	}
+	if result := resp.Results[1]; result.Success || result.Fields["stage"] == "" {
+		t.Errorf("incompatible board result = %+v, want a refused stage field", result)
+	}
+	if boards[incompatible.ID].ProjectID != sourceID {
+		t.Error("incompatible board was moved")
+	}
+	for _, moved := range []*domain.Board{compatible, noFields} {
+		if boards[moved.ID].ProjectID != targetID {
+			t.Errorf("board %s was not moved", moved.ID)
+		}
+	}
+
+	var stored map[string]interface{}
+	if err := json.Unmarshal(boards[compatible.ID].CustomFields, &stored); err != nil {
+		t.Fatalf("failed to decode custom fields: %v", err)
+	}
+	if stored["stage"] != "target-todo" {
+		t.Errorf("stage = %v, want the target project's option ID", stored["stage"])
+	}
+}
+
+func TestBoardService_BulkMoveBoards_DropsIncompatibleFields(t *testing.T) {
+	sourceID, targetID := uuid.New(), uuid.New()
+	board := newMoveTestBoard(sourceID, `{"stage":"todo","role":"designer"}`)
+	boards := map[uuid.UUID]*domain.Board{board.ID: board}
+	service := newTestBoardService(newMoveTestMocks(boards), WithTransactor(&recordingTransactor{}))
+
+	resp, err := service.BulkMoveBoards(context.Background(), &dto.BulkMoveBoardsRequest{
+		BoardIDs:        []uuid.UUID{board.ID},
+		TargetProjectID: targetID,
+	})
+	if err != nil {
+		t.Fatalf("BulkMoveBoards() unexpected error = %v", err)
+	}
+
+	result := resp.Results[0]
+	if !result.Success || len(result.DroppedFields) != 1 || result.DroppedFields[0] != "role" {
+		t.Errorf("result = %+v, want a move that dropped role", result)
+	}
+	if result.FromProjectID != sourceID {
+		t.Errorf("fromProjectId = %v, want %v", result.FromProjectID, sourceID)
+	}
+}
+
+func TestBoardService_BulkMoveBoards_AllOrNothingRollsBack(t *testing.T) {
+	sourceID, targetID := uuid.New(), uuid.New()
+	compatible := newMoveTestBoard(sourceID, `{"stage":"todo"}`)
+	incompatible := newMoveTestBoard(sourceID, `{"stage":"blocked"}`)
+	boards := map[uuid.UUID]*domain.Board{compatible.ID: compatible, incompatible.ID: incompatible}
+	transactor := &recordingTransactor{}
+	service := newTestBoardService(newMoveTestMocks(boards), WithTransactor(transactor))
+
+	resp, err := service.BulkMoveBoards(context.Background(), &dto.BulkMoveBoardsRequest{
+		BoardIDs:        []uuid.UUID{compatible.ID, incompatible.ID},
+		TargetProjectID: targetID,
+		AllOrNothing:    true,
+		Strict:          true,
+	})
+	if err != nil {
+		t.Fatalf("BulkMoveBoards() unexpected error = %v", err)
+	}
+
+	if resp.Applied || !transactor.rolledBack || resp.Moved != 0 {
+		t.Fatalf("applied %v, rolled back %v, moved %d; want a rolled back move", resp.Applied, transactor.rolledBack, resp.Moved)
+	}
+	if resp.Results[0].Error != batchRolledBackMessage {
+		t.Errorf("compatible board error = %q, want %q", resp.Results[0].Error, batchRolledBackMessage)
+	}
+}
+
+func TestBoardService_MoveBoard(t *testing.T) {
+	sourceID, targetID := uuid.New(), uuid.New()
+	member, outsider := uuid.New(), uuid.New()
+	board := newMoveTestBoard(sourceID, `{"stage":"todo","role":"designer"}`)
+	board.Participants = []domain.Participant{{BoardID: board.ID, UserID: member}, {BoardID: board.ID, UserID: outsider}}
+	boards := map[uuid.UUID]*domain.Board{board.ID: board}
+	transactor := &recordingTransactor{}
+	service := newTestBoardService(newMoveTestMocks(boards, member), WithTransactor(transactor))
+
+	// Strict refuses the role field the target project lacks and leaves the board where it was
+	_, err := service.MoveBoard(context.Background(), board.ID, targetID, true)
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeValidation || appErr.Fields["role"] == "" {
+		t.Fatalf("MoveBoard() error = %v, want a validation error for role", err)
+	}
+	if boards[board.ID].ProjectID != sourceID || !transactor.rolledBack {
+		t.Fatal("strict move changed the board")
+	}
+
+	resp, err := service.MoveBoard(context.Background(), board.ID, targetID, false)
+	if err != nil {
+		t.Fatalf("MoveBoard() unexpected error = %v", err)
+	}
+	if resp.Board.ProjectID != targetID || resp.FromProjectID != sourceID {
+		t.Errorf("moved from %v to %v, want %v to %v", resp.FromProjectID, resp.Board.ProjectID, sourceID, targetID)
+	}
+	if len(resp.DroppedFields) != 1 || resp.DroppedFields[0] != "role" {
+		t.Errorf("droppedFields = %v, want [role]", resp.DroppedFields)
+	}
+	if len(resp.NonMemberParticipants) != 1 || resp.NonMemberParticipants[0] != outsider {
+		t.Errorf("nonMemberParticipants = %v, want [%v]", resp.NonMemberParticipants, outsider)
+	}
+}
@@ -0,0 +1,297 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// boardPatchDocument is the canonical JSON representation a board patch is applied to
+type boardPatchDocument struct {
+	Title         string                 `json:"title"`
+	Content       string                 `json:"content"`
+	CustomFields  map[string]interface{} `json:"customFields"`
+	AssigneeID    *uuid.UUID             `json:"assigneeId"`
+	StartDate     *time.Time             `json:"startDate"`
+	DueDate       *time.Time             `json:"dueDate"`
+	EstimateHours *float64               `json:"estimateHours"`
+	ActualHours   *float64               `json:"actualHours"`
+}
+
+// patchableBoardFields lists the top-level members that may be targeted by a patch
+var patchableBoardFields = map[string]bool{
+	"title":         true,
+	"content":       true,
+	"customFields":  true,
+	"assigneeId":    true,
+	"startDate":     true,
+	"dueDate":       true,
+	"estimateHours": true,
+	"actualHours":   true,
+}
+
+// PatchBoard applies RFC 6902 operations to a board and re-validates the result
+func (s *boardServiceImpl) PatchBoard(ctx context.Context, boardID uuid.UUID, ops []dto.PatchOp) (resp *dto.BoardResponse, err error) {
+	ctx, span := s.startSpan(ctx, "PatchBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	if err := checkNotArchived(board); err != nil {
+		return nil, err
+	}
+
+	// Build the canonical document with value-based custom fields
+	if err := s.convertBoardCustomFieldsToValues(ctx, board); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	current := boardPatchDocument{
+		Title:         board.Title,
+		Content:       board.Content,
+		AssigneeID:    board.AssigneeID,
+		StartDate:     board.StartDate,
+		DueDate:       board.DueDate,
+		EstimateHours: board.EstimateHours,
+		ActualHours:   board.ActualHours,
+	}
+	if len(board.CustomFields) > 0 {
+		if err := json.Unmarshal(board.CustomFields, &current.CustomFields); err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to parse custom fields", err.Error())
+		}
+	}
+
+	doc, err := toGenericDocument(current)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to build patch document", err.Error())
+	}
+
+	for i, op := range ops {
+		if err := applyPatchOp(doc, op); err != nil {
+			return nil, response.NewAppError(response.ErrCodeValidation, "Invalid patch operation", fmt.Sprintf("operation %d: %s", i, err.Error()))
+		}
+	}
+
+	// Decode the patched document back into typed fields
+	raw, err := json.Marshal(doc)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to encode patched board", err.Error())
+	}
+	var patched boardPatchDocument
+	if err := json.Unmarshal(raw, &patched); err != nil {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Invalid patched board", err.Error())
+	}
+
+	// Re-validate with the same rules as create/update
+	if patched.Title == "" || utf8.RuneCountInString(patched.Title) > 200 {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Title must be between 1 and 200 characters", "")
+	}
+	if utf8.RuneCountInString(patched.Content) > 5000 {
+		return nil, response.NewAppError(response.ErrCodeValidation, "Content must be at most 5000 characters", "")
+	}
+	patched.StartDate, patched.DueDate = normalizeBoardDate(patched.StartDate), normalizeBoardDate(patched.DueDate)
+	if err := validateDateRange(patched.StartDate, patched.DueDate); err != nil {
+		return nil, err
+	}
+	if err := validateEffortHours(patched.EstimateHours, patched.ActualHours); err != nil {
+		return nil, err
+	}
+	if board.TitleUnique && patched.Title != board.Title {
+		if err := s.checkTitleAvailable(ctx, board.ProjectID, board.ID, patched.Title); err != nil {
+			return nil, err
+		}
+	}
+
+	var customFieldsJSON []byte
+	if len(patched.CustomFields) > 0 {
+		convertedFields, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, board.ProjectID, patched.CustomFields)
+		if err != nil {
+			return nil, customFieldsError(err)
+		}
+		customFieldsJSON, err = s.marshalCustomFields(convertedFields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	before := boardSnapshot{
+		Title:         current.Title,
+		Content:       current.Content,
+		AssigneeID:    current.AssigneeID,
+		StartDate:     current.StartDate,
+		DueDate:       current.DueDate,
+		EstimateHours: current.EstimateHours,
+		ActualHours:   current.ActualHours,
+		CustomFields:  current.CustomFields,
+	}
+
+	board.Title = patched.Title
+	board.Content = patched.Content
+	board.CustomFields = customFieldsJSON
+	board.AssigneeID = patched.AssigneeID
+	if board.AssigneeID != nil && *board.AssigneeID == uuid.Nil {
+		board.AssigneeID = nil
+	}
+	board.StartDate = patched.StartDate
+	board.DueDate = patched.DueDate
+	board.EstimateHours = patched.EstimateHours
+	board.ActualHours = patched.ActualHours
+
+	after, err := s.snapshotBoard(ctx, board)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	activities := diffBoardSnapshots(board.ID, actorFromContext(ctx), before, after)
+
+	autoParticipant := assigneeToAutoAdd(before.AssigneeID, board.AssigneeID, participantUserIDs(board.Participants))
+	if autoParticipant != nil {
+		activities = append(activities, autoParticipantActivity(board.ID, actorFromContext(ctx), *autoParticipant))
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.boardRepo.Update(txCtx, board); err != nil {
+			return boardUpdateError(err)
+		}
+		if autoParticipant != nil {
+			if err := s.participantRepo.Create(txCtx, &domain.Participant{BoardID: board.ID, UserID: *autoParticipant}); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to add assignee as participant", err.Error())
+			}
+		}
+		if err := s.boardRepo.AddActivities(txCtx, activities); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board activity", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
+	}
+	s.publishBoardUpdate(ctx, board, activities)
+	s.evaluateThresholdRules(ctx, board)
+
+	return s.toBoardResponse(board), nil
+}
+
+// toGenericDocument converts a typed document into a mutable JSON object
+func toGenericDocument(v interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// applyPatchOp applies a single operation to the board document
+// Only board members and direct children of /customFields can be targeted
+func applyPatchOp(doc map[string]interface{}, op dto.PatchOp) error {
+	tokens, err := parseJSONPointer(op.Path)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 || !patchableBoardFields[tokens[0]] {
+		return fmt.Errorf("path %q is not supported", op.Path)
+	}
+	if len(tokens) > 2 || (len(tokens) == 2 && tokens[0] != "customFields") {
+		return fmt.Errorf("path %q is not supported", op.Path)
+	}
+
+	// Resolve the parent object and member name
+	parent := doc
+	key := tokens[0]
+	if len(tokens) == 2 {
+		fields, ok := doc["customFields"].(map[string]interface{})
+		if !ok {
+			if op.Op != "add" {
+				return fmt.Errorf("path %q does not exist", op.Path)
+			}
+			fields = map[string]interface{}{}
+			doc["customFields"] = fields
+		}
+		parent = fields
+		key = tokens[1]
+	}
+
+	var value interface{}
+	if op.Op == "add" || op.Op == "replace" || op.Op == "test" {
+		if len(op.Value) == 0 {
+			return fmt.Errorf("operation %q requires a value", op.Op)
+		}
+		if err := json.Unmarshal(op.Value, &value); err != nil {
+			return fmt.Errorf("invalid value: %w", err)
+		}
+	}
+
+	existing, exists := parent[key]
+	switch op.Op {
+	case "add":
+		parent[key] = value
+	case "replace":
+		if !exists {
+			return fmt.Errorf("path %q does not exist", op.Path)
+		}
+		parent[key] = value
+	case "remove":
+		if !exists {
+			return fmt.Errorf("path %q does not exist", op.Path)
+		}
+		if len(tokens) == 1 {
+			// Board members are fixed; removing one resets it to its zero value
+			parent[key] = nil
+		} else {
+			delete(parent, key)
+		}
+	case "test":
+		if !exists {
+			return fmt.Errorf("path %q does not exist", op.Path)
+		}
+		want, _ := json.Marshal(value)
+		got, _ := json.Marshal(existing)
+		if !bytes.Equal(want, got) {
+			return fmt.Errorf("test failed for path %q", op.Path)
+		}
+	default:
+		return fmt.Errorf("operation %q is not supported", op.Op)
+	}
+
+	return nil
+}
+
+// parseJSONPointer splits an RFC 6901 JSON Pointer into unescaped reference tokens
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("path %q must start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		token = strings.ReplaceAll(token, "~1", "/")
+		tokens[i] = strings.ReplaceAll(token, "~0", "~")
+	}
+	return tokens, nil
+}
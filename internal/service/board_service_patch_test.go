@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_PatchBoard_AddReplaceRemove(t *testing.T) {
+	boardID := uuid.New()
+	assigneeID := uuid.New()
+	dueDate := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	customFieldsJSON, _ := json.Marshal(map[string]interface{}{"stage": "in_progress", "role": "developer"})
+
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: boardID},
+		Title:        "Old Title",
+		Content:      "Old Content",
+		CustomFields: customFieldsJSON,
+		AssigneeID:   &assigneeID,
+		DueDate:      &dueDate,
+	}
+	boards := map[uuid.UUID]*domain.Board{board.ID: board}
+	service := newTestBoardService(boardServiceMocks{boardRepo: newBoardMapRepository(boards)})
+
+	ops := []dto.PatchOp{
+		{Op: "test", Path: "/title", Value: json.RawMessage(`"Old Title"`)},
+		{Op: "replace", Path: "/title", Value: json.RawMessage(`"New Title"`)},
+		{Op: "add", Path: "/customFields/importance", Value: json.RawMessage(`"urgent"`)},
+		{Op: "replace", Path: "/customFields/stage", Value: json.RawMessage(`"approved"`)},
+		{Op: "remove", Path: "/customFields/role"},
+		{Op: "remove", Path: "/assigneeId"},
+		{Op: "add", Path: "/startDate", Value: json.RawMessage(`"2024-01-01T00:00:00Z"`)},
+	}
+
+	got, err := service.PatchBoard(context.Background(), boardID, ops)
+	if err != nil {
+		t.Fatalf("PatchBoard() unexpected error = %v", err)
+	}
+
+	if got.Title != "New Title" {
+		t.Errorf("Title = %v, want %v", got.Title, "New Title")
+	}
+	if got.Content != "Old Content" {
+		t.Errorf("Content = %v, want unchanged", got.Content)
+	}
+	wantFields := map[string]interface{}{"stage": "approved", "importance": "urgent"}
+	if len(got.CustomFields) != len(wantFields) {
+		t.Errorf("CustomFields = %v, want %v", got.CustomFields, wantFields)
+	}
+	for k, v := range wantFields {
+		if got.CustomFields[k] != v {
+			t.Errorf("CustomFields[%s] = %v, want %v", k, got.CustomFields[k], v)
+		}
+	}
+	if got.AssigneeID != nil {
+		t.Errorf("AssigneeID = %v, want nil", got.AssigneeID)
+	}
+	if got.StartDate == nil || !got.StartDate.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartDate = %v, want 2024-01-01", got.StartDate)
+	}
+	if boards[boardID] == board || boards[boardID].Title != "New Title" {
+		t.Error("PatchBoard() did not persist the board")
+	}
+}
+
+func TestBoardService_PatchBoard_Rejections(t *testing.T) {
+	tests := []struct {
+		name string
+		ops  []dto.PatchOp
+	}{
+		{
+			name: "실패: 지원하지 않는 경로",
+			ops:  []dto.PatchOp{{Op: "replace", Path: "/authorId", Value: json.RawMessage(`"` + uuid.New().String() + `"`)}},
+		},
+		{
+			name: "실패: customFields 하위 중첩 경로",
+			ops:  []dto.PatchOp{{Op: "add", Path: "/customFields/stage/name", Value: json.RawMessage(`"x"`)}},
+		},
+		{
+			name: "실패: 제목 제거 후 재검증",
+			ops:  []dto.PatchOp{{Op: "remove", Path: "/title"}},
+		},
+		{
+			name: "실패: 시작일이 마감일 이후",
+			ops:  []dto.PatchOp{{Op: "add", Path: "/startDate", Value: json.RawMessage(`"2025-06-01T00:00:00Z"`)}},
+		},
+		{
+			name: "실패: test 연산 불일치",
+			ops:  []dto.PatchOp{{Op: "test", Path: "/title", Value: json.RawMessage(`"Other"`)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dueDate := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+			board := &domain.Board{
+				BaseModel: domain.BaseModel{ID: uuid.New()},
+				Title:     "Title",
+				DueDate:   &dueDate,
+			}
+			boards := map[uuid.UUID]*domain.Board{board.ID: board}
+			service := newTestBoardService(boardServiceMocks{boardRepo: newBoardMapRepository(boards)})
+
+			_, err := service.PatchBoard(context.Background(), board.ID, tt.ops)
+			appErr, ok := err.(*response.AppError)
+			if !ok || appErr.Code != response.ErrCodeValidation {
+				t.Errorf("PatchBoard() error = %v, want %v", err, response.ErrCodeValidation)
+			}
+			if boards[board.ID] != board {
+				t.Error("PatchBoard() persisted a rejected patch")
+			}
+		})
+	}
+}
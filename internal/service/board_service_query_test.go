@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -372,6 +373,41 @@ func TestBoardService_DeleteBoard(t *testing.T) {
 	}
 }
 
+func TestBoardService_TouchBoard(t *testing.T) {
+	boardID := uuid.New()
+
+	var touched []uuid.UUID
+	mockBoardRepo := &MockBoardRepository{
+		TouchFunc: func(ctx context.Context, id uuid.UUID) error {
+			if id != boardID {
+				return gorm.ErrRecordNotFound
+			}
+			touched = append(touched, id)
+			return nil
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			t.Error("TouchBoard() must not save the board")
+			return nil
+		},
+	}
+
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, logger)
+
+	if err := service.TouchBoard(context.Background(), boardID); err != nil {
+		t.Fatalf("TouchBoard() unexpected error = %v", err)
+	}
+	if len(touched) != 1 {
+		t.Errorf("expected one touch, got %d", len(touched))
+	}
+
+	err := service.TouchBoard(context.Background(), uuid.New())
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeNotFound {
+		t.Errorf("TouchBoard() on unknown board error = %v, want %v", err, response.ErrCodeNotFound)
+	}
+}
+
 // TestBoardService_GetBoardsByProject_WithParticipantIDs tests that participant IDs are included in board responses
 
 func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
@@ -543,3 +579,70 @@ func TestBoardService_GetBoardsByProject_WithParticipantIDs(t *testing.T) {
 }
 
 // TestBoardService_toBoardResponse_ParticipantIDs tests the toBoardResponse method directly
+
+func TestBoardService_DeleteBoard_QueuesAttachmentDeletion(t *testing.T) {
+	boardID := uuid.New()
+	attachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "board/files/spec.pdf"}
+
+	tests := []struct {
+		name         string
+		deleteErr    error
+		wantRollback bool
+	}{
+		{name: "jobs commit with the board deletion"},
+		{name: "failed board deletion rolls the jobs back", deleteErr: errors.New("database unavailable"), wantRollback: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queued []*domain.AttachmentDeleteJob
+			s3Called := false
+			transactor := &recordingTransactor{}
+
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}}, nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					return tt.deleteErr
+				},
+			}
+			mockAttachmentRepo := &MockAttachmentRepository{
+				FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+					return []*domain.Attachment{attachment}, nil
+				},
+			}
+			mockS3 := &MockS3Client{
+				DeleteFileFunc: func(ctx context.Context, key string) error {
+					s3Called = true
+					return nil
+				},
+			}
+			queue := &MockAttachmentDeleteJobRepository{
+				EnqueueFunc: func(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error {
+					queued = jobs
+					return nil
+				},
+			}
+
+			service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+				mockAttachmentRepo, mockS3, &MockFieldOptionConverter{}, nil, zap.NewNop(),
+				WithTransactor(transactor), WithAttachmentDeleteQueue(queue))
+
+			err := service.DeleteBoard(context.Background(), boardID)
+
+			if (err != nil) != (tt.deleteErr != nil) {
+				t.Fatalf("DeleteBoard() error = %v", err)
+			}
+			if s3Called {
+				t.Error("expected S3 deletion to be left to the worker")
+			}
+			if len(queued) != 1 || queued[0].AttachmentID != attachment.ID || queued[0].FileKey != attachment.FileURL {
+				t.Errorf("expected one job for the attachment, got %+v", queued)
+			}
+			if transactor.calls != 1 || transactor.rolledBack != tt.wantRollback {
+				t.Errorf("transaction calls = %d, rolled back = %v", transactor.calls, transactor.rolledBack)
+			}
+		})
+	}
+}
@@ -357,4 +357,116 @@ func TestCreateBoard_ValidDateRange(t *testing.T) {
 	}
 }
 
-// TestUpdateBoard_DateValidation tests date validation when updating a board
+// TestUpdateBoard_AllDayDueDate tests that an all-day due date sent with a positive offset keeps its calendar day
+func TestUpdateBoard_AllDayDueDate(t *testing.T) {
+	boardID := uuid.New()
+	seoul := time.FixedZone("KST", 9*60*60)
+	startDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		dueDate time.Time
+		wantDue *time.Time
+		wantErr bool
+	}{
+		{
+			name:    "all-day due on the start day in another offset",
+			dueDate: time.Date(2024, 3, 1, 0, 0, 0, 0, seoul),
+			wantDue: &startDate,
+		},
+		{
+			name:    "all-day due the day before start",
+			dueDate: time.Date(2024, 2, 29, 0, 0, 0, 0, seoul),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existingStart := startDate
+			var saved *domain.Board
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					if saved != nil {
+						return saved, nil
+					}
+					return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, Title: "Board", StartDate: &existingStart, Version: 1}, nil
+				},
+				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+					saved = board
+					return nil
+				},
+			}
+			service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+				&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop())
+
+			ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+			result, err := service.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{DueDate: dto.OptionalOf(&tt.dueDate)})
+
+			if tt.wantErr {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != response.ErrCodeValidation {
+					t.Fatalf("Expected validation error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if saved == nil || saved.DueDate == nil || !saved.DueDate.Equal(*tt.wantDue) || saved.DueDate.Location() != time.UTC {
+				t.Errorf("Expected stored due date %v, got %v", tt.wantDue, saved.DueDate)
+			}
+			if got := result.DueDate.Format(time.RFC3339); got != "2024-03-01T00:00:00Z" {
+				t.Errorf("Expected response due date 2024-03-01T00:00:00Z, got %s", got)
+			}
+		})
+	}
+}
+
+func TestValidateDateRange(t *testing.T) {
+	newYork := time.FixedZone("EST", -5*60*60)
+	at := func(t time.Time) *time.Time { return &t }
+
+	tests := []struct {
+		name      string
+		startDate *time.Time
+		dueDate   *time.Time
+		wantErr   bool
+	}{
+		{
+			name:      "same instant in different offsets",
+			startDate: at(time.Date(2024, 3, 1, 14, 0, 0, 0, newYork)),
+			dueDate:   at(time.Date(2024, 3, 1, 19, 0, 0, 0, time.UTC)),
+		},
+		{
+			name:      "timed start later on an all-day due date",
+			startDate: at(time.Date(2024, 3, 1, 15, 0, 0, 0, time.UTC)),
+			dueDate:   at(time.Date(2024, 3, 1, 0, 0, 0, 0, newYork)),
+		},
+		{
+			name:      "all-day start after timed due",
+			startDate: at(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)),
+			dueDate:   at(time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC)),
+			wantErr:   true,
+		},
+		{
+			name:      "timed start after timed due",
+			startDate: at(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)),
+			dueDate:   at(time.Date(2024, 3, 1, 4, 0, 0, 0, newYork)),
+			wantErr:   true,
+		},
+		{
+			name:    "missing start",
+			dueDate: at(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDateRange(tt.startDate, tt.dueDate)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateDateRange() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
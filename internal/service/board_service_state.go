@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// GetBoardAtTime reconstructs a board's audited fields as they were at the given time
+// It starts from the current board and undoes every recorded change made after at, newest first
+func (s *boardServiceImpl) GetBoardAtTime(ctx context.Context, boardID uuid.UUID, at time.Time) (resp *dto.BoardStateResponse, err error) {
+	ctx, span := s.startSpan(ctx, "GetBoardAtTime", boardID)
+	defer func() { endSpan(span, err) }()
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	if at.Before(board.CreatedAt) {
+		return nil, response.NewAppError(response.ErrCodeNotFound, "Board did not exist at the requested time", "")
+	}
+
+	snapshot, err := s.snapshotBoard(ctx, board)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	snapshot.Participants = participantUserIDs(board.Participants)
+	archivedAt := board.ArchivedAt
+
+	activities, err := s.boardRepo.FindActivitiesSince(ctx, boardID, at)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board activity", err.Error())
+	}
+	for _, activity := range activities {
+		if activity.Field == "archivedAt" {
+			archivedAt = parseActivityTime(activity.OldValue)
+			continue
+		}
+		revertActivity(&snapshot, activity)
+	}
+
+	return &dto.BoardStateResponse{
+		BoardID:       board.ID,
+		At:            at,
+		Title:         snapshot.Title,
+		Content:       snapshot.Content,
+		AssigneeID:    snapshot.AssigneeID,
+		StartDate:     snapshot.StartDate,
+		DueDate:       snapshot.DueDate,
+		EstimateHours: snapshot.EstimateHours,
+		ActualHours:   snapshot.ActualHours,
+		CustomFields:  snapshot.CustomFields,
+		Participants:  snapshot.Participants,
+		ArchivedAt:    archivedAt,
+	}, nil
+}
+
+// revertActivity sets the changed field of snapshot back to its value before the change
+// Fields the snapshot does not track are ignored
+func revertActivity(snapshot *boardSnapshot, activity *domain.BoardActivity) {
+	value := activity.OldValue
+	switch activity.Field {
+	case "title":
+		snapshot.Title = value
+	case "content":
+		snapshot.Content = value
+	case "assigneeId":
+		snapshot.AssigneeID = parseActivityUUID(value)
+	case "startDate":
+		snapshot.StartDate = parseActivityTime(value)
+	case "dueDate":
+		snapshot.DueDate = parseActivityTime(value)
+	case "estimateHours":
+		snapshot.EstimateHours = parseActivityFloat(value)
+	case "actualHours":
+		snapshot.ActualHours = parseActivityFloat(value)
+	case "participants":
+		snapshot.Participants = parseActivityUUIDSet(value)
+	case autoParticipantField:
+		// The participant did not exist before being added with the assignment
+		remaining := snapshot.Participants[:0]
+		for _, userID := range snapshot.Participants {
+			if userID.String() != activity.NewValue {
+				remaining = append(remaining, userID)
+			}
+		}
+		snapshot.Participants = remaining
+	default:
+		key, ok := strings.CutPrefix(activity.Field, "customFields.")
+		if !ok {
+			return
+		}
+		// An empty old value means the field was unset before the change
+		if value == "" {
+			delete(snapshot.CustomFields, key)
+			return
+		}
+		if snapshot.CustomFields == nil {
+			snapshot.CustomFields = map[string]interface{}{}
+		}
+		snapshot.CustomFields[key] = value
+	}
+}
+
+// The parse helpers invert the format helpers in board_service_activity.go; an empty value is nil
+
+func parseActivityUUID(value string) *uuid.UUID {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
+
+func parseActivityTime(value string) *time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func parseActivityFloat(value string) *float64 {
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil
+	}
+	return &f
+}
+
+func parseActivityUUIDSet(value string) []uuid.UUID {
+	ids := []uuid.UUID{}
+	if value == "" {
+		return ids
+	}
+	for _, part := range strings.Split(value, ",") {
+		if id, err := uuid.Parse(part); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
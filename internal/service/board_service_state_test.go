@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/response"
+)
+
+func TestBoardService_GetBoardAtTime(t *testing.T) {
+	boardID := uuid.New()
+	participantID := uuid.New()
+	created := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	titleChanged := created.Add(2 * time.Hour)
+	stageChanged := created.Add(3 * time.Hour)
+
+	board := &domain.Board{
+		BaseModel:    domain.BaseModel{ID: boardID, CreatedAt: created},
+		ProjectID:    uuid.New(),
+		Title:        "New title",
+		Content:      "Content",
+		CustomFields: datatypes.JSON(`{"stage":"id-done"}`),
+		Participants: []domain.Participant{{BoardID: boardID, UserID: participantID}},
+	}
+	history := []*domain.BoardActivity{
+		{BaseModel: domain.BaseModel{CreatedAt: stageChanged}, BoardID: boardID, Field: "customFields.stage", OldValue: "todo", NewValue: "done"},
+		{BaseModel: domain.BaseModel{CreatedAt: stageChanged}, BoardID: boardID, Field: "participants", OldValue: "", NewValue: participantID.String()},
+		{BaseModel: domain.BaseModel{CreatedAt: titleChanged}, BoardID: boardID, Field: "title", OldValue: "Old title", NewValue: "New title"},
+	}
+
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return board, nil
+		},
+		FindActivitiesSinceFunc: func(ctx context.Context, id uuid.UUID, since time.Time) ([]*domain.BoardActivity, error) {
+			var activities []*domain.BoardActivity
+			for _, activity := range history {
+				if activity.CreatedAt.After(since) {
+					activities = append(activities, activity)
+				}
+			}
+			return activities, nil
+		},
+	}
+	mockConverter := &MockFieldOptionConverter{
+		ConvertIDsToValuesFunc: func(ctx context.Context, fields map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"stage": "done"}, nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, mockConverter, nil, zap.NewNop())
+
+	// Before the title change
+	state, err := service.GetBoardAtTime(context.Background(), boardID, titleChanged.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("GetBoardAtTime() unexpected error = %v", err)
+	}
+	if state.Title != "Old title" {
+		t.Errorf("title = %q, want %q", state.Title, "Old title")
+	}
+	if state.CustomFields["stage"] != "todo" {
+		t.Errorf("customFields.stage = %v, want todo", state.CustomFields["stage"])
+	}
+	if len(state.Participants) != 0 {
+		t.Errorf("participants = %v, want none", state.Participants)
+	}
+
+	// Between the title and stage changes
+	state, err = service.GetBoardAtTime(context.Background(), boardID, titleChanged.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetBoardAtTime() unexpected error = %v", err)
+	}
+	if state.Title != "New title" || state.CustomFields["stage"] != "todo" {
+		t.Errorf("got title %q stage %v, want New title and todo", state.Title, state.CustomFields["stage"])
+	}
+
+	// Before the board existed
+	_, err = service.GetBoardAtTime(context.Background(), boardID, created.Add(-time.Hour))
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeNotFound {
+		t.Errorf("GetBoardAtTime() error = %v, want %s", err, response.ErrCodeNotFound)
+	}
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// SaveBoardAsTemplate captures a board's title, content, custom fields, assignee and participants as a template
+// The template is named after the board and belongs to the board's project
+func (s *boardServiceImpl) SaveBoardAsTemplate(ctx context.Context, boardID uuid.UUID) (resp *dto.BoardTemplateResponse, err error) {
+	ctx, span := s.startSpan(ctx, "SaveBoardAsTemplate", boardID)
+	defer func() { endSpan(span, err) }()
+
+	if s.templateRepo == nil {
+		return nil, response.NewValidationError("Board templates are not supported", "")
+	}
+
+	userID, exists := ctx.Value("user_id").(uuid.UUID)
+	if !exists {
+		return nil, response.NewAppError(response.ErrCodeUnauthorized, "User ID not found in context", "")
+	}
+
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+
+	// Option IDs are stored as values so the template survives options being recreated
+	template := &domain.BoardTemplate{
+		ProjectID:      board.ProjectID,
+		Name:           board.Title,
+		TitlePattern:   board.Title,
+		ContentPattern: board.Content,
+		AssigneeID:     board.AssigneeID,
+		CreatedBy:      userID,
+	}
+	if len(board.CustomFields) > 0 {
+		values, err := s.readableCustomFields(ctx, board.CustomFields)
+		if err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+		}
+		if template.CustomFields, err = json.Marshal(values); err != nil {
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to save template", err.Error())
+		}
+	}
+	for _, p := range board.Participants {
+		template.ParticipantIDs = append(template.ParticipantIDs, p.UserID)
+	}
+
+	if err := s.templateRepo.Create(ctx, template); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to save template", err.Error())
+	}
+
+	return toBoardTemplateResponse(template), nil
+}
+
+// GetBoardTemplates lists the templates of a project, newest first
+func (s *boardServiceImpl) GetBoardTemplates(ctx context.Context, projectID uuid.UUID) ([]*dto.BoardTemplateResponse, error) {
+	if s.templateRepo == nil {
+		return nil, response.NewValidationError("Board templates are not supported", "")
+	}
+
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	templates, err := s.templateRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch templates", err.Error())
+	}
+
+	responses := make([]*dto.BoardTemplateResponse, len(templates))
+	for i, template := range templates {
+		responses[i] = toBoardTemplateResponse(template)
+	}
+	return responses, nil
+}
+
+// CreateBoardFromTemplate creates a board in the template's project from the template, then applies overrides
+// The board is created through CreateBoard, so it gets the same validation, quota and title checks
+func (s *boardServiceImpl) CreateBoardFromTemplate(ctx context.Context, templateID uuid.UUID, overrides *dto.CreateBoardFromTemplateRequest) (*dto.BoardResponse, error) {
+	if s.templateRepo == nil {
+		return nil, response.NewValidationError("Board templates are not supported", "")
+	}
+
+	template, err := s.templateRepo.FindByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Template not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch template", err.Error())
+	}
+	if overrides == nil {
+		overrides = &dto.CreateBoardFromTemplateRequest{}
+	}
+
+	customFields, err := s.templateCustomFields(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+	// Overrides are the caller's own input, so invalid values there still fail the request
+	for field, value := range overrides.CustomFields {
+		if customFields == nil {
+			customFields = make(map[string]interface{}, len(overrides.CustomFields))
+		}
+		customFields[field] = value
+	}
+
+	now := time.Now()
+	req := &dto.CreateBoardRequest{
+		ProjectID:     template.ProjectID,
+		Title:         expandTemplatePattern(template.TitlePattern, now),
+		Content:       expandTemplatePattern(template.ContentPattern, now),
+		CustomFields:  customFields,
+		AssigneeID:    template.AssigneeID,
+		Participants:  template.ParticipantIDs,
+		StartDate:     overrides.StartDate,
+		DueDate:       overrides.DueDate,
+		EstimateHours: overrides.EstimateHours,
+	}
+	if overrides.Title != nil {
+		req.Title = *overrides.Title
+	}
+	if overrides.Content != nil {
+		req.Content = *overrides.Content
+	}
+	if overrides.AssigneeID != nil {
+		req.AssigneeID = overrides.AssigneeID
+	}
+	if overrides.Participants != nil {
+		req.Participants = overrides.Participants
+	}
+
+	return s.CreateBoard(ctx, req)
+}
+
+// templateCustomFields returns the template's custom field values that still resolve to an option
+// Values whose option was removed or archived since the template was saved are skipped with a warning
+func (s *boardServiceImpl) templateCustomFields(ctx context.Context, template *domain.BoardTemplate) (map[string]interface{}, error) {
+	if len(template.CustomFields) == 0 {
+		return nil, nil
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(template.CustomFields, &values); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to read template custom fields", err.Error())
+	}
+
+	_, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, template.ProjectID, values)
+	var fieldErr *converter.FieldValuesError
+	if errors.As(err, &fieldErr) {
+		for field, reason := range fieldErr.Fields {
+			s.logger.Warn("Skipping stale template custom field",
+				zap.String("template_id", template.ID.String()),
+				zap.String("field", field),
+				zap.String("reason", reason))
+			delete(values, field)
+		}
+	} else if err != nil {
+		return nil, customFieldsError(err)
+	}
+	return values, nil
+}
+
+// expandTemplatePattern fills in the placeholders of a template title or content
+func expandTemplatePattern(pattern string, now time.Time) string {
+	return strings.ReplaceAll(pattern, domain.BoardTemplatePlaceholderDate, now.Format("2006-01-02"))
+}
+
+// toBoardTemplateResponse converts domain.BoardTemplate to dto.BoardTemplateResponse
+func toBoardTemplateResponse(template *domain.BoardTemplate) *dto.BoardTemplateResponse {
+	var customFields map[string]interface{}
+	if len(template.CustomFields) > 0 {
+		_ = json.Unmarshal(template.CustomFields, &customFields)
+	}
+
+	participantIDs := []uuid.UUID(template.ParticipantIDs)
+	if participantIDs == nil {
+		participantIDs = []uuid.UUID{}
+	}
+
+	return &dto.BoardTemplateResponse{
+		ID:             template.ID,
+		ProjectID:      template.ProjectID,
+		Name:           template.Name,
+		TitlePattern:   template.TitlePattern,
+		ContentPattern: template.ContentPattern,
+		CustomFields:   customFields,
+		AssigneeID:     template.AssigneeID,
+		ParticipantIDs: participantIDs,
+		CreatedBy:      template.CreatedBy,
+		CreatedAt:      template.CreatedAt,
+	}
+}
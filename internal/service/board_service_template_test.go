@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/datatypes"
+
+	"project-board-api/internal/converter"
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+// retiredOptionConverter rejects the value "retired" like an option that was deleted after a template was saved
+func retiredOptionConverter() *MockFieldOptionConverter {
+	return &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, projectID uuid.UUID, fields map[string]interface{}) (map[string]interface{}, error) {
+			invalid := map[string]string{}
+			for field, value := range fields {
+				if value == "retired" {
+					invalid[field] = "invalid field option value 'retired' for field type '" + field + "'"
+				}
+			}
+			if len(invalid) > 0 {
+				return nil, &converter.FieldValuesError{Fields: invalid}
+			}
+			return fields, nil
+		},
+	}
+}
+
+func TestBoardService_CreateBoardFromTemplate(t *testing.T) {
+	projectID := uuid.New()
+	templateAssignee := uuid.New()
+	templateParticipant := uuid.New()
+	template := &domain.BoardTemplate{
+		BaseModel:      domain.BaseModel{ID: uuid.New()},
+		ProjectID:      projectID,
+		Name:           "Weekly sync",
+		TitlePattern:   "Weekly sync " + domain.BoardTemplatePlaceholderDate,
+		ContentPattern: "Agenda",
+		CustomFields:   datatypes.JSON(`{"stage": "in_progress", "importance": "retired", "role": "developer"}`),
+		AssigneeID:     &templateAssignee,
+		ParticipantIDs: []uuid.UUID{templateParticipant},
+	}
+
+	newService := func(created **domain.Board) BoardService {
+		mockBoardRepo := &MockBoardRepository{
+			CreateFunc: func(ctx context.Context, board *domain.Board) error {
+				board.ID = uuid.New()
+				*created = board
+				return nil
+			},
+			// CreateBoard reloads the board after adding the template's participants
+			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+				return *created, nil
+			},
+		}
+		mockProjectRepo := &MockProjectRepository{
+			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+				return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+			},
+		}
+		mockTemplateRepo := &MockBoardTemplateRepository{
+			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error) {
+				return template, nil
+			},
+		}
+		return NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+			&MockAttachmentRepository{}, &MockS3Client{}, retiredOptionConverter(), nil, zap.NewNop(),
+			WithBoardTemplateRepository(mockTemplateRepo))
+	}
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	t.Run("stale options are skipped and the rest of the template applies", func(t *testing.T) {
+		var created *domain.Board
+		resp, err := newService(&created).CreateBoardFromTemplate(ctx, template.ID, nil)
+		if err != nil {
+			t.Fatalf("CreateBoardFromTemplate() unexpected error = %v", err)
+		}
+
+		wantTitle := "Weekly sync " + time.Now().Format("2006-01-02")
+		if created.Title != wantTitle || created.Content != "Agenda" || created.ProjectID != projectID {
+			t.Errorf("created board = %q / %q in %s, want %q / Agenda in %s", created.Title, created.Content, created.ProjectID, wantTitle, projectID)
+		}
+		if created.AssigneeID == nil || *created.AssigneeID != templateAssignee {
+			t.Errorf("assignee = %v, want %s", created.AssigneeID, templateAssignee)
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(created.CustomFields, &fields); err != nil {
+			t.Fatalf("failed to read custom fields: %v", err)
+		}
+		if len(fields) != 2 || fields["stage"] != "in_progress" || fields["role"] != "developer" {
+			t.Errorf("customFields = %v, want stage and role without the retired importance", fields)
+		}
+		if resp.ID != created.ID {
+			t.Errorf("response board = %s, want %s", resp.ID, created.ID)
+		}
+	})
+
+	t.Run("overrides replace template values", func(t *testing.T) {
+		var created *domain.Board
+		title := "Kickoff"
+		assignee := uuid.New()
+		_, err := newService(&created).CreateBoardFromTemplate(ctx, template.ID, &dto.CreateBoardFromTemplateRequest{
+			Title:        &title,
+			AssigneeID:   &assignee,
+			CustomFields: map[string]interface{}{"stage": "done"},
+		})
+		if err != nil {
+			t.Fatalf("CreateBoardFromTemplate() unexpected error = %v", err)
+		}
+		if created.Title != "Kickoff" || *created.AssigneeID != assignee {
+			t.Errorf("created board = %q assigned to %v, want the overrides", created.Title, created.AssigneeID)
+		}
+		if !strings.Contains(string(created.CustomFields), `"stage":"done"`) {
+			t.Errorf("customFields = %s, want the overridden stage", created.CustomFields)
+		}
+	})
+
+	t.Run("invalid override values fail the creation", func(t *testing.T) {
+		var created *domain.Board
+		_, err := newService(&created).CreateBoardFromTemplate(ctx, template.ID, &dto.CreateBoardFromTemplateRequest{
+			CustomFields: map[string]interface{}{"role": "retired"},
+		})
+		if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+			t.Errorf("CreateBoardFromTemplate() error = %v, want %s", err, response.ErrCodeValidation)
+		}
+		if created != nil {
+			t.Error("board was created despite an invalid override")
+		}
+	})
+}
+
+func TestBoardService_SaveBoardAsTemplate(t *testing.T) {
+	boardID := uuid.New()
+	assignee := uuid.New()
+	participant := uuid.New()
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{
+				BaseModel:    domain.BaseModel{ID: boardID},
+				ProjectID:    uuid.New(),
+				Title:        "Release checklist",
+				Content:      "Steps",
+				CustomFields: datatypes.JSON(`{"stage": "option-1"}`),
+				AssigneeID:   &assignee,
+				Participants: []domain.Participant{{BoardID: boardID, UserID: participant}},
+			}, nil
+		},
+	}
+	mockConverter := &MockFieldOptionConverter{
+		ConvertIDsToValuesFunc: func(ctx context.Context, fields map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"stage": "in_progress"}, nil
+		},
+	}
+	var saved *domain.BoardTemplate
+	mockTemplateRepo := &MockBoardTemplateRepository{
+		CreateFunc: func(ctx context.Context, template *domain.BoardTemplate) error {
+			saved = template
+			return nil
+		},
+	}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, mockConverter, nil, zap.NewNop(), WithBoardTemplateRepository(mockTemplateRepo))
+
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+	resp, err := service.SaveBoardAsTemplate(ctx, boardID)
+	if err != nil {
+		t.Fatalf("SaveBoardAsTemplate() unexpected error = %v", err)
+	}
+
+	// Option IDs are stored as values
+	if string(saved.CustomFields) != `{"stage":"in_progress"}` {
+		t.Errorf("customFields = %s, want option values", saved.CustomFields)
+	}
+	if saved.TitlePattern != "Release checklist" || saved.ContentPattern != "Steps" || *saved.AssigneeID != assignee {
+		t.Errorf("saved template = %+v, want the board's title, content and assignee", saved)
+	}
+	if len(resp.ParticipantIDs) != 1 || resp.ParticipantIDs[0] != participant {
+		t.Errorf("participantIds = %v, want [%s]", resp.ParticipantIDs, participant)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -307,3 +309,424 @@ func TestBoardService_CreateBoard_CustomFields(t *testing.T) {
 		})
 	}
 }
+
+func TestBoardService_CreateBoard_Quota(t *testing.T) {
+	projectID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	// In-memory set of active boards backing the mock repository
+	active := map[uuid.UUID]*domain.Board{}
+	mockBoardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			active[board.ID] = board
+			return nil
+		},
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			board, ok := active[id]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			return board, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			delete(active, id)
+			return nil
+		},
+		CountActiveByProjectIDFunc: func(ctx context.Context, id uuid.UUID) (int64, error) {
+			return int64(len(active)), nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, logger, WithMaxBoardsPerProject(2))
+
+	req := &dto.CreateBoardRequest{ProjectID: projectID, Title: "Board"}
+
+	var created []*dto.BoardResponse
+	for i := 0; i < 2; i++ {
+		board, err := service.CreateBoard(ctx, req)
+		if err != nil {
+			t.Fatalf("CreateBoard() under quota unexpected error = %v", err)
+		}
+		created = append(created, board)
+	}
+
+	// Creating at the limit must fail
+	_, err := service.CreateBoard(ctx, req)
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeQuotaExceeded {
+		t.Fatalf("CreateBoard() at quota error = %v, want %v", err, response.ErrCodeQuotaExceeded)
+	}
+
+	// Deleting a board frees a slot
+	if err := service.DeleteBoard(ctx, created[0].ID); err != nil {
+		t.Fatalf("DeleteBoard() unexpected error = %v", err)
+	}
+	if _, err := service.CreateBoard(ctx, req); err != nil {
+		t.Errorf("CreateBoard() after delete unexpected error = %v", err)
+	}
+}
+
+func TestBoardService_CreateBoard_QuotaCountedUnderProjectLock(t *testing.T) {
+	projectID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	// Concurrent creations only see each other's boards if the count runs after the lock, in the same transaction
+	var steps []string
+	mockBoardRepo := &MockBoardRepository{
+		CountActiveByProjectIDFunc: func(ctx context.Context, id uuid.UUID) (int64, error) {
+			steps = append(steps, fmt.Sprintf("count in transaction=%v", inRecordedTransaction(ctx)))
+			return 0, nil
+		},
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			steps = append(steps, fmt.Sprintf("create in transaction=%v", inRecordedTransaction(ctx)))
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+		LockByIDFunc: func(ctx context.Context, id uuid.UUID) error {
+			if id != projectID {
+				t.Errorf("LockByID() project = %v, want %v", id, projectID)
+			}
+			steps = append(steps, fmt.Sprintf("lock in transaction=%v", inRecordedTransaction(ctx)))
+			return nil
+		},
+	}
+
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(),
+		WithMaxBoardsPerProject(2), WithTransactor(&recordingTransactor{}))
+
+	if _, err := service.CreateBoard(ctx, &dto.CreateBoardRequest{ProjectID: projectID, Title: "Board"}); err != nil {
+		t.Fatalf("CreateBoard() unexpected error = %v", err)
+	}
+
+	want := []string{"lock in transaction=true", "count in transaction=true", "create in transaction=true"}
+	if strings.Join(steps, ", ") != strings.Join(want, ", ") {
+		t.Errorf("steps = %v, want %v", steps, want)
+	}
+}
+
+func TestBoardService_CreateBoard_OversizedCustomFields(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	created := false
+	mockBoardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			created = true
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	// The limit applies to the converted JSON, so the converter inflates a small request
+	mockConverter := &MockFieldOptionConverter{
+		ConvertValuesToIDsFunc: func(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}) (map[string]interface{}, error) {
+			return map[string]interface{}{"stage": strings.Repeat("x", 200)}, nil
+		},
+	}
+
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, mockConverter, nil, logger, WithMaxCustomFieldsBytes(128))
+
+	req := &dto.CreateBoardRequest{
+		ProjectID:    uuid.New(),
+		Title:        "Board",
+		CustomFields: map[string]interface{}{"stage": "in_progress"},
+	}
+
+	_, err := service.CreateBoard(ctx, req)
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeTooLarge {
+		t.Fatalf("CreateBoard() error = %v, want %v", err, response.ErrCodeTooLarge)
+	}
+	if created {
+		t.Error("CreateBoard() persisted an oversized board")
+	}
+}
+
+// recordingTransactor runs work directly and records whether it would have been rolled back
+type recordingTransactor struct {
+	calls      int
+	rolledBack bool
+}
+
+// recordingTxKey marks the contexts a recordingTransactor passes to its work
+type recordingTxKey struct{}
+
+func (r *recordingTransactor) WithinTransaction(ctx context.Context, fn func(txCtx context.Context) error) error {
+	r.calls++
+	err := fn(context.WithValue(ctx, recordingTxKey{}, true))
+	r.rolledBack = err != nil
+	return err
+}
+
+// inRecordedTransaction reports whether ctx belongs to work run by a recordingTransactor
+func inRecordedTransaction(ctx context.Context) bool {
+	inTx, _ := ctx.Value(recordingTxKey{}).(bool)
+	return inTx
+}
+
+func TestBoardService_CreateBoard_AttachmentConfirmationRollsBack(t *testing.T) {
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+	attachmentID := uuid.New()
+
+	tests := []struct {
+		name         string
+		confirmErr   error
+		wantErr      bool
+		wantRollback bool
+	}{
+		{name: "성공: 임시 첨부파일 확정", confirmErr: nil},
+		{name: "실패: 확정 실패 시 Board 생성 롤백", confirmErr: errors.New("expected to confirm 1 attachment(s) but only confirmed 0"), wantErr: true, wantRollback: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			compensatingDelete := false
+			mockBoardRepo := &MockBoardRepository{
+				CreateFunc: func(ctx context.Context, board *domain.Board) error {
+					board.ID = uuid.New()
+					return nil
+				},
+				DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+					compensatingDelete = true
+					return nil
+				},
+			}
+			mockProjectRepo := &MockProjectRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+					return &domain.Project{}, nil
+				},
+			}
+			var confirmedFor uuid.UUID
+			mockAttachmentRepo := &MockAttachmentRepository{
+				FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+					return []*domain.Attachment{{
+						BaseModel:  domain.BaseModel{ID: attachmentID},
+						EntityType: domain.EntityTypeBoard,
+						Status:     domain.AttachmentStatusTemp,
+					}}, nil
+				},
+				ConfirmAttachmentsFunc: func(ctx context.Context, ids []uuid.UUID, entityID uuid.UUID) error {
+					confirmedFor = entityID
+					return tt.confirmErr
+				},
+			}
+			transactor := &recordingTransactor{}
+			logger, _ := zap.NewDevelopment()
+			service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+				mockAttachmentRepo, &MockS3Client{}, &MockFieldOptionConverter{}, nil, logger, WithTransactor(transactor))
+
+			// When
+			got, err := service.CreateBoard(ctx, &dto.CreateBoardRequest{
+				ProjectID:     uuid.New(),
+				Title:         "Board",
+				AttachmentIDs: []uuid.UUID{attachmentID},
+			})
+
+			// Then
+			if transactor.calls != 1 {
+				t.Errorf("WithinTransaction calls = %d, want 1", transactor.calls)
+			}
+			if transactor.rolledBack != tt.wantRollback {
+				t.Errorf("rolledBack = %v, want %v", transactor.rolledBack, tt.wantRollback)
+			}
+			if compensatingDelete {
+				t.Error("CreateBoard() should rely on the transaction instead of deleting the board")
+			}
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("CreateBoard() expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateBoard() unexpected error = %v", err)
+			}
+			if confirmedFor != got.ID || len(got.Attachments) != 1 {
+				t.Errorf("attachments confirmed for %v with %d in response, want board %v with 1", confirmedFor, len(got.Attachments), got.ID)
+			}
+		})
+	}
+}
+
+func TestBoardService_CreateBoard_AttachmentChecksumComputedAtConfirmation(t *testing.T) {
+	// Given
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+	stored := &domain.Attachment{
+		BaseModel:  domain.BaseModel{ID: uuid.New()},
+		EntityType: domain.EntityTypeBoard,
+		Status:     domain.AttachmentStatusTemp,
+		FileURL:    "board/boards/ws/2024/01/report.pdf",
+	}
+	const checksum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	mockBoardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{}, nil
+		},
+	}
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
+			copied := *stored
+			return []*domain.Attachment{&copied}, nil
+		},
+		ConfirmAttachmentsFunc: func(ctx context.Context, ids []uuid.UUID, entityID uuid.UUID) error {
+			stored.Status = domain.AttachmentStatusConfirmed
+			stored.EntityID = &entityID
+			return nil
+		},
+		UpdateChecksumFunc: func(ctx context.Context, id uuid.UUID, sum string) error {
+			stored.ChecksumSHA256 = sum
+			return nil
+		},
+	}
+	var hashedKey string
+	mockS3 := &MockS3Client{
+		ComputeSHA256Func: func(ctx context.Context, key string) (string, error) {
+			if stored.Status != domain.AttachmentStatusConfirmed {
+				t.Error("ComputeSHA256() called before the attachment was confirmed")
+			}
+			hashedKey = key
+			return checksum, nil
+		},
+	}
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		mockAttachmentRepo, mockS3, &MockFieldOptionConverter{}, nil, logger)
+
+	// When
+	got, err := service.CreateBoard(ctx, &dto.CreateBoardRequest{
+		ProjectID:     uuid.New(),
+		Title:         "Board",
+		AttachmentIDs: []uuid.UUID{stored.ID},
+	})
+
+	// Then
+	if err != nil {
+		t.Fatalf("CreateBoard() unexpected error = %v", err)
+	}
+	if hashedKey != stored.FileURL {
+		t.Errorf("ComputeSHA256() key = %q, want %q", hashedKey, stored.FileURL)
+	}
+	if len(got.Attachments) != 1 {
+		t.Fatalf("attachments in response = %d, want 1", len(got.Attachments))
+	}
+	if got.Attachments[0].ChecksumSHA256 != checksum {
+		t.Errorf("ChecksumSHA256 = %q, want %q", got.Attachments[0].ChecksumSHA256, checksum)
+	}
+}
+
+func TestBoardService_UniqueTitles(t *testing.T) {
+	projectID := uuid.New()
+	ctx := context.WithValue(context.Background(), "user_id", uuid.New())
+
+	// In-memory set of active boards backing the mock repository
+	active := map[uuid.UUID]*domain.Board{}
+	mockBoardRepo := &MockBoardRepository{
+		CreateFunc: func(ctx context.Context, board *domain.Board) error {
+			board.ID = uuid.New()
+			active[board.ID] = board
+			return nil
+		},
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			board, ok := active[id]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			copied := *board
+			return &copied, nil
+		},
+		FindByUniqueTitleFunc: func(ctx context.Context, projectID uuid.UUID, title string) (*domain.Board, error) {
+			for _, board := range active {
+				if board.TitleUnique && board.Title == title {
+					return board, nil
+				}
+			}
+			return nil, gorm.ErrRecordNotFound
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			active[board.ID] = board
+			return nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			delete(active, id)
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, EnforceUniqueTitles: true}, nil
+		},
+	}
+
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, logger)
+
+	assertConflict := func(t *testing.T, err error) {
+		t.Helper()
+		appErr, ok := err.(*response.AppError)
+		if !ok || appErr.Code != response.ErrCodeConflict {
+			t.Fatalf("error = %v, want %v", err, response.ErrCodeConflict)
+		}
+	}
+
+	original, err := service.CreateBoard(ctx, &dto.CreateBoardRequest{ProjectID: projectID, Title: "Launch"})
+	if err != nil {
+		t.Fatalf("CreateBoard() unexpected error = %v", err)
+	}
+	other, err := service.CreateBoard(ctx, &dto.CreateBoardRequest{ProjectID: projectID, Title: "Retro"})
+	if err != nil {
+		t.Fatalf("CreateBoard() unexpected error = %v", err)
+	}
+
+	t.Run("duplicate create is rejected", func(t *testing.T) {
+		_, err := service.CreateBoard(ctx, &dto.CreateBoardRequest{ProjectID: projectID, Title: "Launch"})
+		assertConflict(t, err)
+	})
+
+	t.Run("renaming onto a taken title is rejected", func(t *testing.T) {
+		title := "Launch"
+		_, err := service.UpdateBoard(ctx, other.ID, &dto.UpdateBoardRequest{Title: &title})
+		assertConflict(t, err)
+	})
+
+	t.Run("keeping its own title is allowed", func(t *testing.T) {
+		title := "Launch"
+		if _, err := service.UpdateBoard(ctx, original.ID, &dto.UpdateBoardRequest{Title: &title}); err != nil {
+			t.Errorf("UpdateBoard() unexpected error = %v", err)
+		}
+	})
+
+	t.Run("deleting the original frees the title", func(t *testing.T) {
+		if err := service.DeleteBoard(ctx, original.ID); err != nil {
+			t.Fatalf("DeleteBoard() unexpected error = %v", err)
+		}
+		if _, err := service.CreateBoard(ctx, &dto.CreateBoardRequest{ProjectID: projectID, Title: "Launch"}); err != nil {
+			t.Errorf("CreateBoard() after delete unexpected error = %v", err)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
+)
+
+// WithThresholdRules evaluates a project's threshold rules whenever one of its boards is updated
+// Alerts are published as board.threshold_alert webhook events, so they need WithWebhookPublisher to be delivered
+func WithThresholdRules(ruleRepo repository.ThresholdRuleRepository) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.thresholdRuleRepo = ruleRepo
+	}
+}
+
+// evaluateThresholdRules alerts the recipients of rules whose condition became true with an update,
+// and resets rules whose condition no longer holds, so a board that stays over a threshold alerts only once
+// The update has already been committed, so failures are logged rather than returned
+func (s *boardServiceImpl) evaluateThresholdRules(ctx context.Context, board *domain.Board) {
+	if s.thresholdRuleRepo == nil {
+		return
+	}
+
+	rules, err := s.thresholdRuleRepo.FindByProjectID(ctx, board.ProjectID)
+	if err != nil {
+		s.logger.Warn("Failed to fetch threshold rules", zap.String("board_id", board.ID.String()), zap.Error(err))
+		return
+	}
+	if len(rules) == 0 {
+		return
+	}
+	triggered, err := s.thresholdRuleRepo.FindTriggeredRuleIDs(ctx, board.ID)
+	if err != nil {
+		s.logger.Warn("Failed to fetch threshold rule triggers", zap.String("board_id", board.ID.String()), zap.Error(err))
+		return
+	}
+
+	now := time.Now().UTC()
+	for _, rule := range rules {
+		value, ok := rule.Field.FieldValue(board)
+		if !ok || !rule.Matches(value) {
+			if triggered[rule.ID] {
+				if err := s.thresholdRuleRepo.ClearTriggered(ctx, rule.ID, board.ID); err != nil {
+					s.logger.Warn("Failed to reset threshold rule",
+						zap.String("rule_id", rule.ID.String()), zap.String("board_id", board.ID.String()), zap.Error(err))
+				}
+			}
+			continue
+		}
+		if triggered[rule.ID] {
+			continue
+		}
+
+		// A concurrent update may have crossed the threshold first; only the one that records it alerts
+		created, err := s.thresholdRuleRepo.MarkTriggered(ctx, rule.ID, board.ID, now)
+		if err != nil {
+			s.logger.Warn("Failed to record threshold rule trigger",
+				zap.String("rule_id", rule.ID.String()), zap.String("board_id", board.ID.String()), zap.Error(err))
+			continue
+		}
+		if created {
+			s.publishThresholdAlert(ctx, board, rule, value, now)
+		}
+	}
+}
+
+// publishThresholdAlert sends a board.threshold_alert event for the rule's recipients
+func (s *boardServiceImpl) publishThresholdAlert(ctx context.Context, board *domain.Board, rule *domain.ThresholdRule, value float64, at time.Time) {
+	s.queueWebhookEvent(ctx, domain.WebhookEvent{
+		Type:          domain.WebhookEventBoardThresholdAlert,
+		ProjectID:     board.ProjectID,
+		BoardID:       board.ID,
+		ChangedFields: []string{string(rule.Field)},
+		Alert: &domain.ThresholdAlert{
+			RuleID:     rule.ID,
+			Field:      rule.Field,
+			Operator:   rule.Operator,
+			Threshold:  rule.Threshold,
+			Value:      value,
+			Recipients: rule.Recipients,
+		},
+		ActorID:    actorFromContext(ctx),
+		OccurredAt: at,
+	})
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardService_UpdateBoard_ThresholdAlerts(t *testing.T) {
+	recipientID := uuid.New()
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		ProjectID: uuid.New(),
+		Title:     "Board",
+	}
+	rule := &domain.ThresholdRule{
+		BaseModel:  domain.BaseModel{ID: uuid.New()},
+		ProjectID:  board.ProjectID,
+		Field:      domain.ThresholdFieldActualHours,
+		Operator:   domain.ThresholdOperatorGreater,
+		Threshold:  10,
+		Recipients: []uuid.UUID{recipientID},
+	}
+
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		UpdateFunc: func(ctx context.Context, updated *domain.Board) error {
+			board = updated
+			return nil
+		},
+	}
+	triggered := map[uuid.UUID]bool{}
+	ruleRepo := &MockThresholdRuleRepository{
+		FindByProjectIDFunc: func(ctx context.Context, projectID uuid.UUID) ([]*domain.ThresholdRule, error) {
+			return []*domain.ThresholdRule{rule}, nil
+		},
+		FindTriggeredRuleIDsFunc: func(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID]bool, error) {
+			copied := make(map[uuid.UUID]bool, len(triggered))
+			for id := range triggered {
+				copied[id] = true
+			}
+			return copied, nil
+		},
+		MarkTriggeredFunc: func(ctx context.Context, ruleID, boardID uuid.UUID, at time.Time) (bool, error) {
+			if triggered[ruleID] {
+				return false, nil
+			}
+			triggered[ruleID] = true
+			return true, nil
+		},
+		ClearTriggeredFunc: func(ctx context.Context, ruleID, boardID uuid.UUID) error {
+			delete(triggered, ruleID)
+			return nil
+		},
+	}
+	publisher := &recordingPublisher{}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(),
+		WithWebhookPublisher(publisher), WithThresholdRules(ruleRepo))
+
+	alerts := func() []domain.WebhookEvent {
+		var events []domain.WebhookEvent
+		for _, event := range publisher.events {
+			if event.Type == domain.WebhookEventBoardThresholdAlert {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+	setActualHours := func(hours float64) {
+		t.Helper()
+		if _, err := service.UpdateBoard(context.Background(), board.ID, &dto.UpdateBoardRequest{ActualHours: &hours}); err != nil {
+			t.Fatalf("UpdateBoard() unexpected error = %v", err)
+		}
+	}
+
+	setActualHours(8)
+	if len(alerts()) != 0 {
+		t.Fatalf("expected no alert below the threshold, got %d", len(alerts()))
+	}
+
+	setActualHours(12)
+	if len(alerts()) != 1 {
+		t.Fatalf("expected 1 alert after crossing the threshold, got %d", len(alerts()))
+	}
+	alert := alerts()[0].Alert
+	if alert == nil || alert.RuleID != rule.ID || alert.Value != 12 {
+		t.Fatalf("alert = %+v, want rule %s with value 12", alert, rule.ID)
+	}
+	if len(alert.Recipients) != 1 || alert.Recipients[0] != recipientID {
+		t.Errorf("alert recipients = %v, want [%s]", alert.Recipients, recipientID)
+	}
+
+	setActualHours(15)
+	if len(alerts()) != 1 {
+		t.Fatalf("expected no new alert while still over the threshold, got %d alerts", len(alerts()))
+	}
+
+	setActualHours(9)
+	if triggered[rule.ID] {
+		t.Fatal("expected the trigger to reset once the board dropped below the threshold")
+	}
+
+	setActualHours(11)
+	if len(alerts()) != 2 {
+		t.Fatalf("expected a new alert after crossing the threshold again, got %d alerts", len(alerts()))
+	}
+}
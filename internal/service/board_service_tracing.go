@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies the board service instrumentation scope
+const tracerName = "project-board-api/internal/service"
+
+// WithTracer sets the tracer used to create spans around board service operations
+func WithTracer(tracer trace.Tracer) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		if tracer != nil {
+			s.tracer = tracer
+		}
+	}
+}
+
+// defaultTracer uses the global tracer provider, which discards spans until one is registered
+func defaultTracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// startSpan starts a span for a board operation
+// The returned context carries the span so repository and S3 calls made with it are nested under it
+func (s *boardServiceImpl) startSpan(ctx context.Context, operation string, boardID uuid.UUID) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{attribute.String("board.operation", operation)}
+	if boardID != uuid.Nil {
+		attrs = append(attrs, attribute.String("board.id", boardID.String()))
+	}
+	return s.tracer.Start(ctx, "BoardService."+operation, trace.WithAttributes(attrs...))
+}
+
+// endSpan records err on span, if any, and ends it
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
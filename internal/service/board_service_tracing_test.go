@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestBoardService_UpdateBoard_Span(t *testing.T) {
+	boardID := uuid.New()
+	title := "Updated Title"
+
+	tests := []struct {
+		name      string
+		updateErr error
+		wantError bool
+	}{
+		{name: "성공: 업데이트 span 기록"},
+		{name: "실패: 업데이트 오류를 span에 기록", updateErr: errors.New("connection reset"), wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			recorder := tracetest.NewSpanRecorder()
+			provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+			var repoCtx context.Context
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return &domain.Board{
+						BaseModel: domain.BaseModel{ID: boardID, CreatedAt: time.Now(), UpdatedAt: time.Now()},
+						Title:     "Old Title",
+					}, nil
+				},
+				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+					repoCtx = ctx
+					return tt.updateErr
+				},
+			}
+			service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+				&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, zap.NewNop(),
+				WithTracer(provider.Tracer("test")))
+
+			// When
+			_, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Title: &title})
+
+			// Then
+			if (err != nil) != tt.wantError {
+				t.Fatalf("UpdateBoard() error = %v, wantError %v", err, tt.wantError)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("ended spans = %d, want 1", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != "BoardService.UpdateBoard" {
+				t.Errorf("span name = %q, want %q", span.Name(), "BoardService.UpdateBoard")
+			}
+
+			attrs := make(map[attribute.Key]string)
+			for _, kv := range span.Attributes() {
+				attrs[kv.Key] = kv.Value.Emit()
+			}
+			if attrs["board.id"] != boardID.String() {
+				t.Errorf("board.id = %q, want %q", attrs["board.id"], boardID.String())
+			}
+			if attrs["board.operation"] != "UpdateBoard" {
+				t.Errorf("board.operation = %q, want %q", attrs["board.operation"], "UpdateBoard")
+			}
+
+			if got := span.SpanContext(); repoCtx == nil || !got.Equal(trace.SpanContextFromContext(repoCtx)) {
+				t.Error("repository call did not receive the span context")
+			}
+
+			if tt.wantError {
+				if span.Status().Code != codes.Error {
+					t.Errorf("span status = %v, want Error", span.Status().Code)
+				}
+				if len(span.Events()) == 0 || span.Events()[0].Name != "exception" {
+					t.Error("span did not record the error event")
+				}
+			} else if span.Status().Code == codes.Error {
+				t.Errorf("span status = %v, want Unset", span.Status().Code)
+			}
+		})
+	}
+}
@@ -2,8 +2,8 @@ package service
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -11,10 +11,37 @@ import (
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
 )
 
-func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (*dto.BoardResponse, error) {
+// UpdateBoard updates a board's attributes
+func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest) (resp *dto.BoardResponse, err error) {
+	ctx, span := s.startSpan(ctx, "UpdateBoard", boardID)
+	defer func() { endSpan(span, err) }()
+
+	return s.updateBoard(ctx, boardID, req, nil)
+}
+
+// updateBoard updates a board whose custom fields may already be converted by a batch conversion
+func (s *boardServiceImpl) updateBoard(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest, converted *convertedCustomFields) (*dto.BoardResponse, error) {
+	update, err := s.updateBoardInTx(ctx, boardID, req, converted)
+	if err != nil {
+		return nil, err
+	}
+	return s.finishBoardUpdate(ctx, update), nil
+}
+
+// boardUpdate is a written board update whose post-commit steps have not run yet
+type boardUpdate struct {
+	board         *domain.Board
+	activities    []*domain.BoardActivity
+	attachmentIDs []uuid.UUID
+}
+
+// updateBoardInTx validates an update and writes it in one transaction, joining the one ctx carries
+// Nothing outside the database is touched, so the caller runs finishBoardUpdate once its transaction has committed
+func (s *boardServiceImpl) updateBoardInTx(ctx context.Context, boardID uuid.UUID, req *dto.UpdateBoardRequest, converted *convertedCustomFields) (*boardUpdate, error) {
 	// Fetch existing board
 	board, err := s.boardRepo.FindByID(ctx, boardID)
 	if err != nil {
@@ -23,30 +50,76 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		}
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
 	}
+	if err := checkNotArchived(board); err != nil {
+		return nil, err
+	}
+
+	if req.ExpectedVersion != nil && *req.ExpectedVersion != board.Version {
+		return nil, response.NewAppError(response.ErrCodeConflict, "Board was modified by someone else",
+			fmt.Sprintf("expected version %d, current version is %d", *req.ExpectedVersion, board.Version))
+	}
 
 	// Determine the effective start and due dates for validation
 	effectiveStartDate := board.StartDate
 	effectiveDueDate := board.DueDate
 
-	if req.StartDate != nil {
-		effectiveStartDate = req.StartDate
+	if req.StartDate.Set {
+		req.StartDate.Value = normalizeBoardDate(req.StartDate.Value)
+		effectiveStartDate = req.StartDate.Value
 	}
-	if req.DueDate != nil {
-		effectiveDueDate = req.DueDate
+	if req.DueDate.Set {
+		req.DueDate.Value = normalizeBoardDate(req.DueDate.Value)
+		effectiveDueDate = req.DueDate.Value
 	}
 
-	// Validate date range with effective dates
+	// Validate date range with effective dates; a cleared date has nothing to compare against
 	if err := validateDateRange(effectiveStartDate, effectiveDueDate); err != nil {
 		return nil, err
 	}
 
+	// Validate effort values
+	if err := validateEffortHours(req.EstimateHours, req.ActualHours); err != nil {
+		return nil, err
+	}
+
+	if req.Title != nil && board.TitleUnique && *req.Title != board.Title {
+		if err := s.checkTitleAvailable(ctx, board.ProjectID, board.ID, *req.Title); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate and confirm attachments if provided
 	if len(req.AttachmentIDs) > 0 {
-		if err := s.validateAndConfirmAttachments(ctx, req.AttachmentIDs, domain.EntityTypeBoard, uuid.Nil); err != nil {
+		if err := s.validateAndConfirmAttachments(ctx, req.AttachmentIDs, domain.EntityTypeBoard, board.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	// Requested participants must be members of the board's project
+	if req.Participants != nil {
+		if err := s.validateParticipantMembers(ctx, board.ProjectID, removeDuplicateUUIDs(req.Participants)); err != nil {
 			return nil, err
 		}
 	}
 
+	// Requested labels must belong to the board's project
+	var labelIDs []uuid.UUID
+	if req.LabelIDs != nil {
+		if s.labelRepo == nil {
+			return nil, response.NewValidationError("Labels are not supported", "")
+		}
+		if labelIDs, err = validateProjectLabels(ctx, s.labelRepo, board.ProjectID, req.LabelIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Capture the audited fields before they change
+	before, err := s.snapshotBoard(ctx, board)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	before.Participants = participantUserIDs(board.Participants)
+
 	// Update fields if provided
 	if req.Title != nil {
 		board.Title = *req.Title
@@ -56,15 +129,15 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 	}
 	if req.CustomFields != nil {
 		// Convert values to IDs
-		convertedFields, err := s.fieldOptionConverter.ConvertValuesToIDs(ctx, board.ProjectID, *req.CustomFields)
+		convertedFields, err := s.convertCustomFields(ctx, board.ProjectID, *req.CustomFields, converted)
 		if err != nil {
-			return nil, response.NewAppError(response.ErrCodeValidation, "Invalid custom field values", err.Error())
+			return nil, customFieldsError(err)
 		}
 
 		// Convert CustomFields to datatypes.JSON
-		jsonBytes, err := json.Marshal(convertedFields)
+		jsonBytes, err := s.marshalCustomFields(convertedFields)
 		if err != nil {
-			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to marshal custom fields", err.Error())
+			return nil, err
 		}
 		board.CustomFields = jsonBytes
 	}
@@ -75,86 +148,92 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 			board.AssigneeID = req.AssigneeID
 		}
 	}
-	if req.StartDate != nil {
-		board.StartDate = req.StartDate
+	if req.StartDate.Set {
+		board.StartDate = req.StartDate.Value
 	}
-	if req.DueDate != nil {
-		board.DueDate = req.DueDate
+	if req.DueDate.Set {
+		board.DueDate = req.DueDate.Value
 	}
-
-	// Update board first
-	if err := s.boardRepo.Update(ctx, board); err != nil {
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
+	if req.EstimateHours != nil {
+		board.EstimateHours = req.EstimateHours
+	}
+	if req.ActualHours != nil {
+		board.ActualHours = req.ActualHours
 	}
 
-	// Attachments 처리 로직 개선 및 Confirm
-	if len(req.AttachmentIDs) > 0 {
-		// 에러 발생 시 업데이트 실패 처리
-		if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, board.ID); err != nil {
-			s.logger.Error("Failed to confirm attachments during board update",
-				zap.String("board_id", board.ID.String()),
-				zap.Strings("attachment_ids", func() []string {
-					ids := make([]string, len(req.AttachmentIDs))
-					for i, id := range req.AttachmentIDs {
-						ids[i] = id.String()
-					}
-					return ids
-				}()),
-				zap.Error(err))
+	after, err := s.snapshotBoard(ctx, board)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to convert custom fields", err.Error())
+	}
+	if req.Participants != nil {
+		after.Participants = removeDuplicateUUIDs(req.Participants)
+	}
+	activities := diffBoardSnapshots(board.ID, actorFromContext(ctx), before, after)
 
-			return nil, response.NewAppError(response.ErrCodeInternal,
-				"Failed to confirm attachments: "+err.Error(),
-				"Please ensure all attachment IDs are valid and not already used")
+	// A new assignee joins the participants; a requested participant set is extended rather than replaced
+	participants := req.Participants
+	currentParticipants := before.Participants
+	if participants != nil {
+		currentParticipants = after.Participants
+	}
+	autoParticipant := assigneeToAutoAdd(before.AssigneeID, board.AssigneeID, currentParticipants)
+	if autoParticipant != nil {
+		activities = append(activities, autoParticipantActivity(board.ID, actorFromContext(ctx), *autoParticipant))
+		if participants != nil {
+			participants = append(append([]uuid.UUID{}, participants...), *autoParticipant)
 		}
 	}
 
-	// ✅ [수정] Participants 업데이트 로직 - board 업데이트 후 처리
-	if req.Participants != nil {
-		// 1. 기존 참여자 모두 조회
-		existingParticipants, err := s.participantRepo.FindByBoardID(ctx, boardID)
-		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-			s.logger.Warn("Failed to fetch existing participants for update",
-				zap.String("board_id", boardID.String()),
-				zap.Error(err))
+	// The board fields, participants, labels, attachment confirmation and activity log are written atomically
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.boardRepo.Update(txCtx, board); err != nil {
+			return boardUpdateError(err)
 		}
-
-		// 2. 기존 참여자 모두 삭제
-		if len(existingParticipants) > 0 {
-			s.logger.Info("Deleting existing participants",
-				zap.String("board_id", boardID.String()),
-				zap.Int("count", len(existingParticipants)))
-
-			for _, p := range existingParticipants {
-				if err := s.participantRepo.Delete(ctx, boardID, p.UserID); err != nil {
-					s.logger.Warn("Failed to delete existing participant",
-						zap.String("board_id", boardID.String()),
-						zap.String("user_id", p.UserID.String()),
-						zap.Error(err))
-				}
+		if participants != nil {
+			if err := s.reconcileParticipants(txCtx, board.ID, participants); err != nil {
+				return err
+			}
+		} else if autoParticipant != nil {
+			if err := s.participantRepo.Create(txCtx, &domain.Participant{BoardID: board.ID, UserID: *autoParticipant}); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to add assignee as participant", err.Error())
 			}
 		}
-
-		// 3. 새로운 참여자 추가
-		if len(req.Participants) > 0 {
-			s.logger.Info("Adding new participants",
-				zap.String("board_id", boardID.String()),
-				zap.Int("count", len(req.Participants)))
-
-			uniqueUserIDs := removeDuplicateUUIDs(req.Participants)
-			for _, userID := range uniqueUserIDs {
-				participant := &domain.Participant{
-					BoardID: boardID,
-					UserID:  userID,
-				}
-				if err := s.participantRepo.Create(ctx, participant); err != nil {
-					s.logger.Warn("Failed to add new participant",
-						zap.String("board_id", boardID.String()),
-						zap.String("user_id", userID.String()),
-						zap.Error(err))
-				}
+		if req.LabelIDs != nil {
+			if err := s.labelRepo.ReplaceForBoard(txCtx, board.ID, labelIDs); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to update labels", err.Error())
 			}
 		}
+		if err := s.attachmentRepo.ConfirmAttachments(txCtx, req.AttachmentIDs, board.ID); err != nil {
+			s.logger.Error("Failed to confirm attachments, rolling back board update",
+				zap.String("board_id", board.ID.String()),
+				zap.Int("attachment_count", len(req.AttachmentIDs)),
+				zap.Error(err))
+			return response.NewAppError(response.ErrCodeInternal,
+				"Failed to confirm attachments: "+err.Error(),
+				"Please ensure all attachment IDs are valid and not already used")
+		}
+		if err := s.boardRepo.AddActivities(txCtx, activities); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to record board activity", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *response.AppError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
 	}
+	return &boardUpdate{board: board, activities: activities, attachmentIDs: req.AttachmentIDs}, nil
+}
+
+// finishBoardUpdate runs the steps of a committed update and returns the reloaded board
+// S3 reads and notifications only happen here, so they never hold up or outlive a rolled back transaction
+func (s *boardServiceImpl) finishBoardUpdate(ctx context.Context, update *boardUpdate) *dto.BoardResponse {
+	board := update.board
+	s.publishBoardUpdate(ctx, board, update.activities)
+	s.evaluateThresholdRules(ctx, board)
+	recordAttachmentChecksums(ctx, s.s3Client, s.attachmentRepo, update.attachmentIDs, s.logger)
 
 	// board와 연결된 모든 Attachments를 다시 조회합니다. (타입 변환 적용)
 	allAttachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeBoard, board.ID)
@@ -164,7 +243,7 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 		board.Attachments = toDomainAttachments(allAttachments)
 	}
 
-	// ✅ [수정] 업데이트된 participants를 다시 로드
+	// 업데이트된 participants와 labels를 다시 로드
 	reloadedBoard, err := s.boardRepo.FindByID(ctx, board.ID)
 	if err != nil {
 		s.logger.Warn("Failed to reload board with participants after update",
@@ -177,7 +256,13 @@ func (s *boardServiceImpl) UpdateBoard(ctx context.Context, boardID uuid.UUID, r
 	}
 
 	// Convert to response DTO
-	return s.toBoardResponse(board), nil
+	return s.toBoardResponse(board)
 }
 
-// DeleteBoard soft deletes a board and its associated attachments
+// boardUpdateError maps a failed boardRepo.Update to an AppError
+func boardUpdateError(err error) error {
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return response.NewAppError(response.ErrCodeConflict, "Board was modified by someone else", "reload the board and retry")
+	}
+	return response.NewAppError(response.ErrCodeInternal, "Failed to update board", err.Error())
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
 )
 
@@ -302,7 +304,7 @@ func TestUpdateBoard_DateValidation(t *testing.T) {
 	ctx := context.Background()
 
 	req := &dto.UpdateBoardRequest{
-		DueDate: &newDueDate,
+		DueDate: dto.OptionalOf(&newDueDate),
 	}
 
 	// Should return validation error
@@ -356,7 +358,7 @@ func TestUpdateBoard_ValidDateUpdate(t *testing.T) {
 	ctx := context.Background()
 
 	req := &dto.UpdateBoardRequest{
-		DueDate: &newDueDate,
+		DueDate: dto.OptionalOf(&newDueDate),
 	}
 
 	// Should succeed
@@ -370,3 +372,275 @@ func TestUpdateBoard_ValidDateUpdate(t *testing.T) {
 		t.Fatal("Expected result, got nil")
 	}
 }
+
+// TestUpdateBoard_ClearDate tests that a null date clears it and skips the range check against it
+func TestUpdateBoard_ClearDate(t *testing.T) {
+	boardID := uuid.New()
+	existingStartDate := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	existingDueDate := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	newDueDate := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) // Before the start date being cleared
+
+	var updated *domain.Board
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return &domain.Board{
+				BaseModel: domain.BaseModel{ID: boardID},
+				ProjectID: uuid.New(),
+				Title:     "Test Board",
+				StartDate: &existingStartDate,
+				DueDate:   &existingDueDate,
+			}, nil
+		},
+		UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+			updated = board
+			return nil
+		},
+	}
+
+	logger, _ := zap.NewDevelopment()
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{}, &MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, logger)
+
+	t.Run("null clears the date", func(t *testing.T) {
+		req := &dto.UpdateBoardRequest{
+			StartDate: dto.Null[time.Time](),
+			DueDate:   dto.OptionalOf(&newDueDate),
+		}
+		if _, err := service.UpdateBoard(context.Background(), boardID, req); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if updated.StartDate != nil {
+			t.Errorf("StartDate = %v, want nil", updated.StartDate)
+		}
+		if updated.DueDate == nil || !updated.DueDate.Equal(newDueDate) {
+			t.Errorf("DueDate = %v, want %v", updated.DueDate, newDueDate)
+		}
+	})
+
+	t.Run("omitted dates are untouched", func(t *testing.T) {
+		title := "Renamed"
+		if _, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Title: &title}); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if updated.StartDate == nil || !updated.StartDate.Equal(existingStartDate) {
+			t.Errorf("StartDate = %v, want %v", updated.StartDate, existingStartDate)
+		}
+		if updated.DueDate == nil || !updated.DueDate.Equal(existingDueDate) {
+			t.Errorf("DueDate = %v, want %v", updated.DueDate, existingDueDate)
+		}
+	})
+}
+
+func TestBoardService_UpdateBoard_Effort(t *testing.T) {
+	boardID := uuid.New()
+	negative := -1.0
+	estimate := 8.0
+	actual := 10.5
+
+	tests := []struct {
+		name         string
+		req          *dto.UpdateBoardRequest
+		wantErr      bool
+		wantVariance *float64
+	}{
+		{
+			name:    "실패: 음수 예상 공수",
+			req:     &dto.UpdateBoardRequest{EstimateHours: &negative},
+			wantErr: true,
+		},
+		{
+			name:    "실패: 음수 실제 공수",
+			req:     &dto.UpdateBoardRequest{ActualHours: &negative},
+			wantErr: true,
+		},
+		{
+			name:         "성공: 예상/실제 공수 설정 시 variance 계산",
+			req:          &dto.UpdateBoardRequest{EstimateHours: &estimate, ActualHours: &actual},
+			wantVariance: floatPtr(2.5),
+		},
+		{
+			name:         "성공: 예상 공수만 있으면 variance 없음",
+			req:          &dto.UpdateBoardRequest{EstimateHours: &estimate},
+			wantVariance: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			var updatedBoard *domain.Board
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					if updatedBoard != nil {
+						return updatedBoard, nil
+					}
+					return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, Title: "Board"}, nil
+				},
+				UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+					updatedBoard = board
+					return nil
+				},
+			}
+			logger, _ := zap.NewDevelopment()
+			service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+				&MockAttachmentRepository{}, &MockS3Client{}, &MockFieldOptionConverter{}, nil, logger)
+
+			// When
+			got, err := service.UpdateBoard(context.Background(), boardID, tt.req)
+
+			// Then
+			if tt.wantErr {
+				appErr, ok := err.(*response.AppError)
+				if !ok || appErr.Code != response.ErrCodeValidation {
+					t.Errorf("UpdateBoard() error = %v, want %v", err, response.ErrCodeValidation)
+				}
+				if updatedBoard != nil {
+					t.Error("UpdateBoard() persisted negative effort")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UpdateBoard() unexpected error = %v", err)
+			}
+			if (got.Variance == nil) != (tt.wantVariance == nil) {
+				t.Fatalf("Variance = %v, want %v", got.Variance, tt.wantVariance)
+			}
+			if tt.wantVariance != nil && *got.Variance != *tt.wantVariance {
+				t.Errorf("Variance = %v, want %v", *got.Variance, *tt.wantVariance)
+			}
+		})
+	}
+}
+
+func TestBoardService_UpdateBoard_VersionConflict(t *testing.T) {
+	boardID := uuid.New()
+	title := "Mine"
+
+	newService := func(updateErr error) (BoardService, *bool) {
+		updated := false
+		mockBoardRepo := &MockBoardRepository{
+			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+				return &domain.Board{BaseModel: domain.BaseModel{ID: boardID}, Title: "Theirs", Version: 4}, nil
+			},
+			UpdateFunc: func(ctx context.Context, board *domain.Board) error {
+				updated = true
+				return updateErr
+			},
+		}
+		return NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+			&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop()), &updated
+	}
+
+	t.Run("stale expectedVersion is rejected before writing", func(t *testing.T) {
+		service, updated := newService(nil)
+		stale := int64(3)
+
+		_, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Title: &title, ExpectedVersion: &stale})
+
+		appErr, ok := err.(*response.AppError)
+		if !ok || appErr.Code != response.ErrCodeConflict {
+			t.Fatalf("expected %s error, got %v", response.ErrCodeConflict, err)
+		}
+		if *updated {
+			t.Error("expected the board not to be written")
+		}
+	})
+
+	t.Run("losing a concurrent save is a conflict", func(t *testing.T) {
+		service, _ := newService(repository.ErrVersionConflict)
+		current := int64(4)
+
+		_, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Title: &title, ExpectedVersion: &current})
+
+		appErr, ok := err.(*response.AppError)
+		if !ok || appErr.Code != response.ErrCodeConflict {
+			t.Fatalf("expected %s error, got %v", response.ErrCodeConflict, err)
+		}
+	})
+}
+
+func TestBoardService_UpdateBoard_ParticipantReconciliation(t *testing.T) {
+	projectID := uuid.New()
+	boardID := uuid.New()
+	staying, leaving, joining := uuid.New(), uuid.New(), uuid.New()
+
+	newService := func(participantRepo *MockParticipantRepository, transactor repository.Transactor) BoardService {
+		mockBoardRepo := &MockBoardRepository{
+			FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+				return &domain.Board{
+					BaseModel:    domain.BaseModel{ID: boardID},
+					ProjectID:    projectID,
+					Title:        "Board",
+					Participants: []domain.Participant{{BoardID: boardID, UserID: staying}, {BoardID: boardID, UserID: leaving}},
+				}, nil
+			},
+		}
+		mockProjectRepo := &MockProjectRepository{
+			FindMembersByProjectIDFunc: func(ctx context.Context, id uuid.UUID) ([]*domain.ProjectMember, error) {
+				return []*domain.ProjectMember{{UserID: staying}, {UserID: leaving}, {UserID: joining}}, nil
+			},
+		}
+		participantRepo.FindByBoardIDFunc = func(ctx context.Context, id uuid.UUID) ([]*domain.Participant, error) {
+			return []*domain.Participant{{BoardID: boardID, UserID: staying}, {BoardID: boardID, UserID: leaving}}, nil
+		}
+		return NewBoardService(mockBoardRepo, mockProjectRepo, &MockFieldOptionRepository{}, participantRepo,
+			&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(), WithTransactor(transactor))
+	}
+
+	t.Run("only the difference is applied", func(t *testing.T) {
+		var added, removed []uuid.UUID
+		participantRepo := &MockParticipantRepository{
+			CreateFunc: func(ctx context.Context, participant *domain.Participant) error {
+				added = append(added, participant.UserID)
+				return nil
+			},
+			DeleteFunc: func(ctx context.Context, id, userID uuid.UUID) error {
+				removed = append(removed, userID)
+				return nil
+			},
+		}
+		service := newService(participantRepo, &recordingTransactor{})
+
+		if _, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Participants: []uuid.UUID{staying, joining}}); err != nil {
+			t.Fatalf("UpdateBoard() unexpected error = %v", err)
+		}
+		if len(added) != 1 || added[0] != joining || len(removed) != 1 || removed[0] != leaving {
+			t.Errorf("added %v, removed %v; want [%s] and [%s]", added, removed, joining, leaving)
+		}
+	})
+
+	t.Run("a failed add rolls back the update", func(t *testing.T) {
+		participantRepo := &MockParticipantRepository{
+			CreateFunc: func(ctx context.Context, participant *domain.Participant) error {
+				return errors.New("connection reset")
+			},
+		}
+		transactor := &recordingTransactor{}
+		service := newService(participantRepo, transactor)
+
+		_, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Participants: []uuid.UUID{staying, joining}})
+		if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeInternal {
+			t.Fatalf("UpdateBoard() error = %v, want %s", err, response.ErrCodeInternal)
+		}
+		if transactor.calls != 1 || !transactor.rolledBack {
+			t.Errorf("expected the update transaction to roll back, calls=%d rolledBack=%v", transactor.calls, transactor.rolledBack)
+		}
+	})
+
+	t.Run("an unknown user is rejected", func(t *testing.T) {
+		transactor := &recordingTransactor{}
+		service := newService(&MockParticipantRepository{}, transactor)
+		stranger := uuid.New()
+
+		_, err := service.UpdateBoard(context.Background(), boardID, &dto.UpdateBoardRequest{Participants: []uuid.UUID{staying, stranger}})
+		appErr, ok := err.(*response.AppError)
+		if !ok || appErr.Code != response.ErrCodeValidation {
+			t.Fatalf("UpdateBoard() error = %v, want %s", err, response.ErrCodeValidation)
+		}
+		if _, named := appErr.Fields[stranger.String()]; !named || len(appErr.Fields) != 1 {
+			t.Errorf("error fields = %v, want only %s", appErr.Fields, stranger)
+		}
+		if transactor.calls != 0 {
+			t.Errorf("expected nothing to be written, got %d transactions", transactor.calls)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"project-board-api/internal/domain"
+)
+
+// WebhookPublisher queues board events for delivery to webhook subscribers
+// Publish must not block; delivery happens in the background
+type WebhookPublisher interface {
+	Publish(event domain.WebhookEvent)
+}
+
+// WithWebhookPublisher notifies webhook subscribers when boards are created, updated or deleted
+func WithWebhookPublisher(publisher WebhookPublisher) BoardServiceOption {
+	return func(s *boardServiceImpl) {
+		s.webhooks = publisher
+	}
+}
+
+// publishBoardEvent hands a board event to the webhook publisher, if one is configured
+// It is called once the change has committed, so subscribers never hear of a rolled back change
+func (s *boardServiceImpl) publishBoardEvent(ctx context.Context, eventType domain.WebhookEventType, board *domain.Board, changedFields []string) {
+	if s.webhooks == nil {
+		return
+	}
+	event := domain.WebhookEvent{
+		Type:          eventType,
+		ProjectID:     board.ProjectID,
+		BoardID:       board.ID,
+		ChangedFields: changedFields,
+		ActorID:       actorFromContext(ctx),
+		OccurredAt:    time.Now().UTC(),
+	}
+	s.queueWebhookEvent(ctx, event)
+}
+
+// queueWebhookEvent publishes event, or holds it back while ctx collects events of an uncommitted transaction
+func (s *boardServiceImpl) queueWebhookEvent(ctx context.Context, event domain.WebhookEvent) {
+	if s.webhooks == nil {
+		return
+	}
+	if buffer, ok := ctx.Value(boardEventBufferKey{}).(*boardEventBuffer); ok {
+		buffer.events = append(buffer.events, event)
+		return
+	}
+	s.webhooks.Publish(event)
+}
+
+// publishBoardUpdate publishes board.updated with the fields named by the update's activity entries
+// An update that changed nothing is not published
+func (s *boardServiceImpl) publishBoardUpdate(ctx context.Context, board *domain.Board, activities []*domain.BoardActivity) {
+	if len(activities) == 0 {
+		return
+	}
+
+	fields := make([]string, 0, len(activities))
+	seen := make(map[string]bool, len(activities))
+	for _, activity := range activities {
+		if !seen[activity.Field] {
+			seen[activity.Field] = true
+			fields = append(fields, activity.Field)
+		}
+	}
+	s.publishBoardEvent(ctx, domain.WebhookEventBoardUpdated, board, fields)
+}
+
+// boardEventBufferKey carries a boardEventBuffer through a context
+type boardEventBufferKey struct{}
+
+// boardEventBuffer holds back board events until the transaction they belong to has committed
+type boardEventBuffer struct {
+	events []domain.WebhookEvent
+}
+
+// withBoardEventBuffer returns a context whose board events are collected instead of published
+// Used when several changes share one outer transaction, so a rolled back change is never announced
+func withBoardEventBuffer(ctx context.Context) (context.Context, *boardEventBuffer) {
+	buffer := &boardEventBuffer{}
+	return context.WithValue(ctx, boardEventBufferKey{}, buffer), buffer
+}
+
+// publishBufferedEvents publishes the events collected in buffer
+func (s *boardServiceImpl) publishBufferedEvents(buffer *boardEventBuffer) {
+	if s.webhooks == nil {
+		return
+	}
+	for _, event := range buffer.events {
+		s.webhooks.Publish(event)
+	}
+}
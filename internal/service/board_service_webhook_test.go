@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+// recordingPublisher collects published webhook events
+type recordingPublisher struct {
+	events []domain.WebhookEvent
+}
+
+func (p *recordingPublisher) Publish(event domain.WebhookEvent) {
+	p.events = append(p.events, event)
+}
+
+func TestBoardService_UpdateBoard_PublishesWebhookEvent(t *testing.T) {
+	boardID := uuid.New()
+	projectID := uuid.New()
+	actorID := uuid.New()
+
+	board := &domain.Board{
+		BaseModel: domain.BaseModel{ID: boardID},
+		ProjectID: projectID,
+		Title:     "Old title",
+		Content:   "Same content",
+	}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+		UpdateFunc: func(ctx context.Context, updated *domain.Board) error {
+			board = updated
+			return nil
+		},
+	}
+	publisher := &recordingPublisher{}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(), WithWebhookPublisher(publisher))
+	ctx := context.WithValue(context.Background(), "user_id", actorID)
+
+	sameContent := "Same content"
+	if _, err := service.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{Content: &sameContent}); err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+	if len(publisher.events) != 0 {
+		t.Fatalf("expected no event for an update that changed nothing, got %d", len(publisher.events))
+	}
+
+	newTitle := "New title"
+	if _, err := service.UpdateBoard(ctx, boardID, &dto.UpdateBoardRequest{Title: &newTitle, Content: &sameContent}); err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+	if len(publisher.events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(publisher.events))
+	}
+	event := publisher.events[0]
+	if event.Type != domain.WebhookEventBoardUpdated {
+		t.Errorf("event type = %s, want %s", event.Type, domain.WebhookEventBoardUpdated)
+	}
+	if event.BoardID != boardID || event.ProjectID != projectID {
+		t.Errorf("event board/project = %s/%s, want %s/%s", event.BoardID, event.ProjectID, boardID, projectID)
+	}
+	if event.ActorID != actorID {
+		t.Errorf("event actor = %s, want %s", event.ActorID, actorID)
+	}
+	if len(event.ChangedFields) != 1 || event.ChangedFields[0] != "title" {
+		t.Errorf("event changed fields = %v, want [title]", event.ChangedFields)
+	}
+}
+
+func TestBoardService_DeleteBoard_PublishesWebhookEvent(t *testing.T) {
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New()}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return board, nil
+		},
+	}
+	publisher := &recordingPublisher{}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(), WithWebhookPublisher(publisher))
+
+	if err := service.DeleteBoard(context.Background(), board.ID); err != nil {
+		t.Fatalf("DeleteBoard() unexpected error = %v", err)
+	}
+	if len(publisher.events) != 1 || publisher.events[0].Type != domain.WebhookEventBoardDeleted {
+		t.Fatalf("expected one board.deleted event, got %v", publisher.events)
+	}
+	if publisher.events[0].BoardID != board.ID {
+		t.Errorf("event board = %s, want %s", publisher.events[0].BoardID, board.ID)
+	}
+}
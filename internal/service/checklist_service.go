@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// ChecklistService defines the interface for board checklist business logic
+type ChecklistService interface {
+	AddChecklistItem(ctx context.Context, boardID uuid.UUID, req *dto.CreateChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	UpdateChecklistItem(ctx context.Context, itemID uuid.UUID, req *dto.UpdateChecklistItemRequest) (*dto.ChecklistItemResponse, error)
+	ReorderChecklistItems(ctx context.Context, boardID uuid.UUID, itemIDs []uuid.UUID) ([]dto.ChecklistItemResponse, error)
+	DeleteChecklistItem(ctx context.Context, itemID uuid.UUID) error
+}
+
+// checklistServiceImpl is the implementation of ChecklistService
+type checklistServiceImpl struct {
+	checklistRepo repository.ChecklistItemRepository
+	boardRepo     repository.BoardRepository
+}
+
+// NewChecklistService creates a new instance of ChecklistService
+func NewChecklistService(checklistRepo repository.ChecklistItemRepository, boardRepo repository.BoardRepository) ChecklistService {
+	return &checklistServiceImpl{
+		checklistRepo: checklistRepo,
+		boardRepo:     boardRepo,
+	}
+}
+
+// AddChecklistItem appends an item to a board's checklist
+func (s *checklistServiceImpl) AddChecklistItem(ctx context.Context, boardID uuid.UUID, req *dto.CreateChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return nil, response.NewFieldValidationError("Checklist item text is required", map[string]string{"text": "must not be blank"})
+	}
+
+	if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+
+	item := &domain.ChecklistItem{BoardID: boardID, Text: text}
+	if err := s.checklistRepo.Create(ctx, item); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to add checklist item", err.Error())
+	}
+
+	resp := toChecklistItemResponse(item)
+	return &resp, nil
+}
+
+// UpdateChecklistItem changes the text or done state of an item
+func (s *checklistServiceImpl) UpdateChecklistItem(ctx context.Context, itemID uuid.UUID, req *dto.UpdateChecklistItemRequest) (*dto.ChecklistItemResponse, error) {
+	item, err := s.findItem(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Text != nil {
+		text := strings.TrimSpace(*req.Text)
+		if text == "" {
+			return nil, response.NewFieldValidationError("Checklist item text is required", map[string]string{"text": "must not be blank"})
+		}
+		item.Text = text
+	}
+	if req.Done != nil {
+		item.Done = *req.Done
+	}
+
+	if err := s.checklistRepo.Update(ctx, item); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update checklist item", err.Error())
+	}
+
+	resp := toChecklistItemResponse(item)
+	return &resp, nil
+}
+
+// ReorderChecklistItems stores a new order for a board's checklist and returns the items in that order
+func (s *checklistServiceImpl) ReorderChecklistItems(ctx context.Context, boardID uuid.UUID, itemIDs []uuid.UUID) ([]dto.ChecklistItemResponse, error) {
+	if err := s.checklistRepo.Reorder(ctx, boardID, itemIDs); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		if errors.Is(err, repository.ErrChecklistOrderMismatch) {
+			return nil, response.NewFieldValidationError("Invalid checklist order", map[string]string{
+				"itemIds": "must list every checklist item of the board exactly once",
+			})
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to reorder checklist", err.Error())
+	}
+
+	items, err := s.checklistRepo.FindByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch checklist", err.Error())
+	}
+
+	responses := make([]dto.ChecklistItemResponse, len(items))
+	for i, item := range items {
+		responses[i] = toChecklistItemResponse(item)
+	}
+	return responses, nil
+}
+
+// DeleteChecklistItem removes an item from its board's checklist
+func (s *checklistServiceImpl) DeleteChecklistItem(ctx context.Context, itemID uuid.UUID) error {
+	if _, err := s.findItem(ctx, itemID); err != nil {
+		return err
+	}
+
+	if err := s.checklistRepo.Delete(ctx, itemID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete checklist item", err.Error())
+	}
+	return nil
+}
+
+func (s *checklistServiceImpl) findItem(ctx context.Context, itemID uuid.UUID) (*domain.ChecklistItem, error) {
+	item, err := s.checklistRepo.FindByID(ctx, itemID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Checklist item not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch checklist item", err.Error())
+	}
+	return item, nil
+}
+
+// toChecklistItemResponse converts domain.ChecklistItem to dto.ChecklistItemResponse
+func toChecklistItemResponse(item *domain.ChecklistItem) dto.ChecklistItemResponse {
+	return dto.ChecklistItemResponse{
+		ID:        item.ID,
+		BoardID:   item.BoardID,
+		Text:      item.Text,
+		Done:      item.Done,
+		Position:  item.Position,
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+// checklistProgress counts the done items of a checklist, e.g. "3/5 done"
+func checklistProgress(items []domain.ChecklistItem) dto.ChecklistProgressResponse {
+	done := 0
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+	return dto.ChecklistProgressResponse{
+		Done:    done,
+		Total:   len(items),
+		Summary: fmt.Sprintf("%d/%d done", done, len(items)),
+	}
+}
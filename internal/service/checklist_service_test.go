@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+func TestChecklistService_UpdateChecklistItem(t *testing.T) {
+	item := &domain.ChecklistItem{BaseModel: domain.BaseModel{ID: uuid.New()}, BoardID: uuid.New(), Text: "build", Position: 2}
+	var saved *domain.ChecklistItem
+	mockRepo := &MockChecklistItemRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.ChecklistItem, error) {
+			copied := *item
+			return &copied, nil
+		},
+		UpdateFunc: func(ctx context.Context, item *domain.ChecklistItem) error {
+			saved = item
+			return nil
+		},
+	}
+	service := NewChecklistService(mockRepo, &MockBoardRepository{})
+
+	done := true
+	resp, err := service.UpdateChecklistItem(context.Background(), item.ID, &dto.UpdateChecklistItemRequest{Done: &done})
+	if err != nil {
+		t.Fatalf("UpdateChecklistItem() unexpected error = %v", err)
+	}
+	// Omitted fields keep their value
+	if !saved.Done || saved.Text != "build" || resp.Position != 2 {
+		t.Errorf("saved item = %+v, want done with text and position unchanged", saved)
+	}
+
+	blank := "  "
+	_, err = service.UpdateChecklistItem(context.Background(), item.ID, &dto.UpdateChecklistItemRequest{Text: &blank})
+	if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+		t.Errorf("UpdateChecklistItem() error = %v, want %s", err, response.ErrCodeValidation)
+	}
+}
+
+func TestChecklistService_ReorderChecklistItems(t *testing.T) {
+	mockRepo := &MockChecklistItemRepository{
+		ReorderFunc: func(ctx context.Context, boardID uuid.UUID, itemIDs []uuid.UUID) error {
+			return repository.ErrChecklistOrderMismatch
+		},
+	}
+	service := NewChecklistService(mockRepo, &MockBoardRepository{})
+
+	_, err := service.ReorderChecklistItems(context.Background(), uuid.New(), []uuid.UUID{uuid.New()})
+	appErr, ok := err.(*response.AppError)
+	if !ok || appErr.Code != response.ErrCodeValidation {
+		t.Fatalf("ReorderChecklistItems() error = %v, want %s", err, response.ErrCodeValidation)
+	}
+	if appErr.Fields["itemIds"] == "" {
+		t.Errorf("fields = %v, want an itemIds entry", appErr.Fields)
+	}
+}
+
+func TestChecklistProgress(t *testing.T) {
+	items := []domain.ChecklistItem{{Done: true}, {Done: false}, {Done: true}, {Done: true}, {Done: false}}
+	if got := checklistProgress(items); got.Done != 3 || got.Total != 5 || got.Summary != "3/5 done" {
+		t.Errorf("checklistProgress() = %+v, want 3/5 done", got)
+	}
+	if got := checklistProgress(nil); got.Summary != "0/0 done" {
+		t.Errorf("checklistProgress(nil) = %+v, want 0/0 done", got)
+	}
+}
@@ -20,6 +20,9 @@ type CommentService interface {
 	GetComments(ctx context.Context, boardID uuid.UUID) ([]*dto.CommentResponse, error)
 	UpdateComment(ctx context.Context, commentID uuid.UUID, req *dto.UpdateCommentRequest) (*dto.CommentResponse, error)
 	DeleteComment(ctx context.Context, commentID uuid.UUID) error
+	PinComment(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error)
+	UnpinComment(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error)
+	GetCommentHistory(ctx context.Context, commentID uuid.UUID) ([]*dto.CommentRevisionResponse, error)
 }
 
 // commentServiceImpl is the implementation of CommentService
@@ -29,17 +32,57 @@ type commentServiceImpl struct {
 	attachmentRepo repository.AttachmentRepository
 	s3Client       S3Client
 	logger         *zap.Logger
+
+	// allowMultiplePins keeps earlier pins when another comment is pinned
+	allowMultiplePins bool
+	// transactor makes a comment edit and its history entry atomic
+	transactor repository.Transactor
+	// deleteQueue schedules attachment deletions durably; when nil they run inline
+	deleteQueue repository.AttachmentDeleteJobRepository
+}
+
+// CommentServiceOption configures optional CommentService behaviour
+type CommentServiceOption func(*commentServiceImpl)
+
+// WithMultiplePinnedComments allows several pinned comments per board
+// By default pinning a comment unpins the board's previously pinned one
+func WithMultiplePinnedComments(allow bool) CommentServiceOption {
+	return func(s *commentServiceImpl) {
+		s.allowMultiplePins = allow
+	}
+}
+
+// WithCommentTransactor sets the Transactor used to record edit history atomically with the edit
+func WithCommentTransactor(transactor repository.Transactor) CommentServiceOption {
+	return func(s *commentServiceImpl) {
+		if transactor != nil {
+			s.transactor = transactor
+		}
+	}
+}
+
+// WithCommentAttachmentDeleteQueue deletes the attachments of deleted comments through the durable outbox
+// The jobs are enqueued in the same transaction as the comment deletion
+func WithCommentAttachmentDeleteQueue(queue repository.AttachmentDeleteJobRepository) CommentServiceOption {
+	return func(s *commentServiceImpl) {
+		s.deleteQueue = queue
+	}
 }
 
 // NewCommentService creates a new instance of CommentService
-func NewCommentService(commentRepo repository.CommentRepository, boardRepo repository.BoardRepository, attachmentRepo repository.AttachmentRepository, s3Client S3Client, logger *zap.Logger) CommentService {
-	return &commentServiceImpl{
+func NewCommentService(commentRepo repository.CommentRepository, boardRepo repository.BoardRepository, attachmentRepo repository.AttachmentRepository, s3Client S3Client, logger *zap.Logger, opts ...CommentServiceOption) CommentService {
+	s := &commentServiceImpl{
 		commentRepo:    commentRepo,
 		boardRepo:      boardRepo,
 		attachmentRepo: attachmentRepo,
 		s3Client:       s3Client,
 		logger:         logger,
+		transactor:     noTransaction{},
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 // CreateComment creates a new comment on a board
@@ -100,6 +143,8 @@ func (s *commentServiceImpl) CreateComment(ctx context.Context, userID uuid.UUID
 				"Please ensure all attachment IDs are valid and not already used")
 		}
 
+		recordAttachmentChecksums(ctx, s.s3Client, s.attachmentRepo, req.AttachmentIDs, s.logger)
+
 		// Confirm 후 Attachments 메타데이터를 조회하여 comment 객체에 할당
 		attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
 		if err != nil {
@@ -169,11 +214,20 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 		}
 	}
 
-	// Update content
+	// Update content, keeping the replaced body in the comment's history
+	previousContent := comment.Content
 	comment.Content = req.Content
 
-	// Save updated comment
-	if err := s.commentRepo.Update(ctx, comment); err != nil {
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if previousContent != comment.Content {
+			revision := &domain.CommentRevision{CommentID: comment.ID, Content: previousContent}
+			if err := s.commentRepo.AddRevision(txCtx, revision); err != nil {
+				return err
+			}
+		}
+		return s.commentRepo.Update(txCtx, comment)
+	})
+	if err != nil {
 		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update comment", err.Error())
 	}
 
@@ -196,6 +250,7 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 				"Failed to confirm attachments: "+err.Error(),
 				"Please ensure all attachment IDs are valid and not already used")
 		}
+		recordAttachmentChecksums(ctx, s.s3Client, s.attachmentRepo, req.AttachmentIDs, s.logger)
 	}
 
 	// comment와 연결된 모든 Attachments를 다시 조회합니다. (타입 변환 적용)
@@ -212,6 +267,33 @@ func (s *commentServiceImpl) UpdateComment(ctx context.Context, commentID uuid.U
 	return s.toCommentResponse(comment), nil
 }
 
+// GetCommentHistory returns the previous bodies of a comment, oldest first
+// The current body is not part of the history; it stays on the comment
+func (s *commentServiceImpl) GetCommentHistory(ctx context.Context, commentID uuid.UUID) ([]*dto.CommentRevisionResponse, error) {
+	if _, err := s.commentRepo.FindByID(ctx, commentID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Comment not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch comment", err.Error())
+	}
+
+	revisions, err := s.commentRepo.FindRevisionsByCommentID(ctx, commentID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch comment history", err.Error())
+	}
+
+	responses := make([]*dto.CommentRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		responses[i] = &dto.CommentRevisionResponse{
+			RevisionID: revision.ID,
+			CommentID:  revision.CommentID,
+			Content:    revision.Content,
+			EditedAt:   revision.CreatedAt,
+		}
+	}
+	return responses, nil
+}
+
 // DeleteComment soft deletes a comment and its associated attachments
 func (s *commentServiceImpl) DeleteComment(ctx context.Context, commentID uuid.UUID) error {
 	// Verify comment exists
@@ -232,17 +314,67 @@ func (s *commentServiceImpl) DeleteComment(ctx context.Context, commentID uuid.U
 		// Continue with comment deletion even if attachment fetch fails
 	}
 
-	// Delete attachments from S3 and database
-	if len(attachments) > 0 {
-		s.deleteAttachmentsWithS3(ctx, attachments)
+	if s.deleteQueue == nil {
+		// Delete attachments from S3 and database
+		if len(attachments) > 0 {
+			s.deleteAttachmentsWithS3(ctx, attachments)
+		}
+
+		// Delete comment
+		if err := s.commentRepo.Delete(ctx, commentID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to delete comment", err.Error())
+		}
+		return nil
 	}
 
-	// Delete comment
-	if err := s.commentRepo.Delete(ctx, commentID); err != nil {
-		return response.NewAppError(response.ErrCodeInternal, "Failed to delete comment", err.Error())
+	// The worker only sees the jobs once the comment deletion has committed
+	return s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.deleteQueue.Enqueue(txCtx, newAttachmentDeleteJobs(attachments, s.logger)); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to schedule attachment deletion", err.Error())
+		}
+		if err := s.commentRepo.Delete(txCtx, commentID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to delete comment", err.Error())
+		}
+		return nil
+	})
+}
+
+// PinComment pins a comment to the top of its board's comment list
+func (s *commentServiceImpl) PinComment(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error) {
+	return s.setCommentPinned(ctx, commentID, true)
+}
+
+// UnpinComment removes a comment's pin
+func (s *commentServiceImpl) UnpinComment(ctx context.Context, commentID uuid.UUID) (*dto.CommentResponse, error) {
+	return s.setCommentPinned(ctx, commentID, false)
+}
+
+// setCommentPinned pins or unpins a comment and returns it with its attachments
+func (s *commentServiceImpl) setCommentPinned(ctx context.Context, commentID uuid.UUID, pinned bool) (*dto.CommentResponse, error) {
+	comment, err := s.commentRepo.FindByID(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Comment not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch comment", err.Error())
+	}
+
+	if pinned {
+		err = s.commentRepo.Pin(ctx, comment, !s.allowMultiplePins)
+	} else {
+		err = s.commentRepo.Unpin(ctx, comment)
+	}
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update comment pin", err.Error())
 	}
 
-	return nil
+	attachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeComment, comment.ID)
+	if err != nil {
+		s.logger.Warn("Failed to fetch attachments for pinned comment", zap.String("comment_id", comment.ID.String()), zap.Error(err))
+	}
+	comment.Attachments = toDomainAttachments(attachments)
+
+	return s.toCommentResponse(comment), nil
 }
 
 // toCommentResponse converts domain.Comment to dto.CommentResponse
@@ -254,13 +386,16 @@ func (s *commentServiceImpl) toCommentResponse(comment *domain.Comment) *dto.Com
 		fileURL := s.s3Client.GetFileURL(a.FileURL)
 
 		attachments = append(attachments, dto.AttachmentResponse{
-			ID:          a.ID,
-			FileName:    a.FileName,
-			FileURL:     fileURL, // full URL 반환
-			FileSize:    a.FileSize,
-			ContentType: a.ContentType,
-			UploadedBy:  a.UploadedBy,
-			UploadedAt:  a.CreatedAt,
+			ID:             a.ID,
+			FileName:       a.FileName,
+			FileURL:        fileURL, // full URL 반환
+			FileSize:       a.FileSize,
+			ContentType:    a.ContentType,
+			UploadedBy:     a.UploadedBy,
+			UploadedAt:     a.CreatedAt,
+			ChecksumSHA256: a.ChecksumSHA256,
+			DownloadCount:  a.DownloadCount,
+			Visibility:     string(a.Visibility),
 		})
 	}
 
@@ -270,6 +405,8 @@ func (s *commentServiceImpl) toCommentResponse(comment *domain.Comment) *dto.Com
 		UserID:      comment.UserID,
 		Content:     comment.Content,
 		Attachments: attachments,
+		IsPinned:    comment.PinnedAt != nil,
+		PinnedAt:    comment.PinnedAt,
 		CreatedAt:   comment.CreatedAt,
 		UpdatedAt:   comment.UpdatedAt,
 	}
@@ -277,35 +414,7 @@ func (s *commentServiceImpl) toCommentResponse(comment *domain.Comment) *dto.Com
 
 // validateAndConfirmAttachments validates that attachments exist and are in TEMP status
 func (s *commentServiceImpl) validateAndConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityType domain.EntityType) error {
-	if len(attachmentIDs) == 0 {
-		return nil
-	}
-
-	// Fetch attachments by IDs
-	attachments, err := s.attachmentRepo.FindByIDs(ctx, attachmentIDs)
-	if err != nil {
-		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachments", err.Error())
-	}
-
-	// Check if all attachments exist
-	if len(attachments) != len(attachmentIDs) {
-		return response.NewAppError(response.ErrCodeValidation, "One or more attachments not found", "")
-	}
-
-	// Validate each attachment
-	for _, attachment := range attachments {
-		// Check if attachment is in TEMP status
-		if attachment.Status != domain.AttachmentStatusTemp {
-			return response.NewAppError(response.ErrCodeValidation, "Attachment is not in temporary status and cannot be reused", "")
-		}
-
-		// Check if attachment entity type matches
-		if attachment.EntityType != entityType {
-			return response.NewAppError(response.ErrCodeValidation, "Attachment entity type does not match", "")
-		}
-	}
-
-	return nil
+	return validateAttachmentsForConfirmation(ctx, s.attachmentRepo, s.s3Client, attachmentIDs, entityType, uuid.Nil)
 }
 
 // deleteAttachmentsWithS3 deletes attachments from both S3 and database
@@ -435,6 +435,217 @@ func TestCommentService_DeleteComment(t *testing.T) {
 	}
 }
 
+func TestCommentService_DeleteComment_RemovesAttachments(t *testing.T) {
+	// Given
+	commentID := uuid.New()
+	attachmentID := uuid.New()
+	fileURL := "https://bucket.s3.ap-northeast-2.amazonaws.com/comments/file.png"
+
+	mockCommentRepo := &MockCommentRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+			return &domain.Comment{BaseModel: domain.BaseModel{ID: commentID}}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			return nil
+		},
+	}
+	var deletedIDs []uuid.UUID
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+			if entityType != domain.EntityTypeComment || entityID != commentID {
+				t.Errorf("FindByEntityID(%v, %v), want comment %v", entityType, entityID, commentID)
+			}
+			return []*domain.Attachment{{
+				BaseModel:  domain.BaseModel{ID: attachmentID},
+				EntityType: domain.EntityTypeComment,
+				EntityID:   &commentID,
+				Status:     domain.AttachmentStatusConfirmed,
+				FileURL:    fileURL,
+			}}, nil
+		},
+		DeleteBatchFunc: func(ctx context.Context, ids []uuid.UUID) error {
+			deletedIDs = ids
+			return nil
+		},
+	}
+	var deletedKeys []string
+	mockS3Client := &MockS3Client{
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			deletedKeys = append(deletedKeys, key)
+			return nil
+		},
+	}
+
+	logger, _ := zap.NewDevelopment()
+	service := NewCommentService(mockCommentRepo, &MockBoardRepository{}, mockAttachmentRepo, mockS3Client, logger)
+
+	// When
+	if err := service.DeleteComment(context.Background(), commentID); err != nil {
+		t.Fatalf("DeleteComment() unexpected error = %v", err)
+	}
+
+	// Then
+	if len(deletedKeys) != 1 || deletedKeys[0] != "comments/file.png" {
+		t.Errorf("deleted S3 keys = %v, want [comments/file.png]", deletedKeys)
+	}
+	if len(deletedIDs) != 1 || deletedIDs[0] != attachmentID {
+		t.Errorf("deleted attachment IDs = %v, want [%v]", deletedIDs, attachmentID)
+	}
+}
+
+func TestCommentService_DeleteComment_QueuesAttachmentDeletion(t *testing.T) {
+	// Given
+	commentID := uuid.New()
+	attachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "comments/file.png"}
+
+	deleted := false
+	mockCommentRepo := &MockCommentRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+			return &domain.Comment{BaseModel: domain.BaseModel{ID: commentID}}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			deleted = inRecordedTransaction(ctx)
+			return nil
+		},
+	}
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+			return []*domain.Attachment{attachment}, nil
+		},
+	}
+	s3Called := false
+	mockS3Client := &MockS3Client{
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			s3Called = true
+			return nil
+		},
+	}
+	var queued []*domain.AttachmentDeleteJob
+	queue := &MockAttachmentDeleteJobRepository{
+		EnqueueFunc: func(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error {
+			if !inRecordedTransaction(ctx) {
+				t.Error("Enqueue() ran outside the transaction")
+			}
+			queued = jobs
+			return nil
+		},
+	}
+
+	service := NewCommentService(mockCommentRepo, &MockBoardRepository{}, mockAttachmentRepo, mockS3Client, zap.NewNop(),
+		WithCommentTransactor(&recordingTransactor{}), WithCommentAttachmentDeleteQueue(queue))
+
+	// When
+	if err := service.DeleteComment(context.Background(), commentID); err != nil {
+		t.Fatalf("DeleteComment() unexpected error = %v", err)
+	}
+
+	// Then
+	if !deleted {
+		t.Error("DeleteComment() did not delete the comment in the transaction")
+	}
+	if len(queued) != 1 || queued[0].AttachmentID != attachment.ID || queued[0].FileKey != "comments/file.png" {
+		t.Errorf("queued jobs = %+v, want one job for the comment attachment", queued)
+	}
+	if s3Called {
+		t.Error("DeleteComment() deleted from S3 inline instead of through the queue")
+	}
+}
+
+func TestCommentService_PinComment(t *testing.T) {
+	tests := []struct {
+		name            string
+		opts            []CommentServiceOption
+		wantUnpinOthers bool
+	}{
+		{name: "성공: 기본 설정은 기존 고정 해제", wantUnpinOthers: true},
+		{name: "성공: 다중 고정 허용 시 기존 고정 유지", opts: []CommentServiceOption{WithMultiplePinnedComments(true)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			commentID := uuid.New()
+			var gotUnpinOthers *bool
+			mockCommentRepo := &MockCommentRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+					return &domain.Comment{BaseModel: domain.BaseModel{ID: id}, BoardID: uuid.New()}, nil
+				},
+				PinFunc: func(ctx context.Context, comment *domain.Comment, unpinOthers bool) error {
+					gotUnpinOthers = &unpinOthers
+					now := time.Now()
+					comment.PinnedAt = &now
+					return nil
+				},
+			}
+			service := NewCommentService(mockCommentRepo, &MockBoardRepository{}, &MockAttachmentRepository{}, &MockS3Client{}, zap.NewNop(), tt.opts...)
+
+			// When
+			got, err := service.PinComment(context.Background(), commentID)
+
+			// Then
+			if err != nil {
+				t.Fatalf("PinComment() unexpected error = %v", err)
+			}
+			if !got.IsPinned || got.PinnedAt == nil {
+				t.Errorf("PinComment() IsPinned = %v, PinnedAt = %v, want pinned", got.IsPinned, got.PinnedAt)
+			}
+			if gotUnpinOthers == nil || *gotUnpinOthers != tt.wantUnpinOthers {
+				t.Errorf("Pin() unpinOthers = %v, want %v", gotUnpinOthers, tt.wantUnpinOthers)
+			}
+		})
+	}
+}
+
+func TestCommentService_UpdateComment_RecordsHistory(t *testing.T) {
+	// Given: a comment store that keeps appended revisions
+	comment := &domain.Comment{
+		BaseModel: domain.BaseModel{ID: uuid.New()},
+		BoardID:   uuid.New(),
+		UserID:    uuid.New(),
+		Content:   "v1",
+	}
+	var revisions []*domain.CommentRevision
+	mockCommentRepo := &MockCommentRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+			stored := *comment
+			return &stored, nil
+		},
+		UpdateFunc: func(ctx context.Context, updated *domain.Comment) error {
+			*comment = *updated
+			return nil
+		},
+		AddRevisionFunc: func(ctx context.Context, revision *domain.CommentRevision) error {
+			revision.ID = uuid.New()
+			revisions = append(revisions, revision)
+			return nil
+		},
+		FindRevisionsByCommentIDFunc: func(ctx context.Context, commentID uuid.UUID) ([]*domain.CommentRevision, error) {
+			return revisions, nil
+		},
+	}
+	service := NewCommentService(mockCommentRepo, &MockBoardRepository{}, &MockAttachmentRepository{}, &MockS3Client{}, zap.NewNop())
+	ctx := context.Background()
+
+	// When: the comment is edited twice
+	for _, content := range []string{"v2", "v3"} {
+		if _, err := service.UpdateComment(ctx, comment.ID, &dto.UpdateCommentRequest{Content: content}); err != nil {
+			t.Fatalf("UpdateComment(%q) unexpected error = %v", content, err)
+		}
+	}
+	history, err := service.GetCommentHistory(ctx, comment.ID)
+
+	// Then
+	if err != nil {
+		t.Fatalf("GetCommentHistory() unexpected error = %v", err)
+	}
+	if len(history) != 2 || history[0].Content != "v1" || history[1].Content != "v2" {
+		t.Fatalf("history = %+v, want previous bodies v1, v2", history)
+	}
+	if comment.Content != "v3" {
+		t.Errorf("current content = %q, want %q", comment.Content, "v3")
+	}
+}
+
 // TestCommentService_toCommentResponse_Attachments tests attachment conversion in toCommentResponse
 func TestCommentService_toCommentResponse_Attachments(t *testing.T) {
 	mockCommentRepo := &MockCommentRepository{}
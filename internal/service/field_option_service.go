@@ -116,6 +116,9 @@ func (s *fieldOptionServiceImpl) UpdateFieldOption(ctx context.Context, optionID
 	if req.DisplayOrder != nil {
 		fieldOption.DisplayOrder = *req.DisplayOrder
 	}
+	if req.IsArchived != nil {
+		fieldOption.IsArchived = *req.IsArchived
+	}
 
 	// Save to repository
 	if err := s.fieldOptionRepo.Update(ctx, fieldOption); err != nil {
@@ -160,6 +163,7 @@ func (s *fieldOptionServiceImpl) toFieldOptionResponse(option *domain.FieldOptio
 		Color:           option.Color,
 		DisplayOrder:    option.DisplayOrder,
 		IsSystemDefault: option.IsSystemDefault,
+		IsArchived:      option.IsArchived,
 		CreatedAt:       option.CreatedAt,
 		UpdatedAt:       option.UpdatedAt,
 	}
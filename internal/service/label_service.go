@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// LabelService defines the interface for label business logic
+type LabelService interface {
+	CreateLabel(ctx context.Context, req *dto.CreateLabelRequest) (*dto.LabelResponse, error)
+	GetLabels(ctx context.Context, projectID uuid.UUID) ([]*dto.LabelResponse, error)
+	DeleteLabel(ctx context.Context, labelID uuid.UUID) error
+	AddBoardLabels(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) ([]dto.LabelResponse, error)
+	RemoveBoardLabels(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) ([]dto.LabelResponse, error)
+}
+
+// labelServiceImpl is the implementation of LabelService
+type labelServiceImpl struct {
+	labelRepo   repository.LabelRepository
+	projectRepo repository.ProjectRepository
+	boardRepo   repository.BoardRepository
+}
+
+// NewLabelService creates a new instance of LabelService
+func NewLabelService(labelRepo repository.LabelRepository, projectRepo repository.ProjectRepository, boardRepo repository.BoardRepository) LabelService {
+	return &labelServiceImpl{
+		labelRepo:   labelRepo,
+		projectRepo: projectRepo,
+		boardRepo:   boardRepo,
+	}
+}
+
+// CreateLabel creates a label in a project; names are unique within the project
+func (s *labelServiceImpl) CreateLabel(ctx context.Context, req *dto.CreateLabelRequest) (*dto.LabelResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, response.NewFieldValidationError("Label name is required", map[string]string{"name": "must not be blank"})
+	}
+
+	if _, err := s.projectRepo.FindByID(ctx, req.ProjectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	// Check for a label with the same name in the project
+	existing, err := s.labelRepo.FindByProjectAndName(ctx, req.ProjectID, name)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check for duplicates", err.Error())
+	}
+	if existing != nil {
+		return nil, duplicateLabelError(name)
+	}
+
+	label := &domain.Label{
+		ProjectID: req.ProjectID,
+		Name:      name,
+		Color:     req.Color,
+	}
+	if err := s.labelRepo.Create(ctx, label); err != nil {
+		// A concurrent request may have taken the name since the check above
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
+			return nil, duplicateLabelError(name)
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create label", err.Error())
+	}
+
+	resp := toLabelResponse(label)
+	return &resp, nil
+}
+
+// GetLabels retrieves all labels of a project
+func (s *labelServiceImpl) GetLabels(ctx context.Context, projectID uuid.UUID) ([]*dto.LabelResponse, error) {
+	if _, err := s.projectRepo.FindByID(ctx, projectID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Project not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify project", err.Error())
+	}
+
+	labels, err := s.labelRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch labels", err.Error())
+	}
+
+	responses := make([]*dto.LabelResponse, len(labels))
+	for i, label := range labels {
+		resp := toLabelResponse(label)
+		responses[i] = &resp
+	}
+	return responses, nil
+}
+
+// DeleteLabel deletes a label and removes it from every board
+func (s *labelServiceImpl) DeleteLabel(ctx context.Context, labelID uuid.UUID) error {
+	if _, err := s.labelRepo.FindByID(ctx, labelID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Label not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch label", err.Error())
+	}
+
+	if err := s.labelRepo.Delete(ctx, labelID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete label", err.Error())
+	}
+	return nil
+}
+
+// AddBoardLabels attaches labels of the board's project to a board and returns its labels
+// Labels already on the board are left as they are
+func (s *labelServiceImpl) AddBoardLabels(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) ([]dto.LabelResponse, error) {
+	board, err := s.findBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+
+	labelIDs, err = validateProjectLabels(ctx, s.labelRepo, board.ProjectID, labelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.labelRepo.AddToBoard(ctx, boardID, labelIDs); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to add labels", err.Error())
+	}
+	return s.boardLabels(ctx, boardID)
+}
+
+// RemoveBoardLabels detaches labels from a board and returns its remaining labels
+// Labels not on the board are ignored
+func (s *labelServiceImpl) RemoveBoardLabels(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) ([]dto.LabelResponse, error) {
+	if _, err := s.findBoard(ctx, boardID); err != nil {
+		return nil, err
+	}
+
+	if err := s.labelRepo.RemoveFromBoard(ctx, boardID, removeDuplicateUUIDs(labelIDs)); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to remove labels", err.Error())
+	}
+	return s.boardLabels(ctx, boardID)
+}
+
+// findBoard loads a board, mapping a missing board to ErrCodeNotFound
+func (s *labelServiceImpl) findBoard(ctx context.Context, boardID uuid.UUID) (*domain.Board, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch board", err.Error())
+	}
+	return board, nil
+}
+
+// boardLabels reloads the board and returns its current labels
+func (s *labelServiceImpl) boardLabels(ctx context.Context, boardID uuid.UUID) ([]dto.LabelResponse, error) {
+	board, err := s.findBoard(ctx, boardID)
+	if err != nil {
+		return nil, err
+	}
+	return toLabelResponses(board.Labels), nil
+}
+
+// validateProjectLabels removes duplicate label IDs and checks that every label exists in the project
+func validateProjectLabels(ctx context.Context, labelRepo repository.LabelRepository, projectID uuid.UUID, labelIDs []uuid.UUID) ([]uuid.UUID, error) {
+	labelIDs = removeDuplicateUUIDs(labelIDs)
+	if len(labelIDs) == 0 {
+		return labelIDs, nil
+	}
+
+	labels, err := labelRepo.FindByIDs(ctx, labelIDs)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch labels", err.Error())
+	}
+
+	found := make(map[uuid.UUID]bool, len(labels))
+	for _, label := range labels {
+		if label.ProjectID == projectID {
+			found[label.ID] = true
+		}
+	}
+	fields := make(map[string]string)
+	for _, id := range labelIDs {
+		if !found[id] {
+			fields[id.String()] = "label does not exist in the board's project"
+		}
+	}
+	if len(fields) > 0 {
+		return nil, response.NewFieldValidationError("Invalid labels", fields)
+	}
+	return labelIDs, nil
+}
+
+// duplicateLabelError reports a label name that is already taken in the project
+func duplicateLabelError(name string) error {
+	return response.NewValidationError(fmt.Sprintf("Label '%s' already exists in the project", name), "")
+}
+
+// toLabelResponse converts domain.Label to dto.LabelResponse
+func toLabelResponse(label *domain.Label) dto.LabelResponse {
+	return dto.LabelResponse{
+		ID:        label.ID,
+		ProjectID: label.ProjectID,
+		Name:      label.Name,
+		Color:     label.Color,
+		CreatedAt: label.CreatedAt,
+	}
+}
+
+// toLabelResponses converts the labels of a board, ordered by name
+func toLabelResponses(labels []domain.Label) []dto.LabelResponse {
+	responses := make([]dto.LabelResponse, 0, len(labels))
+	for i := range labels {
+		responses = append(responses, toLabelResponse(&labels[i]))
+	}
+	sort.Slice(responses, func(i, j int) bool { return responses[i].Name < responses[j].Name })
+	return responses
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestLabelService_CreateLabel_DuplicateName(t *testing.T) {
+	projectID := uuid.New()
+	existing := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "bug"}
+
+	created := false
+	mockLabelRepo := &MockLabelRepository{
+		FindByProjectAndNameFunc: func(ctx context.Context, id uuid.UUID, name string) (*domain.Label, error) {
+			if id == projectID && name == existing.Name {
+				return existing, nil
+			}
+			return nil, errors.New("unexpected lookup")
+		},
+		CreateFunc: func(ctx context.Context, label *domain.Label) error {
+			created = true
+			return nil
+		},
+	}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: id}}, nil
+		},
+	}
+	service := NewLabelService(mockLabelRepo, mockProjectRepo, &MockBoardRepository{})
+
+	// Surrounding whitespace does not make a name distinct
+	_, err := service.CreateLabel(context.Background(), &dto.CreateLabelRequest{ProjectID: projectID, Name: " bug ", Color: "#E5484D"})
+
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+		t.Fatalf("CreateLabel() error = %v, want %s", err, response.ErrCodeValidation)
+	}
+	if created {
+		t.Error("a duplicate label was created")
+	}
+}
+
+func TestLabelService_AddBoardLabels_RejectsOtherProjectLabels(t *testing.T) {
+	projectID := uuid.New()
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID}
+	own := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "bug"}
+	foreign := &domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: uuid.New(), Name: "bug"}
+
+	attached := false
+	mockLabelRepo := &MockLabelRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error) {
+			return []*domain.Label{own, foreign}, nil
+		},
+		AddToBoardFunc: func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+			attached = true
+			return nil
+		},
+	}
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			return board, nil
+		},
+	}
+	service := NewLabelService(mockLabelRepo, &MockProjectRepository{}, mockBoardRepo)
+
+	_, err := service.AddBoardLabels(context.Background(), board.ID, []uuid.UUID{own.ID, foreign.ID})
+
+	var appErr *response.AppError
+	if !errors.As(err, &appErr) || appErr.Code != response.ErrCodeValidation {
+		t.Fatalf("AddBoardLabels() error = %v, want %s", err, response.ErrCodeValidation)
+	}
+	if _, ok := appErr.Fields[foreign.ID.String()]; !ok || len(appErr.Fields) != 1 {
+		t.Errorf("invalid label fields = %v, want only %s", appErr.Fields, foreign.ID)
+	}
+	if attached {
+		t.Error("labels were attached despite the invalid label")
+	}
+}
+
+func TestBoardService_UpdateBoard_ReplacesLabels(t *testing.T) {
+	projectID := uuid.New()
+	board := &domain.Board{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Title: "Board"}
+	bug := domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "bug"}
+	urgent := domain.Label{BaseModel: domain.BaseModel{ID: uuid.New()}, ProjectID: projectID, Name: "urgent"}
+	board.Labels = []domain.Label{urgent}
+
+	mockBoardRepo := &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			copied := *board
+			return &copied, nil
+		},
+	}
+	var replaced []uuid.UUID
+	mockLabelRepo := &MockLabelRepository{
+		FindByIDsFunc: func(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error) {
+			return []*domain.Label{&bug}, nil
+		},
+		ReplaceForBoardFunc: func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+			replaced = labelIDs
+			board.Labels = []domain.Label{bug}
+			return nil
+		},
+	}
+	transactor := &recordingTransactor{}
+	service := NewBoardService(mockBoardRepo, &MockProjectRepository{}, &MockFieldOptionRepository{}, &MockParticipantRepository{},
+		&MockAttachmentRepository{}, nil, &MockFieldOptionConverter{}, nil, zap.NewNop(),
+		WithTransactor(transactor), WithLabelRepository(mockLabelRepo))
+
+	resp, err := service.UpdateBoard(context.Background(), board.ID, &dto.UpdateBoardRequest{LabelIDs: []uuid.UUID{bug.ID, bug.ID}})
+	if err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+	if len(replaced) != 1 || replaced[0] != bug.ID {
+		t.Errorf("replaced labels = %v, want [%s]", replaced, bug.ID)
+	}
+	if transactor.calls != 1 {
+		t.Errorf("expected the update to run in 1 transaction, got %d", transactor.calls)
+	}
+	if len(resp.Labels) != 1 || resp.Labels[0].Name != "bug" {
+		t.Errorf("response labels = %v, want the bug label", resp.Labels)
+	}
+
+	// Leaving labelIds out keeps the labels as they are
+	replaced = nil
+	title := "Renamed"
+	if _, err := service.UpdateBoard(context.Background(), board.ID, &dto.UpdateBoardRequest{Title: &title}); err != nil {
+		t.Fatalf("UpdateBoard() unexpected error = %v", err)
+	}
+	if replaced != nil {
+		t.Errorf("labels were replaced without labelIds: %v", replaced)
+	}
+}
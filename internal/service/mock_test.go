@@ -3,11 +3,17 @@ package service
 import (
 	"context"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
 
 	"project-board-api/internal/client"
+	"project-board-api/internal/converter"
 	"project-board-api/internal/domain"
+	"project-board-api/internal/repository"
 )
 
 // MockFieldOptionRepository is a mock implementation of FieldOptionRepository
@@ -23,6 +29,7 @@ type MockFieldOptionRepository struct {
 	CreateBatchFunc                       func(ctx context.Context, fieldOptions []*domain.FieldOption) error
 	UpdateFunc                            func(ctx context.Context, fieldOption *domain.FieldOption) error
 	DeleteFunc                            func(ctx context.Context, id uuid.UUID) error
+	FindByProjectIDFunc                   func(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error)
 }
 
 func (m *MockFieldOptionRepository) Create(ctx context.Context, fieldOption *domain.FieldOption) error {
@@ -95,6 +102,13 @@ func (m *MockFieldOptionRepository) FindByProjectAndFieldTypeAndValue(ctx contex
 	return nil, nil
 }
 
+func (m *MockFieldOptionRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.FieldOption, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
 func (m *MockFieldOptionRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.FieldOption, error) {
 	if m.FindByIDsFunc != nil {
 		return m.FindByIDsFunc(ctx, ids)
@@ -105,6 +119,7 @@ func (m *MockFieldOptionRepository) FindByIDs(ctx context.Context, ids []uuid.UU
 // MockFieldOptionConverter is a mock implementation of FieldOptionConverter
 type MockFieldOptionConverter struct {
 	ConvertValuesToIDsFunc      func(ctx context.Context, projectID uuid.UUID, customFields map[string]interface{}) (map[string]interface{}, error)
+	ConvertValuesToIDsBatchFunc func(ctx context.Context, projectID uuid.UUID, customFields []map[string]interface{}) ([]converter.ConvertedFields, error)
 	ConvertIDsToValuesFunc      func(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error)
 	ConvertIDsToValuesBatchFunc func(ctx context.Context, boards []*domain.Board) error
 }
@@ -117,6 +132,18 @@ func (m *MockFieldOptionConverter) ConvertValuesToIDs(ctx context.Context, proje
 	return customFields, nil
 }
 
+func (m *MockFieldOptionConverter) ConvertValuesToIDsBatch(ctx context.Context, projectID uuid.UUID, customFields []map[string]interface{}) ([]converter.ConvertedFields, error) {
+	if m.ConvertValuesToIDsBatchFunc != nil {
+		return m.ConvertValuesToIDsBatchFunc(ctx, projectID, customFields)
+	}
+	// Default: convert each input like ConvertValuesToIDs
+	results := make([]converter.ConvertedFields, len(customFields))
+	for i, fields := range customFields {
+		results[i].Fields, results[i].Err = m.ConvertValuesToIDs(ctx, projectID, fields)
+	}
+	return results, nil
+}
+
 func (m *MockFieldOptionConverter) ConvertIDsToValues(ctx context.Context, customFields map[string]interface{}) (map[string]interface{}, error) {
 	if m.ConvertIDsToValuesFunc != nil {
 		return m.ConvertIDsToValuesFunc(ctx, customFields)
@@ -191,11 +218,16 @@ type MockAttachmentRepository struct {
 	CreateFunc                     func(ctx context.Context, attachment *domain.Attachment) error
 	FindByIDFunc                   func(ctx context.Context, id uuid.UUID) (*domain.Attachment, error)
 	FindByEntityIDFunc             func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error)
+	FindByEntityIDsFunc            func(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error)
 	FindByIDsFunc                  func(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error)
 	DeleteFunc                     func(ctx context.Context, id uuid.UUID) error
 	FindExpiredTempAttachmentsFunc func(ctx context.Context) ([]*domain.Attachment, error)
 	ConfirmAttachmentsFunc         func(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error
 	DeleteBatchFunc                func(ctx context.Context, attachmentIDs []uuid.UUID) error
+	UpdateChecksumFunc             func(ctx context.Context, id uuid.UUID, checksum string) error
+	ListByEntityIDFunc             func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID, query repository.AttachmentListQuery) ([]*domain.Attachment, error)
+	IncrementDownloadCountsFunc    func(ctx context.Context, counts map[uuid.UUID]int64) error
+	FindCoverImagesFunc            func(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]*domain.Attachment, error)
 }
 
 func (m *MockAttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) error {
@@ -219,6 +251,13 @@ func (m *MockAttachmentRepository) FindByEntityID(ctx context.Context, entityTyp
 	return nil, nil
 }
 
+func (m *MockAttachmentRepository) FindByEntityIDs(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID][]*domain.Attachment, error) {
+	if m.FindByEntityIDsFunc != nil {
+		return m.FindByEntityIDsFunc(ctx, entityType, entityIDs)
+	}
+	return map[uuid.UUID][]*domain.Attachment{}, nil
+}
+
 func (m *MockAttachmentRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Attachment, error) {
 	if m.FindByIDsFunc != nil {
 		return m.FindByIDsFunc(ctx, ids)
@@ -254,6 +293,34 @@ func (m *MockAttachmentRepository) DeleteBatch(ctx context.Context, attachmentID
 	return nil
 }
 
+func (m *MockAttachmentRepository) UpdateChecksum(ctx context.Context, id uuid.UUID, checksum string) error {
+	if m.UpdateChecksumFunc != nil {
+		return m.UpdateChecksumFunc(ctx, id, checksum)
+	}
+	return nil
+}
+
+func (m *MockAttachmentRepository) ListByEntityID(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID, query repository.AttachmentListQuery) ([]*domain.Attachment, error) {
+	if m.ListByEntityIDFunc != nil {
+		return m.ListByEntityIDFunc(ctx, entityType, entityID, query)
+	}
+	return []*domain.Attachment{}, nil
+}
+
+func (m *MockAttachmentRepository) IncrementDownloadCounts(ctx context.Context, counts map[uuid.UUID]int64) error {
+	if m.IncrementDownloadCountsFunc != nil {
+		return m.IncrementDownloadCountsFunc(ctx, counts)
+	}
+	return nil
+}
+
+func (m *MockAttachmentRepository) FindCoverImages(ctx context.Context, entityType domain.EntityType, entityIDs []uuid.UUID) (map[uuid.UUID]*domain.Attachment, error) {
+	if m.FindCoverImagesFunc != nil {
+		return m.FindCoverImagesFunc(ctx, entityType, entityIDs)
+	}
+	return map[uuid.UUID]*domain.Attachment{}, nil
+}
+
 // MockS3Client is a mock implementation of S3Client
 type MockS3Client struct {
 	GenerateFileKeyFunc      func(entityType, workspaceID, fileExt string) (string, error)
@@ -261,6 +328,10 @@ type MockS3Client struct {
 	UploadFileFunc           func(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
 	DeleteFileFunc           func(ctx context.Context, key string) error
 	GetFileURLFunc           func(key string) string
+	ComputeSHA256Func        func(ctx context.Context, key string) (string, error)
+	CopyFileFunc             func(ctx context.Context, srcKey, dstKey string) error
+	ObjectExistsFunc         func(ctx context.Context, key string) (bool, error)
+	OpenFileFunc             func(ctx context.Context, key string) (io.ReadCloser, int64, error)
 }
 
 func (m *MockS3Client) GenerateFileKey(entityType, workspaceID, fileExt string) (string, error) {
@@ -298,13 +369,61 @@ func (m *MockS3Client) GetFileURL(key string) string {
 	return "https://mock-s3-url.com/" + key
 }
 
+func (m *MockS3Client) ComputeSHA256(ctx context.Context, key string) (string, error) {
+	if m.ComputeSHA256Func != nil {
+		return m.ComputeSHA256Func(ctx, key)
+	}
+	return "", nil
+}
+
+func (m *MockS3Client) CopyFile(ctx context.Context, srcKey, dstKey string) error {
+	if m.CopyFileFunc != nil {
+		return m.CopyFileFunc(ctx, srcKey, dstKey)
+	}
+	return nil
+}
+
+func (m *MockS3Client) ObjectExists(ctx context.Context, key string) (bool, error) {
+	if m.ObjectExistsFunc != nil {
+		return m.ObjectExistsFunc(ctx, key)
+	}
+	return true, nil
+}
+
+func (m *MockS3Client) OpenFile(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	if m.OpenFileFunc != nil {
+		return m.OpenFileFunc(ctx, key)
+	}
+	return io.NopCloser(strings.NewReader("")), 0, nil
+}
+
 // MockBoardRepository is a mock implementation of BoardRepository
 type MockBoardRepository struct {
-	CreateFunc          func(ctx context.Context, board *domain.Board) error
-	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
-	FindByProjectIDFunc func(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
-	UpdateFunc          func(ctx context.Context, board *domain.Board) error
-	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+	CreateFunc                   func(ctx context.Context, board *domain.Board) error
+	FindByIDFunc                 func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	FindByIDIncludingDeletedFunc func(ctx context.Context, id uuid.UUID) (*domain.Board, error)
+	FindProjectIDsFunc           func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error)
+	SearchByProjectIDFunc        func(ctx context.Context, projectID uuid.UUID, query repository.BoardSearchQuery) ([]*domain.Board, int64, error)
+	ListByProjectIDFunc          func(ctx context.Context, projectID uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error)
+	FindByExternalIDFunc         func(ctx context.Context, projectID uuid.UUID, externalID string) (*domain.Board, error)
+	FindByUniqueTitleFunc        func(ctx context.Context, projectID uuid.UUID, title string) (*domain.Board, error)
+	FindByProjectIDFunc          func(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error)
+	UpdateFunc                   func(ctx context.Context, board *domain.Board) error
+	TouchFunc                    func(ctx context.Context, id uuid.UUID) error
+	AddActivitiesFunc            func(ctx context.Context, activities []*domain.BoardActivity) error
+	FindActivitiesByBoardIDFunc  func(ctx context.Context, boardID uuid.UUID, offset, limit int) ([]*domain.BoardActivity, int64, error)
+	FindActivitiesSinceFunc      func(ctx context.Context, boardID uuid.UUID, since time.Time) ([]*domain.BoardActivity, error)
+	DeleteFunc                   func(ctx context.Context, id uuid.UUID) error
+	ArchiveFunc                  func(ctx context.Context, id uuid.UUID, archivedAt time.Time, reason *string) error
+	RestoreFunc                  func(ctx context.Context, id uuid.UUID) error
+
+	CountActiveByProjectIDFunc   func(ctx context.Context, projectID uuid.UUID) (int64, error)
+	CountByProjectIDFunc         func(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error)
+	EstimateCountByProjectIDFunc func(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error)
+	SumEffortByProjectIDFunc     func(ctx context.Context, projectID uuid.UUID) (float64, float64, error)
+	CountByCustomFieldOptionFunc func(ctx context.Context, projectID uuid.UUID, fieldKey string) (map[string]int64, error)
+	FindOrphanedAssigneesFunc    func(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error)
+	ClearOrphanedAssigneesFunc   func(ctx context.Context, projectID uuid.UUID, boardIDs []uuid.UUID) (int64, error)
 }
 
 func (m *MockBoardRepository) Create(ctx context.Context, board *domain.Board) error {
@@ -321,6 +440,48 @@ func (m *MockBoardRepository) FindByID(ctx context.Context, id uuid.UUID) (*doma
 	return nil, nil
 }
 
+func (m *MockBoardRepository) FindProjectIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]uuid.UUID, error) {
+	if m.FindProjectIDsFunc != nil {
+		return m.FindProjectIDsFunc(ctx, ids)
+	}
+	return map[uuid.UUID]uuid.UUID{}, nil
+}
+
+func (m *MockBoardRepository) FindByIDIncludingDeleted(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+	if m.FindByIDIncludingDeletedFunc != nil {
+		return m.FindByIDIncludingDeletedFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockBoardRepository) SearchByProjectID(ctx context.Context, projectID uuid.UUID, query repository.BoardSearchQuery) ([]*domain.Board, int64, error) {
+	if m.SearchByProjectIDFunc != nil {
+		return m.SearchByProjectIDFunc(ctx, projectID, query)
+	}
+	return []*domain.Board{}, 0, nil
+}
+
+func (m *MockBoardRepository) ListByProjectID(ctx context.Context, projectID uuid.UUID, query repository.BoardPageQuery) ([]*domain.Board, error) {
+	if m.ListByProjectIDFunc != nil {
+		return m.ListByProjectIDFunc(ctx, projectID, query)
+	}
+	return []*domain.Board{}, nil
+}
+
+func (m *MockBoardRepository) FindByExternalID(ctx context.Context, projectID uuid.UUID, externalID string) (*domain.Board, error) {
+	if m.FindByExternalIDFunc != nil {
+		return m.FindByExternalIDFunc(ctx, projectID, externalID)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockBoardRepository) FindByUniqueTitle(ctx context.Context, projectID uuid.UUID, title string) (*domain.Board, error) {
+	if m.FindByUniqueTitleFunc != nil {
+		return m.FindByUniqueTitleFunc(ctx, projectID, title)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
 func (m *MockBoardRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) ([]*domain.Board, error) {
 	if m.FindByProjectIDFunc != nil {
 		return m.FindByProjectIDFunc(ctx, projectID, filters)
@@ -335,6 +496,48 @@ func (m *MockBoardRepository) Update(ctx context.Context, board *domain.Board) e
 	return nil
 }
 
+func (m *MockBoardRepository) Touch(ctx context.Context, id uuid.UUID) error {
+	if m.TouchFunc != nil {
+		return m.TouchFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockBoardRepository) Archive(ctx context.Context, id uuid.UUID, archivedAt time.Time, reason *string) error {
+	if m.ArchiveFunc != nil {
+		return m.ArchiveFunc(ctx, id, archivedAt, reason)
+	}
+	return nil
+}
+
+func (m *MockBoardRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	if m.RestoreFunc != nil {
+		return m.RestoreFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockBoardRepository) AddActivities(ctx context.Context, activities []*domain.BoardActivity) error {
+	if m.AddActivitiesFunc != nil {
+		return m.AddActivitiesFunc(ctx, activities)
+	}
+	return nil
+}
+
+func (m *MockBoardRepository) FindActivitiesByBoardID(ctx context.Context, boardID uuid.UUID, offset, limit int) ([]*domain.BoardActivity, int64, error) {
+	if m.FindActivitiesByBoardIDFunc != nil {
+		return m.FindActivitiesByBoardIDFunc(ctx, boardID, offset, limit)
+	}
+	return []*domain.BoardActivity{}, 0, nil
+}
+
+func (m *MockBoardRepository) FindActivitiesSince(ctx context.Context, boardID uuid.UUID, since time.Time) ([]*domain.BoardActivity, error) {
+	if m.FindActivitiesSinceFunc != nil {
+		return m.FindActivitiesSinceFunc(ctx, boardID, since)
+	}
+	return []*domain.BoardActivity{}, nil
+}
+
 func (m *MockBoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, id)
@@ -342,13 +545,64 @@ func (m *MockBoardRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (m *MockBoardRepository) CountActiveByProjectID(ctx context.Context, projectID uuid.UUID) (int64, error) {
+	if m.CountActiveByProjectIDFunc != nil {
+		return m.CountActiveByProjectIDFunc(ctx, projectID)
+	}
+	return 0, nil
+}
+
+func (m *MockBoardRepository) CountByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error) {
+	if m.CountByProjectIDFunc != nil {
+		return m.CountByProjectIDFunc(ctx, projectID, filters)
+	}
+	return 0, nil
+}
+
+func (m *MockBoardRepository) FindOrphanedAssignees(ctx context.Context, projectID uuid.UUID) ([]*domain.Board, error) {
+	if m.FindOrphanedAssigneesFunc != nil {
+		return m.FindOrphanedAssigneesFunc(ctx, projectID)
+	}
+	return []*domain.Board{}, nil
+}
+
+func (m *MockBoardRepository) ClearOrphanedAssignees(ctx context.Context, projectID uuid.UUID, boardIDs []uuid.UUID) (int64, error) {
+	if m.ClearOrphanedAssigneesFunc != nil {
+		return m.ClearOrphanedAssigneesFunc(ctx, projectID, boardIDs)
+	}
+	return 0, nil
+}
+
+func (m *MockBoardRepository) SumEffortByProjectID(ctx context.Context, projectID uuid.UUID) (float64, float64, error) {
+	if m.SumEffortByProjectIDFunc != nil {
+		return m.SumEffortByProjectIDFunc(ctx, projectID)
+	}
+	return 0, 0, nil
+}
+
+func (m *MockBoardRepository) CountByCustomFieldOption(ctx context.Context, projectID uuid.UUID, fieldKey string) (map[string]int64, error) {
+	if m.CountByCustomFieldOptionFunc != nil {
+		return m.CountByCustomFieldOptionFunc(ctx, projectID, fieldKey)
+	}
+	return map[string]int64{}, nil
+}
+
+func (m *MockBoardRepository) EstimateCountByProjectID(ctx context.Context, projectID uuid.UUID, filters interface{}) (int64, error) {
+	if m.EstimateCountByProjectIDFunc != nil {
+		return m.EstimateCountByProjectIDFunc(ctx, projectID, filters)
+	}
+	return 0, nil
+}
+
 // MockProjectRepository is a mock implementation of ProjectRepository
 type MockProjectRepository struct {
 	CreateFunc                      func(ctx context.Context, project *domain.Project) error
 	FindByIDFunc                    func(ctx context.Context, id uuid.UUID) (*domain.Project, error)
+	LockByIDFunc                    func(ctx context.Context, id uuid.UUID) error
 	FindByWorkspaceIDFunc           func(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error)
 	FindDefaultByWorkspaceIDFunc    func(ctx context.Context, workspaceID uuid.UUID) (*domain.Project, error)
 	UpdateFunc                      func(ctx context.Context, project *domain.Project) error
+	SetEnforceUniqueTitlesFunc      func(ctx context.Context, projectID uuid.UUID, enforce bool) error
 	DeleteFunc                      func(ctx context.Context, id uuid.UUID) error
 	SearchFunc                      func(ctx context.Context, workspaceID uuid.UUID, query string, page, limit int) ([]*domain.Project, int64, error)
 	AddMemberFunc                   func(ctx context.Context, member *domain.ProjectMember) error
@@ -378,6 +632,13 @@ func (m *MockProjectRepository) FindByID(ctx context.Context, id uuid.UUID) (*do
 	return nil, nil
 }
 
+func (m *MockProjectRepository) LockByID(ctx context.Context, id uuid.UUID) error {
+	if m.LockByIDFunc != nil {
+		return m.LockByIDFunc(ctx, id)
+	}
+	return nil
+}
+
 func (m *MockProjectRepository) FindByWorkspaceID(ctx context.Context, workspaceID uuid.UUID) ([]*domain.Project, error) {
 	if m.FindByWorkspaceIDFunc != nil {
 		return m.FindByWorkspaceIDFunc(ctx, workspaceID)
@@ -399,6 +660,13 @@ func (m *MockProjectRepository) Update(ctx context.Context, project *domain.Proj
 	return nil
 }
 
+func (m *MockProjectRepository) SetEnforceUniqueTitles(ctx context.Context, projectID uuid.UUID, enforce bool) error {
+	if m.SetEnforceUniqueTitlesFunc != nil {
+		return m.SetEnforceUniqueTitlesFunc(ctx, projectID, enforce)
+	}
+	return nil
+}
+
 func (m *MockProjectRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, id)
@@ -495,7 +763,16 @@ type MockParticipantRepository struct {
 	CreateFunc             func(ctx context.Context, participant *domain.Participant) error
 	FindByBoardIDFunc      func(ctx context.Context, boardID uuid.UUID) ([]*domain.Participant, error)
 	FindByBoardAndUserFunc func(ctx context.Context, boardID, userID uuid.UUID) (*domain.Participant, error)
+	UpsertFunc             func(ctx context.Context, participant *domain.Participant) error
 	DeleteFunc             func(ctx context.Context, boardID, userID uuid.UUID) error
+	UpdateLastSeenFunc     func(ctx context.Context, boardID, userID uuid.UUID, seenAt time.Time) error
+}
+
+func (m *MockParticipantRepository) UpdateLastSeen(ctx context.Context, boardID, userID uuid.UUID, seenAt time.Time) error {
+	if m.UpdateLastSeenFunc != nil {
+		return m.UpdateLastSeenFunc(ctx, boardID, userID, seenAt)
+	}
+	return nil
 }
 
 func (m *MockParticipantRepository) Create(ctx context.Context, participant *domain.Participant) error {
@@ -519,6 +796,18 @@ func (m *MockParticipantRepository) FindByBoardAndUser(ctx context.Context, boar
 	return nil, nil
 }
 
+func (m *MockParticipantRepository) Upsert(ctx context.Context, participant *domain.Participant) error {
+	if m.UpsertFunc != nil {
+		return m.UpsertFunc(ctx, participant)
+	}
+	// Default: emulate the upsert with the single-row functions so existing fixtures keep working
+	if existing, err := m.FindByBoardAndUser(ctx, participant.BoardID, participant.UserID); err == nil && existing != nil {
+		*participant = *existing
+		return nil
+	}
+	return m.Create(ctx, participant)
+}
+
 func (m *MockParticipantRepository) Delete(ctx context.Context, boardID, userID uuid.UUID) error {
 	if m.DeleteFunc != nil {
 		return m.DeleteFunc(ctx, boardID, userID)
@@ -533,6 +822,42 @@ type MockCommentRepository struct {
 	FindByBoardIDFunc func(ctx context.Context, boardID uuid.UUID) ([]*domain.Comment, error)
 	UpdateFunc        func(ctx context.Context, comment *domain.Comment) error
 	DeleteFunc        func(ctx context.Context, id uuid.UUID) error
+	PinFunc           func(ctx context.Context, comment *domain.Comment, unpinOthers bool) error
+	UnpinFunc         func(ctx context.Context, comment *domain.Comment) error
+
+	AddRevisionFunc              func(ctx context.Context, revision *domain.CommentRevision) error
+	FindRevisionsByCommentIDFunc func(ctx context.Context, commentID uuid.UUID) ([]*domain.CommentRevision, error)
+}
+
+func (m *MockCommentRepository) AddRevision(ctx context.Context, revision *domain.CommentRevision) error {
+	if m.AddRevisionFunc != nil {
+		return m.AddRevisionFunc(ctx, revision)
+	}
+	return nil
+}
+
+func (m *MockCommentRepository) FindRevisionsByCommentID(ctx context.Context, commentID uuid.UUID) ([]*domain.CommentRevision, error) {
+	if m.FindRevisionsByCommentIDFunc != nil {
+		return m.FindRevisionsByCommentIDFunc(ctx, commentID)
+	}
+	return nil, nil
+}
+
+func (m *MockCommentRepository) Pin(ctx context.Context, comment *domain.Comment, unpinOthers bool) error {
+	if m.PinFunc != nil {
+		return m.PinFunc(ctx, comment, unpinOthers)
+	}
+	now := time.Now()
+	comment.PinnedAt = &now
+	return nil
+}
+
+func (m *MockCommentRepository) Unpin(ctx context.Context, comment *domain.Comment) error {
+	if m.UnpinFunc != nil {
+		return m.UnpinFunc(ctx, comment)
+	}
+	comment.PinnedAt = nil
+	return nil
 }
 
 func (m *MockCommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
@@ -569,3 +894,406 @@ func (m *MockCommentRepository) Delete(ctx context.Context, id uuid.UUID) error
 	}
 	return nil
 }
+
+// MockAttachmentAnnotationRepository is a mock implementation of AttachmentAnnotationRepository
+type MockAttachmentAnnotationRepository struct {
+	CreateFunc               func(ctx context.Context, annotation *domain.AttachmentAnnotation) error
+	FindByIDFunc             func(ctx context.Context, id uuid.UUID) (*domain.AttachmentAnnotation, error)
+	FindByAttachmentIDFunc   func(ctx context.Context, attachmentID uuid.UUID) ([]*domain.AttachmentAnnotation, error)
+	CountByAttachmentIDsFunc func(ctx context.Context, attachmentIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+	DeleteFunc               func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *MockAttachmentAnnotationRepository) Create(ctx context.Context, annotation *domain.AttachmentAnnotation) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, annotation)
+	}
+	return nil
+}
+
+func (m *MockAttachmentAnnotationRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.AttachmentAnnotation, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockAttachmentAnnotationRepository) FindByAttachmentID(ctx context.Context, attachmentID uuid.UUID) ([]*domain.AttachmentAnnotation, error) {
+	if m.FindByAttachmentIDFunc != nil {
+		return m.FindByAttachmentIDFunc(ctx, attachmentID)
+	}
+	return nil, nil
+}
+
+func (m *MockAttachmentAnnotationRepository) CountByAttachmentIDs(ctx context.Context, attachmentIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	if m.CountByAttachmentIDsFunc != nil {
+		return m.CountByAttachmentIDsFunc(ctx, attachmentIDs)
+	}
+	return map[uuid.UUID]int64{}, nil
+}
+
+func (m *MockAttachmentAnnotationRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// MockAttachmentDeleteJobRepository is a mock implementation of AttachmentDeleteJobRepository
+type MockAttachmentDeleteJobRepository struct {
+	EnqueueFunc                  func(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error
+	FindDueFunc                  func(ctx context.Context, now time.Time, limit int) ([]*domain.AttachmentDeleteJob, error)
+	UpdateFunc                   func(ctx context.Context, job *domain.AttachmentDeleteJob) error
+	CompleteFunc                 func(ctx context.Context, id uuid.UUID) error
+	ListFailedAttachmentJobsFunc func(ctx context.Context) ([]*domain.AttachmentDeleteJob, error)
+}
+
+func (m *MockAttachmentDeleteJobRepository) Enqueue(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error {
+	if m.EnqueueFunc != nil {
+		return m.EnqueueFunc(ctx, jobs)
+	}
+	return nil
+}
+
+func (m *MockAttachmentDeleteJobRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]*domain.AttachmentDeleteJob, error) {
+	if m.FindDueFunc != nil {
+		return m.FindDueFunc(ctx, now, limit)
+	}
+	return nil, nil
+}
+
+func (m *MockAttachmentDeleteJobRepository) Update(ctx context.Context, job *domain.AttachmentDeleteJob) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, job)
+	}
+	return nil
+}
+
+func (m *MockAttachmentDeleteJobRepository) Complete(ctx context.Context, id uuid.UUID) error {
+	if m.CompleteFunc != nil {
+		return m.CompleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockAttachmentDeleteJobRepository) ListFailedAttachmentJobs(ctx context.Context) ([]*domain.AttachmentDeleteJob, error) {
+	if m.ListFailedAttachmentJobsFunc != nil {
+		return m.ListFailedAttachmentJobsFunc(ctx)
+	}
+	return nil, nil
+}
+
+// MockLabelRepository is a mock implementation of LabelRepository
+type MockLabelRepository struct {
+	CreateFunc               func(ctx context.Context, label *domain.Label) error
+	FindByIDFunc             func(ctx context.Context, id uuid.UUID) (*domain.Label, error)
+	FindByIDsFunc            func(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error)
+	FindByProjectIDFunc      func(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error)
+	FindByProjectAndNameFunc func(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error)
+	DeleteFunc               func(ctx context.Context, id uuid.UUID) error
+	AddToBoardFunc           func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+	RemoveFromBoardFunc      func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+	ReplaceForBoardFunc      func(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error
+}
+
+func (m *MockLabelRepository) Create(ctx context.Context, label *domain.Label) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, label)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockLabelRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*domain.Label, error) {
+	if m.FindByIDsFunc != nil {
+		return m.FindByIDsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+func (m *MockLabelRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.Label, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockLabelRepository) FindByProjectAndName(ctx context.Context, projectID uuid.UUID, name string) (*domain.Label, error) {
+	if m.FindByProjectAndNameFunc != nil {
+		return m.FindByProjectAndNameFunc(ctx, projectID, name)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockLabelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) AddToBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	if m.AddToBoardFunc != nil {
+		return m.AddToBoardFunc(ctx, boardID, labelIDs)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) RemoveFromBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	if m.RemoveFromBoardFunc != nil {
+		return m.RemoveFromBoardFunc(ctx, boardID, labelIDs)
+	}
+	return nil
+}
+
+func (m *MockLabelRepository) ReplaceForBoard(ctx context.Context, boardID uuid.UUID, labelIDs []uuid.UUID) error {
+	if m.ReplaceForBoardFunc != nil {
+		return m.ReplaceForBoardFunc(ctx, boardID, labelIDs)
+	}
+	return nil
+}
+
+// MockBoardTemplateRepository is a mock implementation of BoardTemplateRepository
+type MockBoardTemplateRepository struct {
+	CreateFunc          func(ctx context.Context, template *domain.BoardTemplate) error
+	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error)
+	FindByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error)
+}
+
+func (m *MockBoardTemplateRepository) Create(ctx context.Context, template *domain.BoardTemplate) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, template)
+	}
+	return nil
+}
+
+func (m *MockBoardTemplateRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.BoardTemplate, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockBoardTemplateRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.BoardTemplate, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+// MockChecklistItemRepository is a mock implementation of ChecklistItemRepository
+type MockChecklistItemRepository struct {
+	CreateFunc        func(ctx context.Context, item *domain.ChecklistItem) error
+	FindByIDFunc      func(ctx context.Context, id uuid.UUID) (*domain.ChecklistItem, error)
+	FindByBoardIDFunc func(ctx context.Context, boardID uuid.UUID) ([]*domain.ChecklistItem, error)
+	UpdateFunc        func(ctx context.Context, item *domain.ChecklistItem) error
+	DeleteFunc        func(ctx context.Context, id uuid.UUID) error
+	ReorderFunc       func(ctx context.Context, boardID uuid.UUID, itemIDs []uuid.UUID) error
+}
+
+func (m *MockChecklistItemRepository) Create(ctx context.Context, item *domain.ChecklistItem) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, item)
+	}
+	return nil
+}
+
+func (m *MockChecklistItemRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.ChecklistItem, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockChecklistItemRepository) FindByBoardID(ctx context.Context, boardID uuid.UUID) ([]*domain.ChecklistItem, error) {
+	if m.FindByBoardIDFunc != nil {
+		return m.FindByBoardIDFunc(ctx, boardID)
+	}
+	return nil, nil
+}
+
+func (m *MockChecklistItemRepository) Update(ctx context.Context, item *domain.ChecklistItem) error {
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, item)
+	}
+	return nil
+}
+
+func (m *MockChecklistItemRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockChecklistItemRepository) Reorder(ctx context.Context, boardID uuid.UUID, itemIDs []uuid.UUID) error {
+	if m.ReorderFunc != nil {
+		return m.ReorderFunc(ctx, boardID, itemIDs)
+	}
+	return nil
+}
+
+// MockThresholdRuleRepository is a mock implementation of ThresholdRuleRepository
+type MockThresholdRuleRepository struct {
+	CreateFunc               func(ctx context.Context, rule *domain.ThresholdRule) error
+	FindByIDFunc             func(ctx context.Context, id uuid.UUID) (*domain.ThresholdRule, error)
+	FindByProjectIDFunc      func(ctx context.Context, projectID uuid.UUID) ([]*domain.ThresholdRule, error)
+	DeleteFunc               func(ctx context.Context, id uuid.UUID) error
+	FindTriggeredRuleIDsFunc func(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID]bool, error)
+	MarkTriggeredFunc        func(ctx context.Context, ruleID, boardID uuid.UUID, at time.Time) (bool, error)
+	ClearTriggeredFunc       func(ctx context.Context, ruleID, boardID uuid.UUID) error
+}
+
+func (m *MockThresholdRuleRepository) Create(ctx context.Context, rule *domain.ThresholdRule) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, rule)
+	}
+	return nil
+}
+
+func (m *MockThresholdRuleRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.ThresholdRule, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (m *MockThresholdRuleRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.ThresholdRule, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockThresholdRuleRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+func (m *MockThresholdRuleRepository) FindTriggeredRuleIDs(ctx context.Context, boardID uuid.UUID) (map[uuid.UUID]bool, error) {
+	if m.FindTriggeredRuleIDsFunc != nil {
+		return m.FindTriggeredRuleIDsFunc(ctx, boardID)
+	}
+	return map[uuid.UUID]bool{}, nil
+}
+
+func (m *MockThresholdRuleRepository) MarkTriggered(ctx context.Context, ruleID, boardID uuid.UUID, at time.Time) (bool, error) {
+	if m.MarkTriggeredFunc != nil {
+		return m.MarkTriggeredFunc(ctx, ruleID, boardID, at)
+	}
+	return true, nil
+}
+
+func (m *MockThresholdRuleRepository) ClearTriggered(ctx context.Context, ruleID, boardID uuid.UUID) error {
+	if m.ClearTriggeredFunc != nil {
+		return m.ClearTriggeredFunc(ctx, ruleID, boardID)
+	}
+	return nil
+}
+
+// MockWebhookSubscriptionRepository is a mock implementation of WebhookSubscriptionRepository
+type MockWebhookSubscriptionRepository struct {
+	CreateFunc          func(ctx context.Context, subscription *domain.WebhookSubscription) error
+	FindByIDFunc        func(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error)
+	FindByProjectIDFunc func(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error)
+	DeleteFunc          func(ctx context.Context, id uuid.UUID) error
+}
+
+func (m *MockWebhookSubscriptionRepository) Create(ctx context.Context, subscription *domain.WebhookSubscription) error {
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, subscription)
+	}
+	return nil
+}
+
+func (m *MockWebhookSubscriptionRepository) FindByID(ctx context.Context, id uuid.UUID) (*domain.WebhookSubscription, error) {
+	if m.FindByIDFunc != nil {
+		return m.FindByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookSubscriptionRepository) FindByProjectID(ctx context.Context, projectID uuid.UUID) ([]*domain.WebhookSubscription, error) {
+	if m.FindByProjectIDFunc != nil {
+		return m.FindByProjectIDFunc(ctx, projectID)
+	}
+	return nil, nil
+}
+
+func (m *MockWebhookSubscriptionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// boardServiceMocks holds the mocks a test board service is built from
+// Nil repositories and clients are replaced by mocks with the default behaviour; the label repository and user client
+// are only wired when set
+type boardServiceMocks struct {
+	boardRepo       *MockBoardRepository
+	projectRepo     *MockProjectRepository
+	fieldOptionRepo *MockFieldOptionRepository
+	attachmentRepo  *MockAttachmentRepository
+	labelRepo       *MockLabelRepository
+	s3Client        *MockS3Client
+	converter       *MockFieldOptionConverter
+	userClient      *MockUserClient
+}
+
+// newTestBoardService builds a board service from the given mocks and options
+func newTestBoardService(mocks boardServiceMocks, opts ...BoardServiceOption) BoardService {
+	if mocks.boardRepo == nil {
+		mocks.boardRepo = &MockBoardRepository{}
+	}
+	if mocks.projectRepo == nil {
+		mocks.projectRepo = &MockProjectRepository{}
+	}
+	if mocks.fieldOptionRepo == nil {
+		mocks.fieldOptionRepo = &MockFieldOptionRepository{}
+	}
+	if mocks.attachmentRepo == nil {
+		mocks.attachmentRepo = &MockAttachmentRepository{}
+	}
+	if mocks.s3Client == nil {
+		mocks.s3Client = &MockS3Client{}
+	}
+	if mocks.converter == nil {
+		mocks.converter = &MockFieldOptionConverter{}
+	}
+	if mocks.labelRepo != nil {
+		opts = append([]BoardServiceOption{WithLabelRepository(mocks.labelRepo)}, opts...)
+	}
+	if mocks.userClient != nil {
+		opts = append([]BoardServiceOption{WithUserClient(mocks.userClient)}, opts...)
+	}
+	return NewBoardService(mocks.boardRepo, mocks.projectRepo, mocks.fieldOptionRepo, &MockParticipantRepository{},
+		mocks.attachmentRepo, mocks.s3Client, mocks.converter, nil, zap.NewNop(), opts...)
+}
+
+// newBoardMapRepository keeps boards in the given map
+// FindByID returns a copy, so changes only reach the map through Update
+func newBoardMapRepository(boards map[uuid.UUID]*domain.Board) *MockBoardRepository {
+	return &MockBoardRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+			board, ok := boards[id]
+			if !ok {
+				return nil, gorm.ErrRecordNotFound
+			}
+			copied := *board
+			return &copied, nil
+		},
+		UpdateFunc: func(ctx context.Context, updated *domain.Board) error {
+			boards[updated.ID] = updated
+			return nil
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -20,6 +21,8 @@ type ParticipantService interface {
 	AddParticipantsInternal(ctx context.Context, boardID uuid.UUID, userIDs []uuid.UUID) (int, error)
 	GetParticipants(ctx context.Context, boardID uuid.UUID) ([]*dto.ParticipantResponse, error)
 	RemoveParticipant(ctx context.Context, boardID, userID uuid.UUID) error
+	SyncParticipantsFromAssignees(ctx context.Context, boardID uuid.UUID) (*dto.SyncParticipantsResponse, error)
+	MarkBoardSeen(ctx context.Context, boardID, userID uuid.UUID) error
 }
 
 // participantServiceImpl is the implementation of ParticipantService
@@ -217,12 +220,82 @@ func (s *participantServiceImpl) RemoveParticipant(ctx context.Context, boardID,
 	return nil
 }
 
+// MarkBoardSeen records that a participant has seen the board's activity up to now
+// Only participants track what they have seen, so other users get a not found error
+func (s *participantServiceImpl) MarkBoardSeen(ctx context.Context, boardID, userID uuid.UUID) error {
+	if _, err := s.boardRepo.FindByID(ctx, boardID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
+	}
+
+	if err := s.participantRepo.UpdateLastSeen(ctx, boardID, userID, time.Now()); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewAppError(response.ErrCodeNotFound, "Participant not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to mark board as seen", err.Error())
+	}
+	return nil
+}
+
+// SyncParticipantsFromAssignees ensures every assignee of a board is also a participant
+// Missing assignees are added; existing participants are never removed
+func (s *participantServiceImpl) SyncParticipantsFromAssignees(ctx context.Context, boardID uuid.UUID) (*dto.SyncParticipantsResponse, error) {
+	board, err := s.boardRepo.FindByID(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewAppError(response.ErrCodeNotFound, "Board not found", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to verify board", err.Error())
+	}
+
+	participants, err := s.participantRepo.FindByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch participants", err.Error())
+	}
+
+	existing := make(map[uuid.UUID]bool, len(participants))
+	for _, p := range participants {
+		existing[p.UserID] = true
+	}
+
+	// Collect assignees that are not yet participants
+	missing := make([]uuid.UUID, 0, 1)
+	for _, assigneeID := range boardAssigneeIDs(board) {
+		if !existing[assigneeID] {
+			missing = append(missing, assigneeID)
+		}
+	}
+
+	resp := &dto.SyncParticipantsResponse{
+		BoardID: boardID,
+		Added:   make([]uuid.UUID, 0, len(missing)),
+	}
+	for _, result := range s.addParticipantsShared(ctx, boardID, missing) {
+		if result.Success {
+			resp.Added = append(resp.Added, result.UserID)
+		}
+	}
+
+	return resp, nil
+}
+
+// boardAssigneeIDs returns the assignees of a board
+func boardAssigneeIDs(board *domain.Board) []uuid.UUID {
+	if board.AssigneeID == nil || *board.AssigneeID == uuid.Nil {
+		return nil
+	}
+	return []uuid.UUID{*board.AssigneeID}
+}
+
 // toParticipantResponse converts domain.Participant to dto.ParticipantResponse
 func (s *participantServiceImpl) toParticipantResponse(participant *domain.Participant) *dto.ParticipantResponse {
 	return &dto.ParticipantResponse{
-		ID:        participant.ID,
-		BoardID:   participant.BoardID,
-		UserID:    participant.UserID,
-		CreatedAt: participant.CreatedAt,
+		ID:         participant.ID,
+		BoardID:    participant.BoardID,
+		UserID:     participant.UserID,
+		CreatedAt:  participant.CreatedAt,
+		LastSeenAt: participant.LastSeenAt,
 	}
 }
@@ -499,3 +499,100 @@ func TestParticipantService_RemoveParticipant(t *testing.T) {
 		})
 	}
 }
+
+func TestParticipantService_SyncParticipantsFromAssignees(t *testing.T) {
+	boardID := uuid.New()
+	assigneeID := uuid.New()
+	existingUserID := uuid.New()
+
+	tests := []struct {
+		name         string
+		assigneeID   *uuid.UUID
+		participants []uuid.UUID
+		wantAdded    []uuid.UUID
+	}{
+		{
+			name:         "성공: 참여자가 아닌 담당자 추가",
+			assigneeID:   &assigneeID,
+			participants: []uuid.UUID{existingUserID},
+			wantAdded:    []uuid.UUID{assigneeID},
+		},
+		{
+			name:         "성공: 이미 참여자인 담당자는 추가하지 않음",
+			assigneeID:   &assigneeID,
+			participants: []uuid.UUID{existingUserID, assigneeID},
+			wantAdded:    []uuid.UUID{},
+		},
+		{
+			name:         "성공: 담당자가 없는 Board",
+			assigneeID:   nil,
+			participants: []uuid.UUID{existingUserID},
+			wantAdded:    []uuid.UUID{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			stored := map[uuid.UUID]bool{}
+			for _, id := range tt.participants {
+				stored[id] = true
+			}
+			removed := false
+
+			mockBoardRepo := &MockBoardRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Board, error) {
+					return &domain.Board{BaseModel: domain.BaseModel{ID: id}, AssigneeID: tt.assigneeID}, nil
+				},
+			}
+			mockParticipantRepo := &MockParticipantRepository{
+				FindByBoardIDFunc: func(ctx context.Context, bID uuid.UUID) ([]*domain.Participant, error) {
+					result := make([]*domain.Participant, 0, len(stored))
+					for id := range stored {
+						result = append(result, &domain.Participant{BoardID: bID, UserID: id})
+					}
+					return result, nil
+				},
+				FindByBoardAndUserFunc: func(ctx context.Context, bID, uID uuid.UUID) (*domain.Participant, error) {
+					if stored[uID] {
+						return &domain.Participant{BoardID: bID, UserID: uID}, nil
+					}
+					return nil, gorm.ErrRecordNotFound
+				},
+				CreateFunc: func(ctx context.Context, participant *domain.Participant) error {
+					stored[participant.UserID] = true
+					return nil
+				},
+				DeleteFunc: func(ctx context.Context, bID, uID uuid.UUID) error {
+					removed = true
+					return nil
+				},
+			}
+			service := NewParticipantService(mockParticipantRepo, mockBoardRepo)
+
+			// When
+			got, err := service.SyncParticipantsFromAssignees(context.Background(), boardID)
+
+			// Then
+			if err != nil {
+				t.Fatalf("SyncParticipantsFromAssignees() unexpected error = %v", err)
+			}
+			if len(got.Added) != len(tt.wantAdded) {
+				t.Fatalf("Added = %v, want %v", got.Added, tt.wantAdded)
+			}
+			for i, id := range tt.wantAdded {
+				if got.Added[i] != id {
+					t.Errorf("Added[%d] = %v, want %v", i, got.Added[i], id)
+				}
+			}
+			if removed {
+				t.Error("SyncParticipantsFromAssignees() removed an existing participant")
+			}
+			for _, id := range tt.participants {
+				if !stored[id] {
+					t.Errorf("existing participant %v was removed", id)
+				}
+			}
+		})
+	}
+}
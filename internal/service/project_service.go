@@ -24,6 +24,10 @@ type S3Client interface {
 	UploadFile(ctx context.Context, key string, file io.Reader, contentType string) (string, error)
 	DeleteFile(ctx context.Context, key string) error
 	GetFileURL(key string) string // 🚨 [핵심 수정] 이 메서드가 누락되어 오류가 발생했습니다.
+	ComputeSHA256(ctx context.Context, key string) (string, error)
+	CopyFile(ctx context.Context, srcKey, dstKey string) error
+	ObjectExists(ctx context.Context, key string) (bool, error)
+	OpenFile(ctx context.Context, key string) (io.ReadCloser, int64, error)
 }
 
 // ProjectService defines the interface for project business logic
@@ -47,11 +51,34 @@ type projectServiceImpl struct {
 	userClient      client.UserClient
 	metrics         *metrics.Metrics
 	logger          *zap.Logger
+	// deleteQueue schedules replaced attachments for deletion; when nil they are deleted in a goroutine
+	deleteQueue repository.AttachmentDeleteJobRepository
+	// transactor makes a project change and the deletion jobs it schedules atomic
+	transactor repository.Transactor
+}
+
+// ProjectServiceOption configures optional ProjectService behaviour
+type ProjectServiceOption func(*projectServiceImpl)
+
+// WithProjectAttachmentDeleteQueue deletes replaced attachments through the durable outbox
+func WithProjectAttachmentDeleteQueue(queue repository.AttachmentDeleteJobRepository) ProjectServiceOption {
+	return func(s *projectServiceImpl) {
+		s.deleteQueue = queue
+	}
+}
+
+// WithProjectTransactor sets the Transactor that commits project changes together with their deletion jobs
+func WithProjectTransactor(transactor repository.Transactor) ProjectServiceOption {
+	return func(s *projectServiceImpl) {
+		if transactor != nil {
+			s.transactor = transactor
+		}
+	}
 }
 
 // NewProjectService creates a new instance of ProjectService
-func NewProjectService(projectRepo repository.ProjectRepository, fieldOptionRepo repository.FieldOptionRepository, attachmentRepo repository.AttachmentRepository, s3Client S3Client, userClient client.UserClient, m *metrics.Metrics, logger *zap.Logger) ProjectService {
-	return &projectServiceImpl{
+func NewProjectService(projectRepo repository.ProjectRepository, fieldOptionRepo repository.FieldOptionRepository, attachmentRepo repository.AttachmentRepository, s3Client S3Client, userClient client.UserClient, m *metrics.Metrics, logger *zap.Logger, opts ...ProjectServiceOption) ProjectService {
+	s := &projectServiceImpl{
 		projectRepo:     projectRepo,
 		fieldOptionRepo: fieldOptionRepo,
 		attachmentRepo:  attachmentRepo,
@@ -59,7 +86,12 @@ func NewProjectService(projectRepo repository.ProjectRepository, fieldOptionRepo
 		userClient:      userClient,
 		metrics:         m,
 		logger:          logger,
+		transactor:      noTransaction{},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateProject creates a new project
@@ -133,6 +165,8 @@ func (s *projectServiceImpl) CreateProject(ctx context.Context, req *dto.CreateP
 				"Please ensure all attachment IDs are valid and not already used")
 		}
 
+		recordAttachmentChecksums(ctx, s.s3Client, s.attachmentRepo, req.AttachmentIDs, s.logger)
+
 		// 💡 [수정] Confirm 후 Attachments 메타데이터를 조회하여 project 객체에 할당
 		// FindByIDs는 []*domain.Attachment를 반환한다고 가정합니다.
 		attachments, err := s.attachmentRepo.FindByIDs(ctx, req.AttachmentIDs)
@@ -365,21 +399,29 @@ func (s *projectServiceImpl) DeleteProject(ctx context.Context, projectID, userI
 		// Continue with project deletion even if attachment fetch fails
 	}
 
-	// Delete attachments from S3 and database
-	if len(attachments) > 0 {
-		// 💡 [수정] DeleteProject에서도 S3 삭제는 비동기로 처리하여 응답 시간을 개선할 수 있으나,
-		// 데이터 정합성 관점에서 (프로젝트가 DB에서 삭제되었으므로 파일 삭제는 필수),
-		// 여기서는 동기적으로 유지하거나 (가장 보수적), 고루틴을 사용하되 waitGroup을 사용해 완료를 기다리는 방식(가장 이상적)이 좋습니다.
-		// 현재는 기존 로직을 유지하여 동기적으로 처리합니다.
-		s.deleteAttachmentsWithS3(ctx, attachments)
-	}
+	if s.deleteQueue == nil {
+		// Delete attachments from S3 and database
+		if len(attachments) > 0 {
+			s.deleteAttachmentsWithS3(ctx, attachments)
+		}
 
-	// Delete from repository
-	if err := s.projectRepo.Delete(ctx, project.ID); err != nil {
-		return response.NewAppError(response.ErrCodeInternal, "Failed to delete project", err.Error())
+		// Delete from repository
+		if err := s.projectRepo.Delete(ctx, project.ID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to delete project", err.Error())
+		}
+		return nil
 	}
 
-	return nil
+	// The worker only sees the jobs once the project deletion has committed
+	return s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.deleteQueue.Enqueue(txCtx, newAttachmentDeleteJobs(attachments, s.logger)); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to schedule attachment deletion", err.Error())
+		}
+		if err := s.projectRepo.Delete(txCtx, project.ID); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to delete project", err.Error())
+		}
+		return nil
+	})
 }
 
 // SearchProjects searches projects by name or description with workspace membership validation
@@ -24,26 +24,30 @@ func (s *projectServiceImpl) toProjectResponse(project *domain.Project) *dto.Pro
 			ID:       a.ID,
 			FileName: a.FileName,
 			// 💡 FileURL 필드 채우기: S3 Key를 통해 다운로드 URL 생성
-			FileURL:     fileURL,
-			FileSize:    a.FileSize,
-			ContentType: a.ContentType,
-			UploadedBy:  a.UploadedBy,
-			UploadedAt:  a.CreatedAt,
+			FileURL:        fileURL,
+			FileSize:       a.FileSize,
+			ContentType:    a.ContentType,
+			UploadedBy:     a.UploadedBy,
+			UploadedAt:     a.CreatedAt,
+			ChecksumSHA256: a.ChecksumSHA256,
+			DownloadCount:  a.DownloadCount,
+			Visibility:     string(a.Visibility),
 		})
 	}
 
 	return &dto.ProjectResponse{
-		ID:          project.ID,
-		WorkspaceID: project.WorkspaceID,
-		OwnerID:     project.OwnerID,
-		Name:        project.Name,
-		Description: project.Description,
-		StartDate:   project.StartDate,
-		DueDate:     project.DueDate,
-		IsPublic:    project.IsPublic,
-		Attachments: attachments,
-		CreatedAt:   project.CreatedAt,
-		UpdatedAt:   project.UpdatedAt,
+		ID:                  project.ID,
+		WorkspaceID:         project.WorkspaceID,
+		OwnerID:             project.OwnerID,
+		Name:                project.Name,
+		Description:         project.Description,
+		StartDate:           project.StartDate,
+		DueDate:             project.DueDate,
+		IsPublic:            project.IsPublic,
+		Attachments:         attachments,
+		CreatedAt:           project.CreatedAt,
+		UpdatedAt:           project.UpdatedAt,
+		EnforceUniqueTitles: project.EnforceUniqueTitles,
 	}
 }
 
@@ -119,35 +123,7 @@ func validateProjectDateRange(startDate, dueDate *time.Time) error {
 // validateAndConfirmAttachments validates that attachments exist and are in TEMP status
 
 func (s *projectServiceImpl) validateAndConfirmAttachments(ctx context.Context, attachmentIDs []uuid.UUID, entityType domain.EntityType) error {
-	if len(attachmentIDs) == 0 {
-		return nil
-	}
-
-	// Fetch attachments by IDs
-	attachments, err := s.attachmentRepo.FindByIDs(ctx, attachmentIDs)
-	if err != nil {
-		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch attachments", err.Error())
-	}
-
-	// Check if all attachments exist
-	if len(attachments) != len(attachmentIDs) {
-		return response.NewAppError(response.ErrCodeValidation, "One or more attachments not found", "")
-	}
-
-	// Validate each attachment
-	for _, attachment := range attachments {
-		// Check if attachment is in TEMP status
-		if attachment.Status != domain.AttachmentStatusTemp {
-			return response.NewAppError(response.ErrCodeValidation, "Attachment is not in temporary status and cannot be reused", "")
-		}
-
-		// Check if attachment entity type matches
-		if attachment.EntityType != entityType {
-			return response.NewAppError(response.ErrCodeValidation, "Attachment entity type does not match", "")
-		}
-	}
-
-	return nil
+	return validateAttachmentsForConfirmation(ctx, s.attachmentRepo, s.s3Client, attachmentIDs, entityType, uuid.Nil)
 }
 
 // deleteAttachmentsWithS3 deletes attachments from both S3 and database
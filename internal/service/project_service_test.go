@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+)
+
+func TestProjectService_UpdateProject_QueuesReplacedAttachments(t *testing.T) {
+	projectID := uuid.New()
+	ownerID := uuid.New()
+	oldAttachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "project/files/old.pdf"}
+	newAttachmentID := uuid.New()
+
+	tests := []struct {
+		name         string
+		enqueueErr   error
+		confirmErr   error
+		wantErr      bool
+		wantRollback bool
+	}{
+		{name: "jobs commit with the project update"},
+		{name: "failed enqueue fails the update", enqueueErr: errors.New("database unavailable"), wantErr: true, wantRollback: true},
+		{name: "failed confirmation keeps the old attachments", confirmErr: errors.New("expected to confirm 1 attachment(s) but only confirmed 0"), wantErr: true, wantRollback: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queued []*domain.AttachmentDeleteJob
+			updated := false
+			transactor := &recordingTransactor{}
+
+			mockProjectRepo := &MockProjectRepository{
+				FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+					return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}, Name: "Project"}, nil
+				},
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+					return &domain.ProjectMember{UserID: ownerID, RoleName: domain.ProjectRoleOwner}, nil
+				},
+				UpdateFunc: func(ctx context.Context, project *domain.Project) error {
+					if !inRecordedTransaction(ctx) {
+						t.Error("Update() ran outside the transaction")
+					}
+					updated = true
+					return nil
+				},
+			}
+			mockAttachmentRepo := &MockAttachmentRepository{
+				FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+					return []*domain.Attachment{oldAttachment}, nil
+				},
+				ConfirmAttachmentsFunc: func(ctx context.Context, attachmentIDs []uuid.UUID, entityID uuid.UUID) error {
+					return tt.confirmErr
+				},
+			}
+			s3Called := false
+			mockS3 := &MockS3Client{
+				DeleteFileFunc: func(ctx context.Context, key string) error {
+					s3Called = true
+					return nil
+				},
+			}
+			queue := &MockAttachmentDeleteJobRepository{
+				EnqueueFunc: func(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error {
+					if !inRecordedTransaction(ctx) {
+						t.Error("Enqueue() ran outside the transaction")
+					}
+					queued = jobs
+					return tt.enqueueErr
+				},
+			}
+
+			service := NewProjectService(mockProjectRepo, &MockFieldOptionRepository{}, mockAttachmentRepo, mockS3, nil, nil, zap.NewNop(),
+				WithProjectAttachmentDeleteQueue(queue), WithProjectTransactor(transactor))
+
+			name := "Renamed"
+			_, err := service.UpdateProject(context.Background(), projectID, ownerID, &dto.UpdateProjectRequest{
+				Name:          &name,
+				AttachmentIDs: []uuid.UUID{newAttachmentID},
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UpdateProject() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if transactor.calls != 1 || transactor.rolledBack != tt.wantRollback {
+				t.Errorf("transaction calls = %d, rolled back = %v, want 1 and %v", transactor.calls, transactor.rolledBack, tt.wantRollback)
+			}
+			if !updated {
+				t.Error("UpdateProject() did not save the project")
+			}
+			if len(queued) != 1 || queued[0].AttachmentID != oldAttachment.ID || queued[0].FileKey != "project/files/old.pdf" {
+				t.Errorf("queued jobs = %+v, want one job for the replaced attachment", queued)
+			}
+			if s3Called {
+				t.Error("UpdateProject() deleted from S3 inline instead of through the queue")
+			}
+		})
+	}
+}
+
+func TestProjectService_DeleteProject_QueuesAttachmentDeletion(t *testing.T) {
+	// Given
+	projectID := uuid.New()
+	ownerID := uuid.New()
+	attachment := &domain.Attachment{BaseModel: domain.BaseModel{ID: uuid.New()}, FileURL: "project/files/spec.pdf"}
+
+	deleted := false
+	transactor := &recordingTransactor{}
+	mockProjectRepo := &MockProjectRepository{
+		FindByIDFunc: func(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+			return &domain.Project{BaseModel: domain.BaseModel{ID: projectID}}, nil
+		},
+		FindMemberByProjectAndUserFunc: func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+			return &domain.ProjectMember{UserID: ownerID, RoleName: domain.ProjectRoleOwner}, nil
+		},
+		DeleteFunc: func(ctx context.Context, id uuid.UUID) error {
+			deleted = inRecordedTransaction(ctx)
+			return nil
+		},
+	}
+	mockAttachmentRepo := &MockAttachmentRepository{
+		FindByEntityIDFunc: func(ctx context.Context, entityType domain.EntityType, entityID uuid.UUID) ([]*domain.Attachment, error) {
+			return []*domain.Attachment{attachment}, nil
+		},
+	}
+	s3Called := false
+	mockS3 := &MockS3Client{
+		DeleteFileFunc: func(ctx context.Context, key string) error {
+			s3Called = true
+			return nil
+		},
+	}
+	var queued []*domain.AttachmentDeleteJob
+	queue := &MockAttachmentDeleteJobRepository{
+		EnqueueFunc: func(ctx context.Context, jobs []*domain.AttachmentDeleteJob) error {
+			if !inRecordedTransaction(ctx) {
+				t.Error("Enqueue() ran outside the transaction")
+			}
+			queued = jobs
+			return nil
+		},
+	}
+
+	service := NewProjectService(mockProjectRepo, &MockFieldOptionRepository{}, mockAttachmentRepo, mockS3, nil, nil, zap.NewNop(),
+		WithProjectAttachmentDeleteQueue(queue), WithProjectTransactor(transactor))
+
+	// When
+	if err := service.DeleteProject(context.Background(), projectID, ownerID); err != nil {
+		t.Fatalf("DeleteProject() unexpected error = %v", err)
+	}
+
+	// Then
+	if !deleted {
+		t.Error("DeleteProject() did not delete the project in the transaction")
+	}
+	if len(queued) != 1 || queued[0].AttachmentID != attachment.ID {
+		t.Errorf("queued jobs = %+v, want one job for the project attachment", queued)
+	}
+	if s3Called {
+		t.Error("DeleteProject() deleted from S3 inline instead of through the queue")
+	}
+}
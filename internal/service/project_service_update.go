@@ -10,6 +10,7 @@ import (
 
 	"project-board-api/internal/domain"
 	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
 	"project-board-api/internal/response"
 )
 
@@ -51,6 +52,17 @@ func (s *projectServiceImpl) UpdateProject(ctx context.Context, projectID, userI
 		return nil, err
 	}
 
+	// Changing title uniqueness also flags the project's boards, and fails if they already share a title
+	if req.EnforceUniqueTitles != nil && *req.EnforceUniqueTitles != project.EnforceUniqueTitles {
+		if err := s.projectRepo.SetEnforceUniqueTitles(ctx, projectID, *req.EnforceUniqueTitles); err != nil {
+			if errors.Is(err, repository.ErrDuplicateBoardTitles) {
+				return nil, response.NewAppError(response.ErrCodeConflict, "Boards in this project already share a title", err.Error())
+			}
+			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update title uniqueness", err.Error())
+		}
+		project.EnforceUniqueTitles = *req.EnforceUniqueTitles
+	}
+
 	// Update fields if provided
 	if req.Name != nil {
 		project.Name = *req.Name
@@ -65,50 +77,58 @@ func (s *projectServiceImpl) UpdateProject(ctx context.Context, projectID, userI
 		project.DueDate = req.DueDate
 	}
 
-	// Save to repository
-	if err := s.projectRepo.Update(ctx, project); err != nil {
-		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to update project", err.Error())
-	}
-
 	// 🔥 attachmentIds 처리 (기존 삭제 후 새로 추가)
+	// 1. 기존 attachments 조회
+	var replaced []*domain.Attachment
 	if req.AttachmentIDs != nil {
-		// 1. 기존 attachments 조회
-		existingAttachments, err := s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeProject, project.ID)
+		replaced, err = s.attachmentRepo.FindByEntityID(ctx, domain.EntityTypeProject, project.ID)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			s.logger.Error("Failed to fetch existing attachments for replacement",
 				zap.String("project_id", project.ID.String()),
 				zap.Error(err))
 			return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch existing attachments", err.Error())
 		}
+	}
 
-		// 2. 🚨 성능 개선: 기존 attachments 삭제 로직을 비동기 고루틴으로 분리
-		// S3 파일 삭제는 네트워크 I/O가 발생하여 응답 시간을 지연시키므로, 응답에 필수적이지 않은 이 작업은 고루틴으로 실행합니다.
-		if len(existingAttachments) > 0 {
-			// Context.Background()를 사용하여 HTTP 요청 Context의 수명과 분리
-			go s.deleteAttachmentsWithS3(context.Background(), existingAttachments)
-			s.logger.Debug("Asynchronously initiated deletion of existing attachments",
-				zap.String("project_id", project.ID.String()),
-				zap.Int("count", len(existingAttachments)))
+	// 2. 프로젝트 저장, 기존 attachments 삭제 예약, 새 attachments confirm을 한 트랜잭션으로 처리
+	// The worker only sees the jobs once the update has committed, and a failed confirmation keeps the old files
+	err = s.transactor.WithinTransaction(ctx, func(txCtx context.Context) error {
+		if err := s.projectRepo.Update(txCtx, project); err != nil {
+			return response.NewAppError(response.ErrCodeInternal, "Failed to update project", err.Error())
 		}
 
-		// 3. 새 attachments confirm (ConfirmAttachments 내부에서 TEMP 검증) - DB I/O (응답에 필수)
-		if len(req.AttachmentIDs) > 0 {
-			if err := s.attachmentRepo.ConfirmAttachments(ctx, req.AttachmentIDs, project.ID); err != nil {
-				s.logger.Error("Failed to confirm new attachments during project update",
-					zap.String("project_id", project.ID.String()),
-					zap.Strings("attachment_ids", func() []string {
-						ids := make([]string, len(req.AttachmentIDs))
-						for i, id := range req.AttachmentIDs {
-							ids[i] = id.String()
-						}
-						return ids
-					}()),
-					zap.Error(err))
-				return nil, response.NewAppError(response.ErrCodeInternal,
-					"Failed to confirm attachments: "+err.Error(),
-					"Please ensure all attachment IDs are valid and not already used")
+		if len(replaced) > 0 && s.deleteQueue != nil {
+			if err := s.deleteQueue.Enqueue(txCtx, newAttachmentDeleteJobs(replaced, s.logger)); err != nil {
+				return response.NewAppError(response.ErrCodeInternal, "Failed to schedule attachment deletion", err.Error())
 			}
 		}
+
+		// ConfirmAttachments 내부에서 TEMP 검증
+		if err := s.attachmentRepo.ConfirmAttachments(txCtx, req.AttachmentIDs, project.ID); err != nil {
+			s.logger.Error("Failed to confirm new attachments during project update",
+				zap.String("project_id", project.ID.String()),
+				zap.Int("attachment_count", len(req.AttachmentIDs)),
+				zap.Error(err))
+			return response.NewAppError(response.ErrCodeInternal,
+				"Failed to confirm attachments: "+err.Error(),
+				"Please ensure all attachment IDs are valid and not already used")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.AttachmentIDs) > 0 {
+		recordAttachmentChecksums(ctx, s.s3Client, s.attachmentRepo, req.AttachmentIDs, s.logger)
+	}
+
+	// 3. 큐가 없으면 기존 attachments를 요청과 분리된 고루틴에서 삭제
+	if len(replaced) > 0 && s.deleteQueue == nil {
+		go s.deleteAttachmentsWithS3(context.Background(), replaced)
+		s.logger.Debug("Asynchronously initiated deletion of existing attachments",
+			zap.String("project_id", project.ID.String()),
+			zap.Int("count", len(replaced)))
 	}
 
 	// 4. 최신 attachments 조회 (응답에 필수)
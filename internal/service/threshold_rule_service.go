@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// ThresholdRuleService defines the interface for threshold rule business logic
+type ThresholdRuleService interface {
+	CreateRule(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateThresholdRuleRequest) (*dto.ThresholdRuleResponse, error)
+	ListRules(ctx context.Context, projectID, userID uuid.UUID) ([]*dto.ThresholdRuleResponse, error)
+	DeleteRule(ctx context.Context, projectID, ruleID, userID uuid.UUID) error
+}
+
+// thresholdRuleServiceImpl is the implementation of ThresholdRuleService
+type thresholdRuleServiceImpl struct {
+	ruleRepo    repository.ThresholdRuleRepository
+	projectRepo repository.ProjectRepository
+}
+
+// NewThresholdRuleService creates a new instance of ThresholdRuleService
+func NewThresholdRuleService(ruleRepo repository.ThresholdRuleRepository, projectRepo repository.ProjectRepository) ThresholdRuleService {
+	return &thresholdRuleServiceImpl{
+		ruleRepo:    ruleRepo,
+		projectRepo: projectRepo,
+	}
+}
+
+// CreateRule adds a threshold rule to a project (members only)
+// Every recipient must be a member of the project as well
+func (s *thresholdRuleServiceImpl) CreateRule(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateThresholdRuleRequest) (*dto.ThresholdRuleResponse, error) {
+	members, err := s.projectRepo.FindMembersByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch project members", err.Error())
+	}
+	memberIDs := make(map[uuid.UUID]bool, len(members))
+	for _, member := range members {
+		memberIDs[member.UserID] = true
+	}
+	if !memberIDs[userID] {
+		return nil, response.NewForbiddenError("You are not a member of this project", "")
+	}
+
+	recipients := removeDuplicateUUIDs(req.Recipients)
+	for _, recipient := range recipients {
+		if !memberIDs[recipient] {
+			return nil, response.NewFieldValidationError("Threshold rule recipients must be project members", map[string]string{
+				"recipients": recipient.String() + " is not a member of the project",
+			})
+		}
+	}
+
+	rule := &domain.ThresholdRule{
+		ProjectID:  projectID,
+		Field:      domain.ThresholdField(req.Field),
+		Operator:   domain.ThresholdOperator(req.Operator),
+		Threshold:  *req.Threshold,
+		Recipients: recipients,
+		CreatedBy:  userID,
+	}
+	if err := s.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create threshold rule", err.Error())
+	}
+	return toThresholdRuleResponse(rule), nil
+}
+
+// ListRules retrieves the threshold rules of a project (members only)
+func (s *thresholdRuleServiceImpl) ListRules(ctx context.Context, projectID, userID uuid.UUID) ([]*dto.ThresholdRuleResponse, error) {
+	if _, err := s.findMember(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	rules, err := s.ruleRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch threshold rules", err.Error())
+	}
+
+	responses := make([]*dto.ThresholdRuleResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = toThresholdRuleResponse(rule)
+	}
+	return responses, nil
+}
+
+// DeleteRule removes a threshold rule (its creator, or the project's OWNER or ADMIN)
+func (s *thresholdRuleServiceImpl) DeleteRule(ctx context.Context, projectID, ruleID, userID uuid.UUID) error {
+	member, err := s.findMember(ctx, projectID, userID)
+	if err != nil {
+		return err
+	}
+
+	rule, err := s.ruleRepo.FindByID(ctx, ruleID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Threshold rule not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch threshold rule", err.Error())
+	}
+	if rule.ProjectID != projectID {
+		return response.NewNotFoundError("Threshold rule not found", "")
+	}
+	if rule.CreatedBy != userID && member.RoleName != domain.ProjectRoleOwner && member.RoleName != domain.ProjectRoleAdmin {
+		return response.NewForbiddenError("Only the rule's creator or a project owner or admin can delete it", "")
+	}
+
+	if err := s.ruleRepo.Delete(ctx, ruleID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete threshold rule", err.Error())
+	}
+	return nil
+}
+
+// findMember returns the user's membership of the project, or a forbidden error for non-members
+func (s *thresholdRuleServiceImpl) findMember(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, response.NewForbiddenError("You are not a member of this project", "")
+		}
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+	}
+	return member, nil
+}
+
+// toThresholdRuleResponse converts domain.ThresholdRule to dto.ThresholdRuleResponse
+func toThresholdRuleResponse(rule *domain.ThresholdRule) *dto.ThresholdRuleResponse {
+	return &dto.ThresholdRuleResponse{
+		ID:         rule.ID,
+		ProjectID:  rule.ProjectID,
+		Field:      string(rule.Field),
+		Operator:   string(rule.Operator),
+		Threshold:  rule.Threshold,
+		Recipients: rule.Recipients,
+		CreatedBy:  rule.CreatedBy,
+		CreatedAt:  rule.CreatedAt,
+	}
+}
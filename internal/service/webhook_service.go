@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/url"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/repository"
+	"project-board-api/internal/response"
+)
+
+// WebhookService defines the interface for webhook subscription business logic
+type WebhookService interface {
+	CreateWebhook(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error)
+	ListWebhooks(ctx context.Context, projectID, userID uuid.UUID) ([]*dto.WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, projectID, webhookID, userID uuid.UUID) error
+}
+
+// webhookServiceImpl is the implementation of WebhookService
+type webhookServiceImpl struct {
+	webhookRepo repository.WebhookSubscriptionRepository
+	projectRepo repository.ProjectRepository
+	// lookupIP resolves the host of a target URL; tests replace it to avoid real DNS lookups
+	lookupIP func(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// NewWebhookService creates a new instance of WebhookService
+func NewWebhookService(webhookRepo repository.WebhookSubscriptionRepository, projectRepo repository.ProjectRepository) WebhookService {
+	return &webhookServiceImpl{
+		webhookRepo: webhookRepo,
+		projectRepo: projectRepo,
+		lookupIP:    net.DefaultResolver.LookupIPAddr,
+	}
+}
+
+// CreateWebhook subscribes a URL to board events of a project (OWNER or ADMIN only)
+// The target must resolve to public addresses only, so deliveries cannot be aimed at internal services
+// The generated signing secret is returned only in this response
+func (s *webhookServiceImpl) CreateWebhook(ctx context.Context, projectID, userID uuid.UUID, req *dto.CreateWebhookRequest) (*dto.WebhookResponse, error) {
+	if err := s.checkManager(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	target, err := url.Parse(req.TargetURL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+		return nil, response.NewValidationError("Webhook target URL must be an absolute http or https URL", req.TargetURL)
+	}
+	if err := s.checkPublicHost(ctx, target.Hostname()); err != nil {
+		return nil, err
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to generate webhook secret", err.Error())
+	}
+
+	subscription := &domain.WebhookSubscription{
+		ProjectID: projectID,
+		TargetURL: req.TargetURL,
+		Events:    req.Events,
+		Secret:    secret,
+		CreatedBy: userID,
+	}
+	if err := s.webhookRepo.Create(ctx, subscription); err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to create webhook", err.Error())
+	}
+
+	resp := toWebhookResponse(subscription)
+	resp.Secret = subscription.Secret
+	return resp, nil
+}
+
+// ListWebhooks retrieves the webhook subscriptions of a project (OWNER or ADMIN only)
+func (s *webhookServiceImpl) ListWebhooks(ctx context.Context, projectID, userID uuid.UUID) ([]*dto.WebhookResponse, error) {
+	if err := s.checkManager(ctx, projectID, userID); err != nil {
+		return nil, err
+	}
+
+	subscriptions, err := s.webhookRepo.FindByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, response.NewAppError(response.ErrCodeInternal, "Failed to fetch webhooks", err.Error())
+	}
+
+	responses := make([]*dto.WebhookResponse, len(subscriptions))
+	for i, subscription := range subscriptions {
+		responses[i] = toWebhookResponse(subscription)
+	}
+	return responses, nil
+}
+
+// DeleteWebhook removes a webhook subscription (OWNER or ADMIN only)
+func (s *webhookServiceImpl) DeleteWebhook(ctx context.Context, projectID, webhookID, userID uuid.UUID) error {
+	if err := s.checkManager(ctx, projectID, userID); err != nil {
+		return err
+	}
+
+	subscription, err := s.webhookRepo.FindByID(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewNotFoundError("Webhook not found", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to fetch webhook", err.Error())
+	}
+	if subscription.ProjectID != projectID {
+		return response.NewNotFoundError("Webhook not found", "")
+	}
+
+	if err := s.webhookRepo.Delete(ctx, webhookID); err != nil {
+		return response.NewAppError(response.ErrCodeInternal, "Failed to delete webhook", err.Error())
+	}
+	return nil
+}
+
+// checkManager verifies that the user is an OWNER or ADMIN of the project
+func (s *webhookServiceImpl) checkManager(ctx context.Context, projectID, userID uuid.UUID) error {
+	member, err := s.projectRepo.FindMemberByProjectAndUser(ctx, projectID, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return response.NewForbiddenError("You are not a member of this project", "")
+		}
+		return response.NewAppError(response.ErrCodeInternal, "Failed to check membership", err.Error())
+	}
+	if member.RoleName != domain.ProjectRoleOwner && member.RoleName != domain.ProjectRoleAdmin {
+		return response.NewForbiddenError("Only project owner or admin can manage webhooks", "")
+	}
+	return nil
+}
+
+// checkPublicHost rejects a webhook host that is, or resolves to, a loopback, private, link-local or otherwise
+// non-public address, such as 127.0.0.1, 10.0.0.0/8 or the 169.254.169.254 cloud metadata endpoint
+// Every resolved address is checked, since any one of them may be the one a delivery connects to
+func (s *webhookServiceImpl) checkPublicHost(ctx context.Context, host string) error {
+	var addrs []net.IPAddr
+	if ip := net.ParseIP(host); ip != nil {
+		addrs = []net.IPAddr{{IP: ip}}
+	} else {
+		resolved, err := s.lookupIP(ctx, host)
+		if err != nil || len(resolved) == 0 {
+			return response.NewValidationError("Webhook target host could not be resolved", host)
+		}
+		addrs = resolved
+	}
+
+	for _, addr := range addrs {
+		if !isPublicIP(addr.IP) {
+			return response.NewValidationError("Webhook target must not point at a private, loopback or link-local address", host)
+		}
+	}
+	return nil
+}
+
+// isPublicIP reports whether ip is a globally routable unicast address
+func isPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast()
+}
+
+// newWebhookSecret generates a random signing secret for a subscription
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+// toWebhookResponse converts domain.WebhookSubscription to dto.WebhookResponse without its secret
+func toWebhookResponse(subscription *domain.WebhookSubscription) *dto.WebhookResponse {
+	return &dto.WebhookResponse{
+		ID:        subscription.ID,
+		ProjectID: subscription.ProjectID,
+		TargetURL: subscription.TargetURL,
+		Events:    subscription.Events,
+		CreatedBy: subscription.CreatedBy,
+		CreatedAt: subscription.CreatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"project-board-api/internal/domain"
+	"project-board-api/internal/dto"
+	"project-board-api/internal/response"
+)
+
+func TestWebhookService_CreateWebhook_RejectsNonPublicTargets(t *testing.T) {
+	// Host names resolve through this table instead of DNS
+	hosts := map[string][]net.IPAddr{
+		"hooks.example.com":    {{IP: net.ParseIP("93.184.216.34")}},
+		"localhost":            {{IP: net.ParseIP("127.0.0.1")}},
+		"intranet.example.com": {{IP: net.ParseIP("93.184.216.34")}, {IP: net.ParseIP("192.168.1.10")}},
+	}
+
+	tests := []struct {
+		name      string
+		targetURL string
+		wantErr   bool
+	}{
+		{name: "public host", targetURL: "https://hooks.example.com/boards", wantErr: false},
+		{name: "public IP", targetURL: "https://93.184.216.34/boards", wantErr: false},
+		{name: "loopback IP", targetURL: "http://127.0.0.1:8080/boards", wantErr: true},
+		{name: "loopback host", targetURL: "http://localhost/boards", wantErr: true},
+		{name: "private IP", targetURL: "http://10.0.0.5/boards", wantErr: true},
+		{name: "cloud metadata endpoint", targetURL: "http://169.254.169.254/latest/meta-data/", wantErr: true},
+		{name: "IPv6 loopback", targetURL: "http://[::1]/boards", wantErr: true},
+		{name: "IPv6 link-local", targetURL: "http://[fe80::1]/boards", wantErr: true},
+		{name: "unspecified address", targetURL: "http://0.0.0.0/boards", wantErr: true},
+		{name: "host with one private address", targetURL: "https://intranet.example.com/boards", wantErr: true},
+		{name: "unresolvable host", targetURL: "https://missing.example.com/boards", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			created := false
+			mockWebhookRepo := &MockWebhookSubscriptionRepository{
+				CreateFunc: func(ctx context.Context, subscription *domain.WebhookSubscription) error {
+					created = true
+					return nil
+				},
+			}
+			mockProjectRepo := &MockProjectRepository{
+				FindMemberByProjectAndUserFunc: func(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+					return &domain.ProjectMember{RoleName: domain.ProjectRoleOwner}, nil
+				},
+			}
+			service := NewWebhookService(mockWebhookRepo, mockProjectRepo).(*webhookServiceImpl)
+			service.lookupIP = func(ctx context.Context, host string) ([]net.IPAddr, error) {
+				if addrs, ok := hosts[host]; ok {
+					return addrs, nil
+				}
+				return nil, errors.New("no such host")
+			}
+
+			_, err := service.CreateWebhook(context.Background(), uuid.New(), uuid.New(), &dto.CreateWebhookRequest{
+				TargetURL: tt.targetURL,
+				Events:    []string{string(domain.WebhookEventBoardUpdated)},
+			})
+
+			if !tt.wantErr {
+				if err != nil || !created {
+					t.Errorf("CreateWebhook() error = %v, created = %v; want the subscription created", err, created)
+				}
+				return
+			}
+			if appErr, ok := err.(*response.AppError); !ok || appErr.Code != response.ErrCodeValidation {
+				t.Errorf("CreateWebhook() error = %v, want %s", err, response.ErrCodeValidation)
+			}
+			if created {
+				t.Error("CreateWebhook() stored a subscription for a rejected target")
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package textutil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Supported locales for RelativeDueDate; anything else falls back to LocaleEnglish
+const (
+	LocaleEnglish = "en"
+	LocaleKorean  = "ko"
+)
+
+// RelativeDueDate renders due relative to now, e.g. "in 3 days", "due today" or "overdue by 2 days".
+// Days are counted between calendar dates in loc (the board's time zone, UTC when nil), so a board
+// due at 09:00 tomorrow is "due tomorrow" even if that is less than 24 hours away.
+// locale accepts BCP 47 tags such as "ko-KR"; only the language part is used.
+func RelativeDueDate(due, now time.Time, loc *time.Location, locale string) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	days := calendarDaysBetween(now.In(loc), due.In(loc))
+	if normalizeLocale(locale) == LocaleKorean {
+		return relativeDueDateKorean(days)
+	}
+	return relativeDueDateEnglish(days)
+}
+
+// calendarDaysBetween counts calendar days from from to to, both already in the same location
+func calendarDaysBetween(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// normalizeLocale reduces a locale tag to its language subtag
+func normalizeLocale(locale string) string {
+	lang := strings.ToLower(locale)
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+func relativeDueDateEnglish(days int) string {
+	switch {
+	case days == 0:
+		return "due today"
+	case days == 1:
+		return "due tomorrow"
+	case days > 1:
+		return fmt.Sprintf("in %d days", days)
+	case days == -1:
+		return "overdue by 1 day"
+	default:
+		return fmt.Sprintf("overdue by %d days", -days)
+	}
+}
+
+func relativeDueDateKorean(days int) string {
+	switch {
+	case days == 0:
+		return "오늘 마감"
+	case days == 1:
+		return "내일 마감"
+	case days > 1:
+		return fmt.Sprintf("%d일 후 마감", days)
+	default:
+		return fmt.Sprintf("마감 %d일 지남", -days)
+	}
+}
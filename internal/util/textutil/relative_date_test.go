@@ -0,0 +1,55 @@
+package textutil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelativeDueDate(t *testing.T) {
+	kst := time.FixedZone("KST", 9*60*60)
+	// 2024-06-01 10:00 KST
+	now := time.Date(2024, 6, 1, 1, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		due    time.Time
+		locale string
+		want   string
+	}{
+		{name: "future en", due: now.Add(72 * time.Hour), locale: "en", want: "in 3 days"},
+		{name: "future ko", due: now.Add(72 * time.Hour), locale: "ko-KR", want: "3일 후 마감"},
+		{name: "tomorrow en", due: now.Add(24 * time.Hour), locale: "en-US", want: "due tomorrow"},
+		{name: "tomorrow ko", due: now.Add(24 * time.Hour), locale: "ko", want: "내일 마감"},
+		{name: "today en", due: now.Add(5 * time.Hour), locale: "en", want: "due today"},
+		{name: "today ko", due: now.Add(5 * time.Hour), locale: "ko", want: "오늘 마감"},
+		{name: "earlier today is still today", due: now.Add(-time.Hour), locale: "en", want: "due today"},
+		{name: "overdue one day en", due: now.Add(-24 * time.Hour), locale: "en", want: "overdue by 1 day"},
+		{name: "overdue en", due: now.Add(-48 * time.Hour), locale: "en", want: "overdue by 2 days"},
+		{name: "overdue ko", due: now.Add(-48 * time.Hour), locale: "ko_KR", want: "마감 2일 지남"},
+		{name: "unknown locale falls back to en", due: now.Add(72 * time.Hour), locale: "fr", want: "in 3 days"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RelativeDueDate(tt.due, now, kst, tt.locale); got != tt.want {
+				t.Errorf("RelativeDueDate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelativeDueDate_UsesBoardTimezone(t *testing.T) {
+	// 23:30 UTC on June 1st is already 08:30 on June 2nd in KST
+	now := time.Date(2024, 6, 1, 23, 30, 0, 0, time.UTC)
+	due := time.Date(2024, 6, 2, 1, 0, 0, 0, time.UTC)
+
+	if got := RelativeDueDate(due, now, time.UTC, "en"); got != "due tomorrow" {
+		t.Errorf("UTC board: got %q, want %q", got, "due tomorrow")
+	}
+	if got := RelativeDueDate(due, now, time.FixedZone("KST", 9*60*60), "en"); got != "due today" {
+		t.Errorf("KST board: got %q, want %q", got, "due today")
+	}
+	if got := RelativeDueDate(due, now, nil, "en"); got != "due tomorrow" {
+		t.Errorf("nil location: got %q, want UTC result %q", got, "due tomorrow")
+	}
+}
@@ -0,0 +1,93 @@
+// Package textutil provides text helpers shared by services and handlers.
+package textutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Ellipsis is appended to titles that were shortened
+const Ellipsis = "…"
+
+// zeroWidthJoiner glues emoji into a single sequence (e.g. family or profession emoji)
+const zeroWidthJoiner = '\u200d'
+
+// TruncateTitle shortens title to at most maxLen user-perceived characters, ellipsis included.
+// Truncation happens on grapheme cluster boundaries, so emoji sequences, flags and
+// characters with combining marks are either kept whole or dropped whole.
+func TruncateTitle(title string, maxLen int) string {
+	if maxLen <= 0 {
+		return ""
+	}
+
+	clusters := graphemeClusters(title)
+	if len(clusters) <= maxLen {
+		return title
+	}
+
+	return strings.Join(clusters[:maxLen-1], "") + Ellipsis
+}
+
+// graphemeClusters splits s into user-perceived characters.
+// It covers the cases titles run into in practice: combining marks, variation selectors,
+// emoji modifiers, ZWJ sequences, regional indicator flags and tag sequences.
+func graphemeClusters(s string) []string {
+	clusters := make([]string, 0, utf8.RuneCountInString(s))
+
+	start := 0
+	var prev rune
+	regionalCount := 0 // consecutive regional indicators in the current cluster
+	for i, r := range s {
+		if i > 0 && !continuesCluster(prev, r, regionalCount) {
+			clusters = append(clusters, s[start:i])
+			start = i
+			regionalCount = 0
+		}
+		if isRegionalIndicator(r) {
+			regionalCount++
+		}
+		prev = r
+	}
+	if start < len(s) {
+		clusters = append(clusters, s[start:])
+	}
+
+	return clusters
+}
+
+// continuesCluster reports whether r belongs to the same grapheme cluster as the preceding rune prev
+func continuesCluster(prev, r rune, regionalCount int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return true
+	case prev == zeroWidthJoiner:
+		return true
+	case r == zeroWidthJoiner:
+		return true
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc):
+		return true
+	case isVariationSelector(r), isEmojiModifier(r), isTag(r):
+		return true
+	case isRegionalIndicator(prev) && isRegionalIndicator(r):
+		// Flags are pairs of regional indicators
+		return regionalCount%2 == 1
+	}
+	return false
+}
+
+func isVariationSelector(r rune) bool {
+	return (r >= 0xFE00 && r <= 0xFE0F) || (r >= 0xE0100 && r <= 0xE01EF)
+}
+
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
+
+func isTag(r rune) bool {
+	return r >= 0xE0020 && r <= 0xE007F
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
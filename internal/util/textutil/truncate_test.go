@@ -0,0 +1,59 @@
+package textutil
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncateTitle(t *testing.T) {
+	family := "👨‍👩‍👧‍👦"
+	thumbsUp := "👍🏽"
+	flag := "🇰🇷"
+
+	tests := []struct {
+		name   string
+		title  string
+		maxLen int
+		want   string
+	}{
+		{name: "짧은 제목은 그대로", title: "Board", maxLen: 10, want: "Board"},
+		{name: "정확히 최대 길이", title: "Board", maxLen: 5, want: "Board"},
+		{name: "ASCII 잘라내기", title: "Implement login", maxLen: 6, want: "Imple…"},
+		{name: "한글 잘라내기", title: "사용자 인증 구현", maxLen: 4, want: "사용자…"},
+		{name: "ZWJ 가족 이모지로 끝나는 제목", title: "Team " + family, maxLen: 6, want: "Team " + family},
+		{name: "ZWJ 이모지는 통째로 제거", title: "Team " + family + "!", maxLen: 6, want: "Team …"},
+		{name: "피부색 수정자", title: thumbsUp + thumbsUp + thumbsUp, maxLen: 2, want: thumbsUp + "…"},
+		{name: "국기 쌍은 분리되지 않음", title: flag + flag + flag, maxLen: 2, want: flag + "…"},
+		{name: "결합 문자", title: "café menu", maxLen: 5, want: "café…"},
+		{name: "최대 길이 0", title: "Board", maxLen: 0, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TruncateTitle(tt.title, tt.maxLen)
+			if got != tt.want {
+				t.Errorf("TruncateTitle(%q, %d) = %q, want %q", tt.title, tt.maxLen, got, tt.want)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("TruncateTitle(%q, %d) produced invalid UTF-8", tt.title, tt.maxLen)
+			}
+		})
+	}
+}
+
+func TestTruncateTitle_NeverSplitsTrailingEmoji(t *testing.T) {
+	family := "👨‍👩‍👧‍👦"
+	title := "Release " + family
+
+	// Cutting anywhere inside the emoji's code points must keep or drop it whole
+	for maxLen := 1; maxLen <= len([]rune(title)); maxLen++ {
+		got := TruncateTitle(title, maxLen)
+		trimmed := got
+		if len(got) >= len(Ellipsis) && got[len(got)-len(Ellipsis):] == Ellipsis {
+			trimmed = got[:len(got)-len(Ellipsis)]
+		}
+		if trimmed != title && len(trimmed) > len("Release ") {
+			t.Errorf("TruncateTitle(%q, %d) = %q splits the emoji", title, maxLen, got)
+		}
+	}
+}